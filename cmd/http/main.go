@@ -5,15 +5,25 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
+	_ "time/tzdata"
 
 	_ "github.com/bagashiz/go-pos/docs"
 	"github.com/bagashiz/go-pos/internal/adapter/auth/paseto"
+	"github.com/bagashiz/go-pos/internal/adapter/auth/totp"
+	"github.com/bagashiz/go-pos/internal/adapter/catalog"
 	"github.com/bagashiz/go-pos/internal/adapter/config"
 	"github.com/bagashiz/go-pos/internal/adapter/handler/http"
+	"github.com/bagashiz/go-pos/internal/adapter/label"
 	"github.com/bagashiz/go-pos/internal/adapter/logger"
+	"github.com/bagashiz/go-pos/internal/adapter/media"
+	"github.com/bagashiz/go-pos/internal/adapter/notifier"
+	"github.com/bagashiz/go-pos/internal/adapter/scheduler"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/blob"
 	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
 	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres/repository"
 	"github.com/bagashiz/go-pos/internal/adapter/storage/redis"
+	"github.com/bagashiz/go-pos/internal/adapter/ticket"
 	"github.com/bagashiz/go-pos/internal/core/service"
 )
 
@@ -49,6 +59,13 @@ func main() {
 
 	slog.Info("Starting the application", "app", config.App.Name, "env", config.App.Env)
 
+	// Load the time zone of the store
+	storeLocation, err := time.LoadLocation(config.App.TimeZone)
+	if err != nil {
+		slog.Error("Error loading store time zone", "error", err)
+		os.Exit(1)
+	}
+
 	// Init database
 	ctx := context.Background()
 	db, err := postgres.New(ctx, config.DB)
@@ -86,16 +103,60 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Init TOTP service
+	totpService, err := totp.New(config.TwoFactor)
+	if err != nil {
+		slog.Error("Error initializing TOTP service", "error", err)
+		os.Exit(1)
+	}
+
+	// Init file storage
+	fileStorage, err := blob.New(ctx, config.Storage)
+	if err != nil {
+		slog.Error("Error initializing file storage", "error", err)
+		os.Exit(1)
+	}
+
+	slog.Info("Successfully initialized the file storage", "driver", config.Storage.Driver)
+
+	// Init notifier
+	mailNotifier, err := notifier.New(config.Mail)
+	if err != nil {
+		slog.Error("Error initializing notifier", "error", err)
+		os.Exit(1)
+	}
+
 	// Dependency injection
+	// Audit
+	auditLogger := logger.NewAuditLogger()
+
+	// Role
+	roleRepo := repository.NewRoleRepository(db)
+	roleService := service.NewRoleService(roleRepo, cache)
+	roleHandler := http.NewRoleHandler(roleService)
+
 	// User
 	userRepo := repository.NewUserRepository(db)
-	userService := service.NewUserService(userRepo, cache)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	userService := service.NewUserService(userRepo, refreshTokenRepo, roleRepo, cache)
 	userHandler := http.NewUserHandler(userService)
 
+	// Terminal
+	terminalRepo := repository.NewTerminalRepository(db)
+	terminalService := service.NewTerminalService(terminalRepo)
+	terminalHandler := http.NewTerminalHandler(terminalService)
+
 	// Auth
-	authService := service.NewAuthService(userRepo, token)
+	twoFactorRepo := repository.NewTwoFactorRepository(db)
+	enforceAdminTwoFactor := config.TwoFactor.EnforceAdmin == "true"
+	authService := service.NewAuthService(userRepo, refreshTokenRepo, terminalRepo, roleRepo, twoFactorRepo, token, totpService, cache, auditLogger, enforceAdminTwoFactor)
 	authHandler := http.NewAuthHandler(authService)
 
+	// Password
+	passwordResetRepo := repository.NewPasswordResetRepository(db)
+	passwordService := service.NewPasswordService(userRepo, passwordResetRepo, refreshTokenRepo, token, mailNotifier, cache, auditLogger)
+	passwordHandler := http.NewPasswordHandler(passwordService)
+
 	// Payment
 	paymentRepo := repository.NewPaymentRepository(db)
 	paymentService := service.NewPaymentService(paymentRepo, cache)
@@ -111,21 +172,109 @@ func main() {
 	productService := service.NewProductService(productRepo, categoryRepo, cache)
 	productHandler := http.NewProductHandler(productService)
 
+	// Lot
+	lotRepo := repository.NewLotRepository(db)
+	lotService := service.NewLotService(lotRepo, productRepo, cache)
+	lotHandler := http.NewLotHandler(lotService)
+
+	// Price list
+	priceListRepo := repository.NewPriceListRepository(db)
+	priceListService := service.NewPriceListService(priceListRepo, productRepo, storeLocation)
+	priceListHandler := http.NewPriceListHandler(priceListService)
+
+	// Modifier
+	modifierRepo := repository.NewModifierRepository(db)
+	modifierService := service.NewModifierService(modifierRepo, productRepo)
+	modifierHandler := http.NewModifierHandler(modifierService)
+
 	// Order
 	orderRepo := repository.NewOrderRepository(db)
-	orderService := service.NewOrderService(orderRepo, productRepo, categoryRepo, userRepo, paymentRepo, cache)
+	ticketRenderer := ticket.New()
+	orderService := service.NewOrderService(orderRepo, productRepo, categoryRepo, userRepo, paymentRepo, priceListRepo, modifierRepo, ticketRenderer, cache, storeLocation)
 	orderHandler := http.NewOrderHandler(orderService)
 
+	// Location
+	locationRepo := repository.NewLocationRepository(db)
+	locationService := service.NewLocationService(locationRepo, productRepo)
+	locationHandler := http.NewLocationHandler(locationService)
+
+	// Transfer
+	transferRepo := repository.NewTransferRepository(db)
+	transferService := service.NewTransferService(transferRepo, locationRepo, productRepo, cache)
+	transferHandler := http.NewTransferHandler(transferService)
+
+	// Serial
+	serialRepo := repository.NewSerialRepository(db)
+	serialService := service.NewSerialService(serialRepo, productRepo, orderRepo, cache)
+	serialHandler := http.NewSerialHandler(serialService)
+
+	// Barcode
+	barcodeRepo := repository.NewBarcodeRepository(db)
+	barcodeService := service.NewBarcodeService(barcodeRepo, productRepo, categoryRepo, cache)
+	barcodeHandler := http.NewBarcodeHandler(barcodeService)
+
+	// Label
+	labelRenderer := label.New()
+	labelService := service.NewLabelService(productRepo, barcodeRepo, labelRenderer)
+	labelHandler := http.NewLabelHandler(labelService)
+
+	// Catalog
+	catalogCodec := catalog.New()
+	catalogService := service.NewCatalogService(productRepo, categoryRepo, barcodeRepo, catalogCodec, cache)
+	catalogHandler := http.NewCatalogHandler(catalogService)
+
+	// Image
+	imageProcessor := media.New()
+	imageService := service.NewImageService(productRepo, paymentRepo, fileStorage, imageProcessor, cache)
+	imageHandler := http.NewImageHandler(imageService)
+
+	// Report
+	reportRepo := repository.NewReportRepository(db)
+	reportService := service.NewReportService(reportRepo)
+	reportHandler := http.NewReportHandler(reportService)
+
+	// Price
+	priceRepo := repository.NewPriceRepository(db)
+	priceService := service.NewPriceService(priceRepo, productRepo, cache)
+	priceHandler := http.NewPriceHandler(priceService)
+
+	// Start background jobs
+	jobs, err := scheduler.New(config.Scheduler, priceService)
+	if err != nil {
+		slog.Error("Error initializing scheduler", "error", err)
+		os.Exit(1)
+	}
+
+	jobs.Start(ctx)
+
+	slog.Info("Started the background job scheduler", "interval", config.Scheduler.Interval)
+
 	// Init router
 	router, err := http.NewRouter(
 		config.HTTP,
 		token,
+		cache,
 		*userHandler,
 		*authHandler,
 		*paymentHandler,
 		*categoryHandler,
 		*productHandler,
 		*orderHandler,
+		*locationHandler,
+		*transferHandler,
+		*lotHandler,
+		*serialHandler,
+		*barcodeHandler,
+		*labelHandler,
+		*reportHandler,
+		*priceHandler,
+		*priceListHandler,
+		*catalogHandler,
+		*imageHandler,
+		*modifierHandler,
+		*terminalHandler,
+		*roleHandler,
+		*passwordHandler,
 	)
 	if err != nil {
 		slog.Error("Error initializing router", "error", err)
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/bagashiz/go-pos/internal/adapter/auth/paseto"
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+)
+
+const usage = `Usage: token <command> [flags]
+
+Commands:
+  generate    print a new key to use in TOKEN_KEYS
+  rotate      prepend a new signing key to the key file and drop the oldest keys
+
+Flags:
+`
+
+// Generates and rotates the keys used to encrypt access tokens
+func main() {
+	flags := flag.NewFlagSet("token", flag.ExitOnError)
+	tokenType := flags.String("type", "", "token type of the key, local or public, defaults to TOKEN_TYPE or local")
+	file := flags.String("file", "", "path of the key file to rotate, defaults to TOKEN_KEY_FILE")
+	keep := flags.Int("keep", 2, "number of keys to keep when rotating, including the new one")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	if len(os.Args) < 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	_ = flags.Parse(os.Args[2:])
+
+	// Fill the unset flags from environment variables
+	if *tokenType == "" || *file == "" {
+		config, err := config.New()
+		if err == nil {
+			if *tokenType == "" {
+				*tokenType = config.Token.Type
+			}
+			if *file == "" {
+				*file = config.Token.KeyFile
+			}
+		}
+	}
+	if *tokenType == "" {
+		*tokenType = paseto.Local
+	}
+
+	switch os.Args[1] {
+	case "generate":
+		key, err := paseto.GenerateKey(*tokenType)
+		if err != nil {
+			slog.Error("Error generating token key", "error", err)
+			os.Exit(1)
+		}
+
+		fmt.Println(key)
+	case "rotate":
+		path := *file
+		if path == "" {
+			slog.Error("Key file is not set, use -file or TOKEN_KEY_FILE")
+			os.Exit(1)
+		}
+
+		key, err := paseto.RotateKeyFile(path, *tokenType, *keep)
+		if err != nil {
+			slog.Error("Error rotating token keys", "error", err)
+			os.Exit(1)
+		}
+
+		slog.Info("Rotated the token keys, restart the application to sign with the new key", "file", path, "key_id", key.ID)
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+}
@@ -23,112 +23,24 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/categories": {
+        "/.well-known/paseto-keys": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "List categories with pagination",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Categories"
-                ],
-                "summary": "List categories",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Skip",
-                        "name": "skip",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Categories displayed",
-                        "schema": {
-                            "$ref": "#/definitions/http.meta"
-                        }
-                    },
-                    "400": {
-                        "description": "Validation error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "create a new category with name",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the Ed25519 public keys that verify the v4.public access tokens, including the keys no longer signing new tokens. Returns not found when the access tokens are v4.local.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
-                ],
-                "summary": "Create a new category",
-                "parameters": [
-                    {
-                        "description": "Create category request",
-                        "name": "createCategoryRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.createCategoryRequest"
-                        }
-                    }
+                    "Users"
                 ],
+                "summary": "List the public keys of access tokens",
                 "responses": {
                     "200": {
-                        "description": "Category created",
-                        "schema": {
-                            "$ref": "#/definitions/http.categoryResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Validation error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden error",
+                        "description": "Public keys displayed",
                         "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.publicKeyResponse"
+                            }
                         }
                     },
                     "404": {
@@ -137,12 +49,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -152,14 +58,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories/{id}": {
+        "/barcodes": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "get a category by id",
+                "description": "List barcodes of a product",
                 "consumes": [
                     "application/json"
                 ],
@@ -167,23 +73,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Barcodes"
                 ],
-                "summary": "Get a category",
+                "summary": "List barcodes",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category retrieved",
+                        "description": "Barcodes retrieved",
                         "schema": {
-                            "$ref": "#/definitions/http.categoryResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.barcodeResponse"
+                            }
                         }
                     },
                     "400": {
@@ -192,12 +101,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -206,13 +109,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update a category's name by id",
+                "description": "add a manufacturer EAN-13, EAN-8 or UPC-A barcode, or the 7-digit prefix of in-store barcodes that encode weight or price, to a product",
                 "consumes": [
                     "application/json"
                 ],
@@ -220,32 +123,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Barcodes"
                 ],
-                "summary": "Update a category",
+                "summary": "Add a new barcode to a product",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update category request",
-                        "name": "updateCategoryRequest",
+                        "description": "Create barcode request",
+                        "name": "createBarcodeRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.updateCategoryRequest"
+                            "$ref": "#/definitions/http.createBarcodeRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category updated",
+                        "description": "Barcode created",
                         "schema": {
-                            "$ref": "#/definitions/http.categoryResponse"
+                            "$ref": "#/definitions/http.barcodeResponse"
                         }
                     },
                     "400": {
@@ -285,14 +181,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/barcodes/{id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a category by id",
+                "description": "Delete a barcode by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -300,13 +198,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Categories"
+                    "Barcodes"
                 ],
-                "summary": "Delete a category",
+                "summary": "Delete a barcode",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Category ID",
+                        "description": "Barcode ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -314,7 +212,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category deleted",
+                        "description": "Barcode deleted",
                         "schema": {
                             "$ref": "#/definitions/http.response"
                         }
@@ -352,14 +250,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders": {
+        "/categories": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List orders and return an array of order data with purchase details",
+                "description": "List categories with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -367,20 +265,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Categories"
                 ],
-                "summary": "List orders",
+                "summary": "List categories",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skip records",
+                        "description": "Skip",
                         "name": "skip",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Limit records",
+                        "description": "Limit",
                         "name": "limit",
                         "in": "query",
                         "required": true
@@ -388,7 +286,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Orders displayed",
+                        "description": "Categories displayed",
                         "schema": {
                             "$ref": "#/definitions/http.meta"
                         }
@@ -399,12 +297,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -419,7 +311,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new order and return the order data with purchase details",
+                "description": "create a new category with name, optionally as a sub-category of a parent category",
                 "consumes": [
                     "application/json"
                 ],
@@ -427,25 +319,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "Categories"
                 ],
-                "summary": "Create a new order",
+                "summary": "Create a new category",
                 "parameters": [
                     {
-                        "description": "Create order request",
-                        "name": "createOrderRequest",
+                        "description": "Create category request",
+                        "name": "createCategoryRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.createOrderRequest"
+                            "$ref": "#/definitions/http.createCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Order created",
+                        "description": "Category created",
                         "schema": {
-                            "$ref": "#/definitions/http.orderResponse"
+                            "$ref": "#/definitions/http.categoryResponse"
                         }
                     },
                     "400": {
@@ -454,6 +346,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
@@ -475,14 +379,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/{id}": {
+        "/categories/tree": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get an order by id and return the order data with purchase details",
+                "description": "get the top-level categories with their nested sub-categories, ordered by name",
                 "consumes": [
                     "application/json"
                 ],
@@ -490,33 +394,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
-                ],
-                "summary": "Get an order",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Categories"
                 ],
+                "summary": "Get the category tree",
                 "responses": {
                     "200": {
-                        "description": "Order displayed",
-                        "schema": {
-                            "$ref": "#/definitions/http.orderResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Validation error",
+                        "description": "Category tree retrieved",
                         "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.categoryTreeResponse"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
+                    "401": {
+                        "description": "Unauthorized error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
@@ -530,14 +422,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/payments": {
+        "/categories/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List payments with pagination",
+                "description": "get a category by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -545,30 +437,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Categories"
                 ],
-                "summary": "List payments",
+                "summary": "Get a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skip",
-                        "name": "skip",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payments displayed",
+                        "description": "Category retrieved",
                         "schema": {
-                            "$ref": "#/definitions/http.meta"
+                            "$ref": "#/definitions/http.categoryResponse"
                         }
                     },
                     "400": {
@@ -577,6 +462,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -585,13 +476,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "create a new payment with name, type, and logo",
+                "description": "update a category's name by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -599,25 +490,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Categories"
                 ],
-                "summary": "Create a new payment",
+                "summary": "Update a category",
                 "parameters": [
                     {
-                        "description": "Create payment request",
-                        "name": "createPaymentRequest",
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update category request",
+                        "name": "updateCategoryRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.createPaymentRequest"
+                            "$ref": "#/definitions/http.updateCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment created",
+                        "description": "Category updated",
                         "schema": {
-                            "$ref": "#/definitions/http.paymentResponse"
+                            "$ref": "#/definitions/http.categoryResponse"
                         }
                     },
                     "400": {
@@ -657,16 +555,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/payments/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "get a payment by id",
+                "description": "Delete a category by id. A category with products or sub-categories cannot be deleted",
                 "consumes": [
                     "application/json"
                 ],
@@ -674,13 +570,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Categories"
                 ],
-                "summary": "Get a payment",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Payment ID",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -688,9 +584,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment retrieved",
+                        "description": "Category deleted",
                         "schema": {
-                            "$ref": "#/definitions/http.paymentResponse"
+                            "$ref": "#/definitions/http.response"
                         }
                     },
                     "400": {
@@ -699,12 +595,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -712,14 +626,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/categories/{id}/parent": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update a payment's name, type, or logo by id",
+                "description": "move a category under a parent category, or to the top level when the parent id is 0. A category cannot be moved under itself or one of its sub-categories",
                 "consumes": [
                     "application/json"
                 ],
@@ -727,32 +643,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payments"
+                    "Categories"
                 ],
-                "summary": "Update a payment",
+                "summary": "Move a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Payment ID",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update payment request",
-                        "name": "updatePaymentRequest",
+                        "description": "Move category request",
+                        "name": "moveCategoryRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.updatePaymentRequest"
+                            "$ref": "#/definitions/http.moveCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment updated",
+                        "description": "Category moved",
                         "schema": {
-                            "$ref": "#/definitions/http.paymentResponse"
+                            "$ref": "#/definitions/http.categoryResponse"
                         }
                     },
                     "400": {
@@ -779,8 +695,45 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/{key}": {
+            "get": {
+                "description": "get an uploaded product image, payment logo or one of their thumbnails by the key in its URL",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "tags": [
+                    "Images"
+                ],
+                "summary": "Get an uploaded image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Image key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image retrieved",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
@@ -792,38 +745,59 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/labels/shelf": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a payment by id",
+                "description": "render a printable A4 PDF sheet of shelf labels with the name, price and barcode of the chosen products, or of the products of a category. EAN-13 labels require every product to have an EAN-13 or UPC-A barcode",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/pdf"
                 ],
                 "tags": [
-                    "Payments"
+                    "Labels"
                 ],
-                "summary": "Delete a payment",
+                "summary": "Render shelf labels",
                 "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Product IDs",
+                        "name": "product_ids",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Payment ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "code128",
+                            "ean13"
+                        ],
+                        "type": "string",
+                        "description": "Barcode symbology",
+                        "name": "symbology",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Payment deleted",
+                        "description": "Shelf labels rendered",
                         "schema": {
-                            "$ref": "#/definitions/http.response"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -832,18 +806,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
@@ -859,14 +821,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
+        "/locations": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List products with pagination",
+                "description": "List locations, the default location first, with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -874,22 +836,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Locations"
                 ],
-                "summary": "List products",
+                "summary": "List locations",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Query",
-                        "name": "q",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
                         "description": "Skip",
@@ -907,7 +857,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Products retrieved",
+                        "description": "Locations retrieved",
                         "schema": {
                             "$ref": "#/definitions/http.meta"
                         }
@@ -932,7 +882,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "create a new product with name, image, price, and stock",
+                "description": "create a new location, such as a warehouse, stock can be transferred to and from. The default location is the store, whose stock is the stock of the products",
                 "consumes": [
                     "application/json"
                 ],
@@ -940,25 +890,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Locations"
                 ],
-                "summary": "Create a new product",
+                "summary": "Create a new location",
                 "parameters": [
                     {
-                        "description": "Create product request",
-                        "name": "createProductRequest",
+                        "description": "Create location request",
+                        "name": "createLocationRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.createProductRequest"
+                            "$ref": "#/definitions/http.createLocationRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product created",
+                        "description": "Location created",
                         "schema": {
-                            "$ref": "#/definitions/http.productResponse"
+                            "$ref": "#/definitions/http.locationResponse"
                         }
                     },
                     "400": {
@@ -979,12 +929,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "409": {
                         "description": "Data conflict error",
                         "schema": {
@@ -1000,14 +944,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{id}": {
+        "/locations/{id}/stock": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "get a product by id with its category",
+                "description": "List the products in stock at a location with their quantities, with pagination. Stock in transit between locations is not included",
                 "consumes": [
                     "application/json"
                 ],
@@ -1015,23 +959,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Locations"
                 ],
-                "summary": "Get a product",
+                "summary": "List the stock at a location",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
+                        "description": "Location ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product retrieved",
+                        "description": "Location stock retrieved",
                         "schema": {
-                            "$ref": "#/definitions/http.productResponse"
+                            "$ref": "#/definitions/http.meta"
                         }
                     },
                     "400": {
@@ -1053,14 +1011,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/lots": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update a product's name, image, price, or stock by id",
+                "description": "List lots of a product ordered by expiry date with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -1068,32 +1028,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Lots"
                 ],
-                "summary": "Update a product",
+                "summary": "List lots",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
+                        "name": "product_id",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Update product request",
-                        "name": "updateProductRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.updateProductRequest"
-                        }
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product updated",
+                        "description": "Lots retrieved",
                         "schema": {
-                            "$ref": "#/definitions/http.productResponse"
+                            "$ref": "#/definitions/http.meta"
                         }
                     },
                     "400": {
@@ -1102,30 +1067,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1134,13 +1081,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a product by id",
+                "description": "receive a new lot of a lot-tracked product with its expiry date and add the quantity, converted from the given unit of measure, to the product stock",
                 "consumes": [
                     "application/json"
                 ],
@@ -1148,23 +1095,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Lots"
                 ],
-                "summary": "Delete a product",
+                "summary": "Receive a new lot",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Product ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Create lot request",
+                        "name": "createLotRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createLotRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Product deleted",
+                        "description": "Lot created",
                         "schema": {
-                            "$ref": "#/definitions/http.response"
+                            "$ref": "#/definitions/http.lotResponse"
                         }
                     },
                     "400": {
@@ -1191,6 +1140,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1200,14 +1155,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/lots/expiring": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List users with pagination",
+                "description": "List lots with remaining stock that expire within the given number of days, including already expired lots",
                 "consumes": [
                     "application/json"
                 ],
@@ -1215,10 +1170,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Lots"
                 ],
-                "summary": "List users",
+                "summary": "List expiring lots",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Days until expiry",
+                        "name": "days",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Skip",
@@ -1236,7 +1197,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Users displayed",
+                        "description": "Expiring lots retrieved",
                         "schema": {
                             "$ref": "#/definitions/http.meta"
                         }
@@ -1254,9 +1215,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "create a new user account with default role \"cashier\"",
+            }
+        },
+        "/lots/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get a lot by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1264,25 +1232,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Lots"
                 ],
-                "summary": "Register a new user",
+                "summary": "Get a lot",
                 "parameters": [
                     {
-                        "description": "Register request",
-                        "name": "registerRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.registerRequest"
-                        }
+                        "type": "integer",
+                        "description": "Lot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User created",
+                        "description": "Lot retrieved",
                         "schema": {
-                            "$ref": "#/definitions/http.userResponse"
+                            "$ref": "#/definitions/http.lotResponse"
                         }
                     },
                     "400": {
@@ -1291,20 +1257,70 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized error",
+                    "404": {
+                        "description": "Data not found error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Data not found error",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List orders and return an array of order data with purchase details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "List orders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skip records",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit records",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Orders displayed",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Data conflict error",
+                    "401": {
+                        "description": "Unauthorized error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
@@ -1316,11 +1332,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/login": {
+            },
             "post": {
-                "description": "Logs in a registered user and returns an access token if the credentials are valid.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new order, priced with the price lists that apply to the customer group at the time of the order plus the prices of the modifiers chosen on each product, and return the order data with purchase details. Pricing an order for a customer group other than retail requires the orders.customer_group permission",
                 "consumes": [
                     "application/json"
                 ],
@@ -1328,25 +1347,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Orders"
                 ],
-                "summary": "Login and get an access token",
+                "summary": "Create a new order",
                 "parameters": [
                     {
-                        "description": "Login request body",
-                        "name": "request",
+                        "description": "Create order request",
+                        "name": "createOrderRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.loginRequest"
+                            "$ref": "#/definitions/http.createOrderRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Succesfully logged in",
+                        "description": "Order created",
                         "schema": {
-                            "$ref": "#/definitions/http.authResponse"
+                            "$ref": "#/definitions/http.orderResponse"
                         }
                     },
                     "400": {
@@ -1355,8 +1374,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized error",
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
                         "schema": {
                             "$ref": "#/definitions/http.errorResponse"
                         }
@@ -1370,14 +1401,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/orders/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a user by id",
+                "description": "Get an order by id and return the order data with purchase details",
                 "consumes": [
                     "application/json"
                 ],
@@ -1385,13 +1416,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Orders"
                 ],
-                "summary": "Get a user",
+                "summary": "Get an order",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1399,9 +1430,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User displayed",
+                        "description": "Order displayed",
                         "schema": {
-                            "$ref": "#/definitions/http.userResponse"
+                            "$ref": "#/definitions/http.orderResponse"
                         }
                     },
                     "400": {
@@ -1423,47 +1454,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/orders/{id}/kitchen-ticket": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a user's name, email, password, or role by id",
+                "description": "Render the kitchen ticket of an order as plain text for a kitchen printer, listing each product with the modifiers chosen on it without prices",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Users"
+                    "Orders"
                 ],
-                "summary": "Update a user",
+                "summary": "Print an order kitchen ticket",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update user request",
-                        "name": "updateUserRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.updateUserRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User updated",
+                        "description": "Kitchen ticket rendered",
                         "schema": {
-                            "$ref": "#/definitions/http.userResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -1478,12 +1502,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
@@ -1497,28 +1515,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/orders/{id}/receipt": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a user by id",
+                "description": "Render the customer receipt of an order as plain text for a receipt printer, listing each product with the modifiers chosen on it and the totals of the order",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Users"
+                    "Orders"
                 ],
-                "summary": "Delete a user",
+                "summary": "Print an order receipt",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1526,9 +1546,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deleted",
+                        "description": "Receipt rendered",
                         "schema": {
-                            "$ref": "#/definitions/http.response"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -1543,12 +1563,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.errorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden error",
-                        "schema": {
-                            "$ref": "#/definitions/http.errorResponse"
-                        }
-                    },
                     "404": {
                         "description": "Data not found error",
                         "schema": {
@@ -1563,212 +1577,6767 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.PaymentType": {
-            "type": "string",
-            "enum": [
-                "CASH",
-                "E-WALLET",
-                "EDC"
-            ],
-            "x-enum-varnames": [
-                "Cash",
-                "EWallet",
-                "EDC"
-            ]
-        },
-        "domain.UserRole": {
-            "type": "string",
-            "enum": [
-                "admin",
-                "cashier"
-            ],
-            "x-enum-varnames": [
-                "Admin",
-                "Cashier"
-            ]
-        },
-        "http.authResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "type": "string",
-                    "example": "v2.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."
-                }
-            }
-        },
-        "http.categoryResponse": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "name": {
-                    "type": "string",
-                    "example": "Foods"
-                }
-            }
         },
-        "http.createCategoryRequest": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "Foods"
+        "/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List payments with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "List payments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payments displayed",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create a new payment with name, type, and logo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Create a new payment",
+                "parameters": [
+                    {
+                        "description": "Create payment request",
+                        "name": "createPaymentRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createPaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment created",
+                        "schema": {
+                            "$ref": "#/definitions/http.paymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get a payment by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Get a payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.paymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a payment's name, type, or logo by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Update a payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update payment request",
+                        "name": "updatePaymentRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.updatePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment updated",
+                        "schema": {
+                            "$ref": "#/definitions/http.paymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a payment by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Delete a payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/payments/{id}/logo": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "upload a JPEG, PNG or GIF image of at most 5 MB as the logo of a payment, generating its thumbnail and replacing the previous logo",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payments"
+                ],
+                "summary": "Upload a payment logo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Payment logo",
+                        "name": "logo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment logo uploaded",
+                        "schema": {
+                            "$ref": "#/definitions/http.paymentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/price-lists": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List price lists with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceLists"
+                ],
+                "summary": "List price lists",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price lists retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create a new price list of product prices for a customer group, or every customer group when none is given, within an optional validity period and daily time window in HH:MM, which spans midnight when it ends before it starts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceLists"
+                ],
+                "summary": "Create a new price list",
+                "parameters": [
+                    {
+                        "description": "Create price list request",
+                        "name": "priceListRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.priceListRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price list created",
+                        "schema": {
+                            "$ref": "#/definitions/http.priceListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/price-lists/price": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get the price a product is sold at right now to a customer group, defaulting to retail, along with the price list it comes from, if any",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceLists"
+                ],
+                "summary": "Look up the current price of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "retail",
+                            "wholesale",
+                            "staff"
+                        ],
+                        "type": "string",
+                        "description": "Customer group",
+                        "name": "customer_group",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product price retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.productPriceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/price-lists/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get a price list by id along with its product prices",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceLists"
+                ],
+                "summary": "Get a price list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Price list ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price list retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.priceListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "replace the customer group, validity, time window and product prices of a price list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceLists"
+                ],
+                "summary": "Update a price list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Price list ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update price list request",
+                        "name": "priceListRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.priceListRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price list updated",
+                        "schema": {
+                            "$ref": "#/definitions/http.priceListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a price list by id, keeping the orders priced with it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PriceLists"
+                ],
+                "summary": "Delete a price list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Price list ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price list deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/prices/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the price changes of a product with who made them, newest first, with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "List price history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price history retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/prices/scheduled": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the scheduled prices of a product ordered by effective time, optionally only the ones not applied yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "List scheduled prices",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only scheduled prices not applied yet",
+                        "name": "pending",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled prices retrieved",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.scheduledPriceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "schedule a future price of a product, applied by a background job once its effective time is reached",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "Schedule a price change",
+                "parameters": [
+                    {
+                        "description": "Schedule price request",
+                        "name": "schedulePriceRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.schedulePriceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Price scheduled",
+                        "schema": {
+                            "$ref": "#/definitions/http.scheduledPriceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/prices/scheduled/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel a scheduled price that has not taken effect yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Prices"
+                ],
+                "summary": "Cancel a scheduled price",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scheduled price ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled price canceled",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List products with pagination, optionally grouping variants under their parent product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "List products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID, including its sub-categories",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Group variants under their parent product",
+                        "name": "grouped",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Products retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create a new product with name, image, price, stock, units of measure, and whether it is tracked by lot or serial number, a variant of a parent product with its option values, or a bundle of component products whose stock is derived from the components",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Create product request",
+                        "name": "createProductRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product created",
+                        "schema": {
+                            "$ref": "#/definitions/http.productResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/barcode/{code}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "look up the product of a scanned barcode, with the weight or price decoded from in-store weighted barcodes, or by the SKU printed on Code128 labels",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Look up a product by barcode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Barcode",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.barcodeScanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "export every product with its SKU, first standard barcode, category name, unit, price, cost and current stock into a CSV or XLSX file that can be imported back",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Export products into a catalog file",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Catalog exported",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "import products from a CSV or XLSX file whose header names any of the columns sku, barcode, name, category, unit, price, cost and stock. Rows update the product with their SKU, or else their barcode, keeping the values of empty cells, and create a new product otherwise, which requires a name, category name and price. Changing the price of an existing product requires the products.edit_price permission. A higher stock of an existing product is received at the cost of its row, and a lower stock consumes its oldest cost layers. Every row is validated first, and nothing is imported when any row has errors or the import is a dry run",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Import products from a catalog file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Catalog file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "File format, from the file extension by default",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the file",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Catalog imported or validated",
+                        "schema": {
+                            "$ref": "#/definitions/http.catalogImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get a product by id with its category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Get a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.productResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update a product's name, image, price, stock, average cost, or valuation method by id. Changing the price requires the products.edit_price permission",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Update a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update product request",
+                        "name": "updateProductRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.updateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product updated",
+                        "schema": {
+                            "$ref": "#/definitions/http.productResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a product by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/barcode": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "render the SKU of a product as a Code128 barcode, or its EAN-13 barcode, as an SVG or PNG image. Products without an EAN-13 or UPC-A barcode need an in-store barcode generated first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "image/svg+xml",
+                    "image/png"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Render the barcode of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "code128",
+                            "ean13"
+                        ],
+                        "type": "string",
+                        "description": "Barcode symbology",
+                        "name": "symbology",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "svg",
+                            "png"
+                        ],
+                        "type": "string",
+                        "description": "Image format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Barcode rendered",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "add an in-store EAN-13 barcode generated from the product id to a product without a manufacturer barcode, so that its EAN-13 labels can be printed and scanned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Barcodes"
+                ],
+                "summary": "Generate an in-store barcode for a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Barcode generated",
+                        "schema": {
+                            "$ref": "#/definitions/http.barcodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/image": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "upload a JPEG, PNG or GIF image of at most 5 MB as the image of a product, generating its thumbnail and replacing the previous image",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Upload a product image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Product image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product image uploaded",
+                        "schema": {
+                            "$ref": "#/definitions/http.productResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/modifier-groups": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "list the modifier groups of a product with their modifiers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "List modifier groups",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Modifier groups displayed",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.modifierGroupResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create a new group of modifiers for a product, like the milk of a coffee. Each order of the product chooses between the minimum and maximum selections of the group, so a group with a minimum is required. The price of a chosen modifier is added to each unit of the product, and its ingredient, if any, is consumed from stock by the given quantity for each unit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Create a new modifier group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create modifier group request",
+                        "name": "createModifierGroupRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createModifierGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Modifier group created",
+                        "schema": {
+                            "$ref": "#/definitions/http.modifierGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/modifier-groups/{group_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "delete a modifier group of a product with its modifiers. Orders keep the names and prices of the modifiers chosen on them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Delete a modifier group",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Modifier group ID",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Modifier group deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/receive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "add a received quantity, converted from the given unit of measure, to the stock of a product by id, updating its average cost with the cost per unit received",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Receive stock of a product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Receive product request",
+                        "name": "receiveProductRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.receiveProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product stock received",
+                        "schema": {
+                            "$ref": "#/definitions/http.productResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/margin": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "report the quantity sold, revenue, cost and gross margin per product of the orders created between two dates, both inclusive",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Report gross margin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Start date",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "End date",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Margin report retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.marginReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/valuation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "report the value of the stock on hand per product, by weighted average cost or FIFO as set on each product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Report inventory valuation",
+                "responses": {
+                    "200": {
+                        "description": "Inventory valuation retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.inventoryValuationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List all roles with their permissions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "List roles",
+                "responses": {
+                    "200": {
+                        "description": "Roles displayed",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.roleResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "create a new role with a name and the permissions of the users given it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Create a new role",
+                "parameters": [
+                    {
+                        "description": "Create role request",
+                        "name": "createRoleRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role created",
+                        "schema": {
+                            "$ref": "#/definitions/http.roleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List every permission a role can be given",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "List permissions",
+                "responses": {
+                    "200": {
+                        "description": "Permissions displayed",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get a role by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Get a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.roleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update the name or permissions of a role by id. Built-in roles cannot be renamed and the admin role cannot be changed. Access tokens issued with the previous permissions are revoked and have to be refreshed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Update a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update role request",
+                        "name": "updateRoleRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.updateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role updated",
+                        "schema": {
+                            "$ref": "#/definitions/http.roleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a role by id. Built-in roles and roles given to users cannot be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Delete a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/serials": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List serials of a product, optionally filtered by status, with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Serials"
+                ],
+                "summary": "List serials",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "product_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "in_stock",
+                            "sold",
+                            "in_stock",
+                            "sold"
+                        ],
+                        "type": "string",
+                        "description": "Serial status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Serials retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "receive new serial numbers of a serial-tracked product and add them to the product stock",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Serials"
+                ],
+                "summary": "Receive serial numbers",
+                "parameters": [
+                    {
+                        "description": "Create serials request",
+                        "name": "createSerialsRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createSerialsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Serials created",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/serials/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Find the product and, if sold, the order receipt of a serial number",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Serials"
+                ],
+                "summary": "Search a serial number",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Serial number",
+                        "name": "serial_number",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Serials retrieved",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.serialResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/terminals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the registered terminals, without their credentials",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Terminals"
+                ],
+                "summary": "List terminals",
+                "responses": {
+                    "200": {
+                        "description": "Terminals displayed",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/http.terminalResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a terminal cashiers can log in on with a PIN and returns its credential. The credential is only shown once and is sent by the terminal in the X-Terminal-Credential header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Terminals"
+                ],
+                "summary": "Register a terminal",
+                "parameters": [
+                    {
+                        "description": "Register terminal request",
+                        "name": "registerTerminalRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.registerTerminalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Terminal registered",
+                        "schema": {
+                            "$ref": "#/definitions/http.terminalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/terminals/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a terminal by id, so PIN login is no longer accepted on it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Terminals"
+                ],
+                "summary": "Delete a terminal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Terminal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Terminal deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List transfers, the latest first, optionally filtered by status, with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "List transfers",
+                "parameters": [
+                    {
+                        "enum": [
+                            "requested",
+                            "in_transit",
+                            "received",
+                            "canceled",
+                            "requested",
+                            "in_transit",
+                            "received",
+                            "canceled"
+                        ],
+                        "type": "string",
+                        "description": "Transfer status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transfers retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "request a transfer of product quantities, in their base units, from one location to another. Stock is only taken out of the source location once the transfer is dispatched",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Request a transfer",
+                "parameters": [
+                    {
+                        "description": "Create transfer request",
+                        "name": "createTransferRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.createTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transfer requested",
+                        "schema": {
+                            "$ref": "#/definitions/http.transferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get a transfer by id with its items, the discrepancies found on receipt and the stock movements it recorded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Get a transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transfer retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/http.transferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "cancel a transfer that has not been dispatched yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Cancel a transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transfer canceled",
+                        "schema": {
+                            "$ref": "#/definitions/http.transferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transfer status error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/dispatch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "take the items of a requested transfer out of the stock of its source location, recording the stock movements, and put them in transit until the transfer is received",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Dispatch a transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transfer dispatched",
+                        "schema": {
+                            "$ref": "#/definitions/http.transferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transfer status error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers/{id}/receive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "add the quantity received of each product of a transfer in transit, in its base unit, to the stock of its destination location, recording the stock movements. Every product of the transfer must be listed; quantities that differ from those dispatched are kept on the items as discrepancies",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Receive a transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Receive transfer request",
+                        "name": "receiveTransferRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.receiveTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transfer received",
+                        "schema": {
+                            "$ref": "#/definitions/http.transferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Transfer status error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List users with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skip",
+                        "name": "skip",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users displayed",
+                        "schema": {
+                            "$ref": "#/definitions/http.meta"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "create a new user account with default role \"cashier\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Register request",
+                        "name": "registerRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.registerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User created",
+                        "schema": {
+                            "$ref": "#/definitions/http.userResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Data conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/2fa": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generates a TOTP secret for the current user with its provisioning URI, to show as a QR code for an authenticator app, and recovery codes that are only shown once. Two-factor authentication is only enabled once it is confirmed with a code from the app; enrolling again before that replaces the secret and recovery codes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Enroll in two-factor authentication",
+                "responses": {
+                    "200": {
+                        "description": "Enrolled",
+                        "schema": {
+                            "$ref": "#/definitions/http.twoFactorEnrollmentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Two-factor authentication already enabled error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/2fa/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Enables the enrolled two-factor authentication of the current user with a code from the authenticator app.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Confirm two-factor authentication",
+                "parameters": [
+                    {
+                        "description": "Two-factor code request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.twoFactorCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Two-factor authentication enabled",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Two-factor authentication already enabled error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/2fa/disable": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Disables the two-factor authentication of the current user with a code from the authenticator app or an unused recovery code. Not allowed for users whose role can manage users or roles while two-factor authentication is enforced.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Disable two-factor authentication",
+                "parameters": [
+                    {
+                        "description": "Two-factor code request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.twoFactorCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Two-factor authentication disabled",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Two-factor authentication required error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/2fa/recovery-codes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the recovery codes of the current user with new ones, shown only once, after checking a code from the authenticator app or an unused recovery code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Regenerate recovery codes",
+                "parameters": [
+                    {
+                        "description": "Two-factor code request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.twoFactorCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recovery codes regenerated",
+                        "schema": {
+                            "$ref": "#/definitions/http.recoveryCodesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Logs in a registered user and returns a short-lived access token and a refresh token for the device if the credentials are valid. If the user has two-factor authentication enabled, or has a role that can manage users or roles while it is enforced, only a two-factor challenge is returned instead, to complete at /users/login/2fa. A user who has to enroll first also gets the enrollment in the challenge.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Login and get an access token",
+                "parameters": [
+                    {
+                        "description": "Login request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.loginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Succesfully logged in or challenged",
+                        "schema": {
+                            "$ref": "#/definitions/http.authResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed login attempts error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login/2fa": {
+            "post": {
+                "description": "Completes a login challenged for a two-factor code with a code from the authenticator app or an unused recovery code, and returns a short-lived access token and a refresh token for the device the login was challenged on. A challenge with an enrollment only accepts a code from the authenticator app, which enables two-factor authentication. A challenge expires after 5 minutes or 5 invalid codes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Complete a login with a two-factor code",
+                "parameters": [
+                    {
+                        "description": "Complete two-factor request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.completeTwoFactorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Succesfully logged in",
+                        "schema": {
+                            "$ref": "#/definitions/http.authResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many invalid codes error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the access token of the request and the refresh tokens obtained from the same login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "200": {
+                        "description": "Succesfully logged out",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes all access tokens and refresh tokens of the current user, on every device.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Logout everywhere",
+                "responses": {
+                    "200": {
+                        "description": "Succesfully logged out everywhere",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the password of the current user if the current password is correct. Every access token and refresh token of the user is revoked, so the user has to log in again. Incorrect current passwords count as failed login attempts, delaying and then locking out further attempts for the user and the client IP address.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Change the password of the current user",
+                "parameters": [
+                    {
+                        "description": "Change password request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.changePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password changed",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Incorrect current password error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed login attempts error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password-reset": {
+            "post": {
+                "description": "Sends a single-use password reset token that expires to the user with the email. Succeeds whether or not the email is registered, and sends at most one token per email a minute. The token is sent after the response, so the response time does not reveal whether the email is registered either.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Request a password reset",
+                "parameters": [
+                    {
+                        "description": "Request password reset request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.requestPasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password reset requested",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/password-reset/confirm": {
+            "post": {
+                "description": "Replaces the password of the user a password reset token was sent to. The token can only be used once, and every access token, refresh token and other password reset token of the user is revoked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Reset a password",
+                "parameters": [
+                    {
+                        "description": "Reset password request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.resetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password reset",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired password reset token error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/pin": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the numeric PIN the current user logs in with on a registered terminal, if their role allows PIN login, replacing the previous one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Set the PIN of the current user",
+                "parameters": [
+                    {
+                        "description": "Set PIN request",
+                        "name": "setPINRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.setPINRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PIN set",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/pin-login": {
+            "post": {
+                "description": "Logs in a user whose role allows PIN login with a numeric PIN and returns a short-lived access token and a refresh token for the terminal. Only accepted with the credential of a registered terminal. Like a login with a password, only a two-factor challenge is returned instead if the user has to pass two-factor authentication.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Login with a PIN on a registered terminal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Terminal credential",
+                        "name": "X-Terminal-Credential",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "PIN login request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.pinLoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Succesfully logged in or challenged",
+                        "schema": {
+                            "$ref": "#/definitions/http.authResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed login attempts error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/refresh": {
+            "post": {
+                "description": "Replaces a refresh token with a new one and returns it with a new access token. A refresh token can only be used once; using it again revokes every token obtained from the same login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Refresh an access token",
+                "parameters": [
+                    {
+                        "description": "Refresh request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.refreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Succesfully refreshed",
+                        "schema": {
+                            "$ref": "#/definitions/http.authResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a user by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User displayed",
+                        "schema": {
+                            "$ref": "#/definitions/http.userResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update a user's name, email, password, or role by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update user request",
+                        "name": "updateUserRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.updateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated",
+                        "schema": {
+                            "$ref": "#/definitions/http.userResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a user by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User deleted",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/2fa": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the two-factor authentication and recovery codes of a user who lost access to them. A user whose two-factor authentication is enforced enrolls again on the next login.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Reset the two-factor authentication of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Two-factor authentication reset",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lifts the lockout after too many failed login attempts with the email or PIN of a user and forgets those attempts. Lockouts of client IP addresses expire on their own.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Unlock the login of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login unlocked",
+                        "schema": {
+                            "$ref": "#/definitions/http.response"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Data not found error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/http.errorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.BarcodeType": {
+            "type": "string",
+            "enum": [
+                "standard",
+                "weight",
+                "price"
+            ],
+            "x-enum-varnames": [
+                "BarcodeStandard",
+                "BarcodeWeight",
+                "BarcodePrice"
+            ]
+        },
+        "domain.CustomerGroup": {
+            "type": "string",
+            "enum": [
+                "retail",
+                "wholesale",
+                "staff"
+            ],
+            "x-enum-varnames": [
+                "CustomerRetail",
+                "CustomerWholesale",
+                "CustomerStaff"
+            ]
+        },
+        "domain.PaymentType": {
+            "type": "string",
+            "enum": [
+                "CASH",
+                "E-WALLET",
+                "EDC"
+            ],
+            "x-enum-varnames": [
+                "Cash",
+                "EWallet",
+                "EDC"
+            ]
+        },
+        "domain.Permission": {
+            "type": "string",
+            "enum": [
+                "users.manage",
+                "roles.manage",
+                "terminals.manage",
+                "payments.manage",
+                "categories.manage",
+                "products.manage",
+                "products.edit_price",
+                "catalog.manage",
+                "inventory.manage",
+                "price_lists.manage",
+                "orders.customer_group",
+                "reports.view",
+                "auth.pin_login"
+            ],
+            "x-enum-varnames": [
+                "UsersManage",
+                "RolesManage",
+                "TerminalsManage",
+                "PaymentsManage",
+                "CategoriesManage",
+                "ProductsManage",
+                "ProductsEditPrice",
+                "CatalogManage",
+                "InventoryManage",
+                "PriceListsManage",
+                "OrdersCustomerGroup",
+                "ReportsView",
+                "AuthPINLogin"
+            ]
+        },
+        "domain.SerialStatus": {
+            "type": "string",
+            "enum": [
+                "in_stock",
+                "sold"
+            ],
+            "x-enum-varnames": [
+                "SerialInStock",
+                "SerialSold"
+            ]
+        },
+        "domain.StockMovementType": {
+            "type": "string",
+            "enum": [
+                "transfer_out",
+                "transfer_in"
+            ],
+            "x-enum-varnames": [
+                "StockTransferOut",
+                "StockTransferIn"
+            ]
+        },
+        "domain.TransferStatus": {
+            "type": "string",
+            "enum": [
+                "requested",
+                "in_transit",
+                "received",
+                "canceled"
+            ],
+            "x-enum-varnames": [
+                "TransferRequested",
+                "TransferInTransit",
+                "TransferReceived",
+                "TransferCanceled"
+            ]
+        },
+        "domain.UserRole": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "cashier"
+            ],
+            "x-enum-varnames": [
+                "Admin",
+                "Cashier"
+            ]
+        },
+        "domain.ValuationMethod": {
+            "type": "string",
+            "enum": [
+                "average",
+                "fifo"
+            ],
+            "x-enum-varnames": [
+                "ValuationAverage",
+                "ValuationFIFO"
+            ]
+        },
+        "http.authResponse": {
+            "type": "object",
+            "properties": {
+                "refresh_expires_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "refresh_token": {
+                    "type": "string",
+                    "example": "5Jt4ZGl7WZQ1xV0yqk0c0pK3yC8m0A1uYkq2mX8bXhA"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "v2.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."
+                },
+                "two_factor": {
+                    "$ref": "#/definitions/http.twoFactorChallengeResponse"
+                }
+            }
+        },
+        "http.barcodeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "8991234567891"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BarcodeType"
+                        }
+                    ],
+                    "example": "standard"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.barcodeScanResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "2100123012343"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 61700
+                },
+                "product": {
+                    "$ref": "#/definitions/http.productResponse"
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 1.234
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BarcodeType"
+                        }
+                    ],
+                    "example": "weight"
+                }
+            }
+        },
+        "http.bundleComponentRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "qty"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 2
+                }
+            }
+        },
+        "http.bundleComponentResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Chiki Ball"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 2
+                }
+            }
+        },
+        "http.catalogImportResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.catalogRowErrorResponse"
+                    }
+                },
+                "imported": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 35
+                }
+            }
+        },
+        "http.catalogRowErrorResponse": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "type": "string",
+                    "example": "category"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Beverages does not exist"
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "http.categoryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Foods"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "http.categoryTreeResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.categoryTreeResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Foods"
+                }
+            }
+        },
+        "http.changePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string",
+                    "example": "12345678"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "87654321"
+                }
+            }
+        },
+        "http.completeTwoFactorRequest": {
+            "type": "object",
+            "required": [
+                "challenge",
+                "code"
+            ],
+            "properties": {
+                "challenge": {
+                    "type": "string",
+                    "example": "Zk3n9QvX1c7bT2yW5pL0aR8sD4fH6jK1mN3qU5wE7rY"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "123456"
+                }
+            }
+        },
+        "http.createBarcodeRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "product_id"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "8991234567891"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BarcodeType"
+                        }
+                    ],
+                    "example": "standard"
+                }
+            }
+        },
+        "http.createCategoryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Foods"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "http.createLocationRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Warehouse"
+                }
+            }
+        },
+        "http.createLotRequest": {
+            "type": "object",
+            "required": [
+                "expires_at",
+                "lot_number",
+                "product_id",
+                "qty"
+            ],
+            "properties": {
+                "cost": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 3500
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "lot_number": {
+                    "type": "string",
+                    "example": "LOT-2024-001"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 24
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "pcs"
+                }
+            }
+        },
+        "http.createModifierGroupRequest": {
+            "type": "object",
+            "required": [
+                "max_selections",
+                "modifiers",
+                "name"
+            ],
+            "properties": {
+                "max_selections": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "min_selections": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "modifiers": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/http.modifierRequest"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Milk"
+                }
+            }
+        },
+        "http.createOrderRequest": {
+            "type": "object",
+            "required": [
+                "customer_name",
+                "payment_id",
+                "products",
+                "total_paid"
+            ],
+            "properties": {
+                "customer_group": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.CustomerGroup"
+                        }
+                    ],
+                    "example": "retail"
+                },
+                "customer_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "payment_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.orderProductRequest"
+                    }
+                },
+                "total_paid": {
+                    "type": "integer",
+                    "example": 100000
+                }
+            }
+        },
+        "http.createPaymentRequest": {
+            "type": "object",
+            "required": [
+                "logo",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "logo": {
+                    "type": "string",
+                    "example": "https://example.com/cash.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tunai"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PaymentType"
+                        }
+                    ],
+                    "example": "CASH"
+                }
+            }
+        },
+        "http.createProductRequest": {
+            "type": "object",
+            "required": [
+                "price"
+            ],
+            "properties": {
+                "barcodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "8991234567891"
+                    ]
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.bundleComponentRequest"
+                    }
+                },
+                "cost": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 3500
+                },
+                "fractional": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "image": {
+                    "type": "string",
+                    "example": "https://example.com/chiki-ball.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chiki Ball"
+                },
+                "option_values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.productOptionRequest"
+                    }
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 0
+                },
+                "price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 5000
+                },
+                "stock": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 100
+                },
+                "track_lots": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "track_serials": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "pcs"
+                },
+                "units": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.productUnitRequest"
+                    }
+                },
+                "valuation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ValuationMethod"
+                        }
+                    ],
+                    "example": "average"
+                }
+            }
+        },
+        "http.createRoleRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "permissions"
+            ],
+            "properties": {
+                "name": {
+                    "maxLength": 50,
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.UserRole"
+                        }
+                    ],
+                    "example": "supervisor"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Permission"
+                    },
+                    "example": [
+                        "products.edit_price",
+                        "reports.view"
+                    ]
+                }
+            }
+        },
+        "http.createSerialsRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "serial_numbers"
+            ],
+            "properties": {
+                "cost": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 1500000
+                },
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "serial_numbers": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SN-0001",
+                        "SN-0002"
+                    ]
+                }
+            }
+        },
+        "http.createTransferRequest": {
+            "type": "object",
+            "required": [
+                "from_location_id",
+                "items",
+                "to_location_id"
+            ],
+            "properties": {
+                "from_location_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/http.transferItemRequest"
+                    }
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Weekly restock"
+                },
+                "to_location_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
+                }
+            }
+        },
+        "http.errorResponse": {
+            "type": "object",
+            "properties": {
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Error message 1",
+                        " Error message 2"
+                    ]
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "http.inventoryValuationResponse": {
+            "type": "object",
+            "properties": {
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.stockValuationResponse"
+                    }
+                },
+                "value": {
+                    "type": "number",
+                    "example": 350000
+                }
+            }
+        },
+        "http.locationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Warehouse"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.loginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "device_name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Register 1"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "12345678"
+                }
+            }
+        },
+        "http.lotResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "expired": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lot_number": {
+                    "type": "string",
+                    "example": "LOT-2024-001"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "Chiki Ball"
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 24
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.marginReportResponse": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "number",
+                    "example": 420000
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "margin": {
+                    "type": "number",
+                    "example": 180000
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.productMarginResponse"
+                    }
+                },
+                "revenue": {
+                    "type": "number",
+                    "example": 600000
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-02-01T00:00:00Z"
+                }
+            }
+        },
+        "http.meta": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "skip": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "http.modifierGroupResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "max_selections": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "min_selections": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.modifierResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Milk"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "required": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.modifierRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "ingredient_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
+                },
+                "ingredient_qty": {
+                    "type": "number",
+                    "example": 0.2
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Oat milk"
+                },
+                "price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 5000
+                }
+            }
+        },
+        "http.modifierResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ingredient_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "ingredient_qty": {
+                    "type": "number",
+                    "example": 0.2
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Oat milk"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 5000
+                }
+            }
+        },
+        "http.moveCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "parent_id": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                }
+            }
+        },
+        "http.orderModifierResponse": {
+            "type": "object",
+            "properties": {
+                "group_name": {
+                    "type": "string",
+                    "example": "Milk"
+                },
+                "modifier_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Oat milk"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 5000
+                }
+            }
+        },
+        "http.orderProductRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "qty",
+                "serial_numbers"
+            ],
+            "properties": {
+                "modifier_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1
+                    ]
+                },
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 1
+                },
+                "serial_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SN-0001"
+                    ]
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "pcs"
+                }
+            }
+        },
+        "http.orderProductResponse": {
+            "type": "object",
+            "properties": {
+                "base_qty": {
+                    "type": "number",
+                    "example": 1
+                },
+                "cost": {
+                    "type": "number",
+                    "example": 70000
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "margin": {
+                    "type": "number",
+                    "example": 30000
+                },
+                "modifiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.orderModifierResponse"
+                    }
+                },
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "price": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "price_list_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product": {
+                    "$ref": "#/definitions/http.productResponse"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 1
+                },
+                "serial_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "SN-0001"
+                    ]
+                },
+                "total_final_price": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "total_normal_price": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "pcs"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.orderResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "customer_group": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.CustomerGroup"
+                        }
+                    ],
+                    "example": "retail"
+                },
+                "customer_name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "gross_margin": {
+                    "type": "number",
+                    "example": 30000
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "payment_type": {
+                    "$ref": "#/definitions/http.paymentResponse"
+                },
+                "payment_type_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.orderProductResponse"
+                    }
+                },
+                "receipt_id": {
+                    "type": "string",
+                    "example": "4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"
+                },
+                "total_cost": {
+                    "type": "number",
+                    "example": 70000
+                },
+                "total_paid": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "total_price": {
+                    "type": "number",
+                    "example": 100000
+                },
+                "total_return": {
+                    "type": "number",
+                    "example": 0
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "http.paymentResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "logo": {
+                    "type": "string",
+                    "example": "https://example.com/cash.png"
+                },
+                "logo_thumbnail": {
+                    "type": "string",
+                    "example": "https://example.com/cash_thumb.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Tunai"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.PaymentType"
+                        }
+                    ],
+                    "example": "CASH"
+                }
+            }
+        },
+        "http.pinLoginRequest": {
+            "type": "object",
+            "required": [
+                "pin",
+                "user_id"
+            ],
+            "properties": {
+                "pin": {
+                    "type": "string",
+                    "maxLength": 8,
+                    "minLength": 4,
+                    "example": "1234"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "http.priceListItemRequest": {
+            "type": "object",
+            "required": [
+                "product_id"
+            ],
+            "properties": {
+                "price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 4000
+                },
+                "product_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "http.priceListItemResponse": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "number",
+                    "example": 4000
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "http.priceListRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "name"
+            ],
+            "properties": {
+                "customer_group": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.CustomerGroup"
+                        }
+                    ],
+                    "example": "retail"
+                },
+                "end_time": {
+                    "type": "string",
+                    "example": "18:00"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.priceListItemRequest"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Happy Hour"
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "start_time": {
+                    "type": "string",
+                    "example": "16:00"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.priceListResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "customer_group": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.CustomerGroup"
+                        }
+                    ],
+                    "example": "retail"
+                },
+                "end_time": {
+                    "type": "string",
+                    "example": "18:00"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.priceListItemResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Happy Hour"
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "start_time": {
+                    "type": "string",
+                    "example": "16:00"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.productMarginResponse": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "number",
+                    "example": 420000
+                },
+                "margin": {
+                    "type": "number",
+                    "example": 180000
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chiki Ball"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 120
+                },
+                "revenue": {
+                    "type": "number",
+                    "example": 600000
+                }
+            }
+        },
+        "http.productOptionRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "values"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Size"
+                },
+                "values": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "S",
+                        "M",
+                        "L"
+                    ]
+                }
+            }
+        },
+        "http.productOptionResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Size"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "S",
+                        "M",
+                        "L"
+                    ]
+                }
+            }
+        },
+        "http.productPriceResponse": {
+            "type": "object",
+            "properties": {
+                "customer_group": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.CustomerGroup"
+                        }
+                    ],
+                    "example": "wholesale"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 4000
+                },
+                "price_list": {
+                    "$ref": "#/definitions/http.priceListResponse"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "http.productResponse": {
+            "type": "object",
+            "properties": {
+                "barcodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "8991234567891"
+                    ]
+                },
+                "category": {
+                    "$ref": "#/definitions/http.categoryResponse"
+                },
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.bundleComponentResponse"
+                    }
+                },
+                "cost": {
+                    "type": "number",
+                    "example": 3500
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "fractional": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "image": {
+                    "type": "string",
+                    "example": "https://example.com/chiki-ball.png"
+                },
+                "is_bundle": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chiki Ball"
+                },
+                "option_values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.productOptionResponse"
+                    }
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "price": {
+                    "type": "number",
+                    "example": 5000
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "9a4c25d3-9786-492c-b084-85cb75c1ee3e"
+                },
+                "stock": {
+                    "type": "number",
+                    "example": 100
+                },
+                "thumbnail": {
+                    "type": "string",
+                    "example": "https://example.com/chiki-ball_thumb.png"
+                },
+                "track_lots": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "track_serials": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "pcs"
+                },
+                "units": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.productUnitResponse"
+                    }
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "valuation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ValuationMethod"
+                        }
+                    ],
+                    "example": "average"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.productResponse"
+                    }
+                }
+            }
+        },
+        "http.productUnitRequest": {
+            "type": "object",
+            "required": [
+                "factor",
+                "name"
+            ],
+            "properties": {
+                "factor": {
+                    "type": "number",
+                    "example": 24
+                },
+                "name": {
+                    "type": "string",
+                    "example": "carton"
+                }
+            }
+        },
+        "http.productUnitResponse": {
+            "type": "object",
+            "properties": {
+                "factor": {
+                    "type": "number",
+                    "example": 24
+                },
+                "name": {
+                    "type": "string",
+                    "example": "carton"
+                }
+            }
+        },
+        "http.publicKeyResponse": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "example": "1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2"
+                },
+                "kid": {
+                    "type": "string",
+                    "example": "5e7bb340"
+                },
+                "paserk": {
+                    "type": "string",
+                    "example": "k4.public.Hrnbu7wEfAP9cGBOAHHwmH4Wsot1ciXBHwBBXQ4gsaI"
+                },
+                "purpose": {
+                    "type": "string",
+                    "example": "public"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "v4"
+                }
+            }
+        },
+        "http.receiveProductRequest": {
+            "type": "object",
+            "required": [
+                "qty"
+            ],
+            "properties": {
+                "cost": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 84000
+                },
+                "qty": {
+                    "type": "number",
+                    "example": 2
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "carton"
+                }
+            }
+        },
+        "http.receiveTransferRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/http.receivedItemRequest"
+                    }
                 }
             }
         },
-        "http.createOrderRequest": {
+        "http.receivedItemRequest": {
             "type": "object",
             "required": [
-                "customer_name",
-                "payment_id",
-                "products",
-                "total_paid"
+                "product_id",
+                "received_qty"
             ],
             "properties": {
-                "customer_name": {
-                    "type": "string",
-                    "example": "John Doe"
-                },
-                "payment_id": {
+                "product_id": {
                     "type": "integer",
+                    "minimum": 1,
                     "example": 1
                 },
-                "products": {
+                "received_qty": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 23
+                }
+            }
+        },
+        "http.recoveryCodesResponse": {
+            "type": "object",
+            "properties": {
+                "recovery_codes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/http.orderProductRequest"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "k7qz-m2xa-4nvb-p9cd"
+                    ]
+                }
+            }
+        },
+        "http.refreshRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "device_name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Register 1"
                 },
-                "total_paid": {
-                    "type": "integer",
-                    "example": 100000
+                "refresh_token": {
+                    "type": "string",
+                    "example": "5Jt4ZGl7WZQ1xV0yqk0c0pK3yC8m0A1uYkq2mX8bXhA"
                 }
             }
         },
-        "http.createPaymentRequest": {
+        "http.registerRequest": {
             "type": "object",
             "required": [
-                "logo",
+                "email",
                 "name",
-                "type"
+                "password"
             ],
             "properties": {
-                "logo": {
+                "email": {
                     "type": "string",
-                    "example": "https://example.com/cash.png"
+                    "example": "test@example.com"
                 },
                 "name": {
                     "type": "string",
-                    "example": "Tunai"
+                    "example": "John Doe"
                 },
-                "type": {
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "12345678"
+                }
+            }
+        },
+        "http.registerTerminalRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Register 1"
+                }
+            }
+        },
+        "http.requestPasswordResetRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "test@example.com"
+                }
+            }
+        },
+        "http.resetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "87654321"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "q3Zc0b8m1TQ2yXk9lV4pN7aH5sJ6dR0eW1uC3fG8iKo"
+                }
+            }
+        },
+        "http.response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string",
+                    "example": "Success"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "http.roleResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/domain.PaymentType"
+                            "$ref": "#/definitions/domain.UserRole"
                         }
                     ],
-                    "example": "CASH"
+                    "example": "supervisor"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Permission"
+                    },
+                    "example": [
+                        "products.edit_price",
+                        "reports.view"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
                 }
             }
         },
-        "http.createProductRequest": {
+        "http.schedulePriceRequest": {
             "type": "object",
             "required": [
-                "category_id",
-                "image",
-                "name",
+                "effective_at",
                 "price",
-                "stock"
+                "product_id"
             ],
             "properties": {
-                "category_id": {
+                "effective_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 5500
+                },
+                "product_id": {
                     "type": "integer",
                     "minimum": 1,
                     "example": 1
+                }
+            }
+        },
+        "http.scheduledPriceResponse": {
+            "type": "object",
+            "properties": {
+                "applied_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:30Z"
                 },
-                "image": {
+                "created_at": {
                     "type": "string",
-                    "example": "https://example.com/chiki-ball.png"
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "effective_at": {
+                    "type": "string",
+                    "example": "2024-12-31T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "price": {
+                    "type": "number",
+                    "example": 5500
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "http.serialResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "Smartphone X"
+                },
+                "receipt_id": {
+                    "type": "string",
+                    "example": "4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"
+                },
+                "serial_number": {
+                    "type": "string",
+                    "example": "SN-0001"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.SerialStatus"
+                        }
+                    ],
+                    "example": "sold"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                }
+            }
+        },
+        "http.setPINRequest": {
+            "type": "object",
+            "required": [
+                "pin"
+            ],
+            "properties": {
+                "pin": {
+                    "type": "string",
+                    "maxLength": 8,
+                    "minLength": 4,
+                    "example": "1234"
+                }
+            }
+        },
+        "http.stockMovementResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "location_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "qty": {
+                    "type": "number",
+                    "example": -24
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.StockMovementType"
+                        }
+                    ],
+                    "example": "transfer_out"
+                }
+            }
+        },
+        "http.stockValuationResponse": {
+            "type": "object",
+            "properties": {
+                "average_cost": {
+                    "type": "number",
+                    "example": 3500
                 },
                 "name": {
                     "type": "string",
                     "example": "Chiki Ball"
                 },
-                "price": {
-                    "type": "number",
-                    "minimum": 0,
-                    "example": 5000
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "stock": {
-                    "type": "integer",
-                    "minimum": 0,
+                    "type": "number",
                     "example": 100
-                }
-            }
-        },
-        "http.errorResponse": {
-            "type": "object",
-            "properties": {
-                "messages": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Error message 1",
-                        " Error message 2"
-                    ]
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": false
+                "unit": {
+                    "type": "string",
+                    "example": "pcs"
+                },
+                "valuation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ValuationMethod"
+                        }
+                    ],
+                    "example": "average"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 350000
                 }
             }
         },
-        "http.loginRequest": {
+        "http.terminalResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "created_at": {
                     "type": "string",
-                    "example": "test@example.com"
+                    "example": "1970-01-01T00:00:00Z"
                 },
-                "password": {
+                "credential": {
                     "type": "string",
-                    "minLength": 8,
-                    "example": "12345678"
-                }
-            }
-        },
-        "http.meta": {
-            "type": "object",
-            "properties": {
-                "limit": {
-                    "type": "integer",
-                    "example": 10
+                    "example": "Zk9xN2pUQ0l0dWZqV3JmYnJ0cEhQd3lWQ2NkS3h6bEE"
                 },
-                "skip": {
+                "id": {
                     "type": "integer",
-                    "example": 0
+                    "example": 1
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 100
+                "name": {
+                    "type": "string",
+                    "example": "Register 1"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
                 }
             }
         },
-        "http.orderProductRequest": {
+        "http.transferItemRequest": {
             "type": "object",
             "required": [
                 "product_id",
@@ -1781,98 +8350,102 @@ const docTemplate = `{
                     "example": 1
                 },
                 "qty": {
-                    "type": "integer",
-                    "example": 1
+                    "type": "number",
+                    "example": 24
                 }
             }
         },
-        "http.orderProductResponse": {
+        "http.transferItemResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string",
-                    "example": "1970-01-01T00:00:00Z"
-                },
-                "id": {
-                    "type": "integer",
+                "discrepancy": {
+                    "type": "number",
                     "example": 1
                 },
-                "order_id": {
+                "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "price": {
-                    "type": "number",
-                    "example": 100000
-                },
-                "product": {
-                    "$ref": "#/definitions/http.productResponse"
-                },
                 "product_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "qty": {
-                    "type": "integer",
-                    "example": 1
+                "product_name": {
+                    "type": "string",
+                    "example": "Chiki Ball"
                 },
-                "total_final_price": {
+                "qty": {
                     "type": "number",
-                    "example": 100000
+                    "example": 24
                 },
-                "total_normal_price": {
+                "received_qty": {
                     "type": "number",
-                    "example": 100000
+                    "example": 23
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "1970-01-01T00:00:00Z"
+                "unit_cost": {
+                    "type": "number",
+                    "example": 3500
                 }
             }
         },
-        "http.orderResponse": {
+        "http.transferResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string",
                     "example": "1970-01-01T00:00:00Z"
                 },
-                "customer_name": {
+                "dispatched_at": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "1970-01-01T00:00:00Z"
                 },
-                "id": {
+                "from_location_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "payment_type": {
-                    "$ref": "#/definitions/http.paymentResponse"
+                "from_location_name": {
+                    "type": "string",
+                    "example": "Store"
                 },
-                "payment_type_id": {
+                "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "products": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/http.orderProductResponse"
+                        "$ref": "#/definitions/http.transferItemResponse"
                     }
                 },
-                "receipt_id": {
+                "movements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/http.stockMovementResponse"
+                    }
+                },
+                "note": {
                     "type": "string",
-                    "example": "4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"
+                    "example": "Weekly restock"
                 },
-                "total_paid": {
-                    "type": "number",
-                    "example": 100000
+                "received_at": {
+                    "type": "string",
+                    "example": "1970-01-01T00:00:00Z"
                 },
-                "total_price": {
-                    "type": "number",
-                    "example": 100000
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.TransferStatus"
+                        }
+                    ],
+                    "example": "in_transit"
                 },
-                "total_return": {
-                    "type": "number",
-                    "example": 0
+                "to_location_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "to_location_name": {
+                    "type": "string",
+                    "example": "Warehouse"
                 },
                 "updated_at": {
                     "type": "string",
@@ -1884,105 +8457,54 @@ const docTemplate = `{
                 }
             }
         },
-        "http.paymentResponse": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "logo": {
-                    "type": "string",
-                    "example": "https://example.com/cash.png"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "Tunai"
-                },
-                "type": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/domain.PaymentType"
-                        }
-                    ],
-                    "example": "CASH"
-                }
-            }
-        },
-        "http.productResponse": {
+        "http.twoFactorChallengeResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/http.categoryResponse"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "1970-01-01T00:00:00Z"
-                },
-                "id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "image": {
-                    "type": "string",
-                    "example": "https://example.com/chiki-ball.png"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "Chiki Ball"
-                },
-                "price": {
-                    "type": "number",
-                    "example": 5000
-                },
-                "sku": {
+                "challenge": {
                     "type": "string",
-                    "example": "9a4c25d3-9786-492c-b084-85cb75c1ee3e"
+                    "example": "Zk3n9QvX1c7bT2yW5pL0aR8sD4fH6jK1mN3qU5wE7rY"
                 },
-                "stock": {
-                    "type": "integer",
-                    "example": 100
+                "enrollment": {
+                    "$ref": "#/definitions/http.twoFactorEnrollmentResponse"
                 },
-                "updated_at": {
+                "expires_at": {
                     "type": "string",
                     "example": "1970-01-01T00:00:00Z"
                 }
             }
         },
-        "http.registerRequest": {
+        "http.twoFactorCodeRequest": {
             "type": "object",
             "required": [
-                "email",
-                "name",
-                "password"
+                "code"
             ],
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "test@example.com"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "John Doe"
-                },
-                "password": {
+                "code": {
                     "type": "string",
-                    "minLength": 8,
-                    "example": "12345678"
+                    "maxLength": 32,
+                    "example": "123456"
                 }
             }
         },
-        "http.response": {
+        "http.twoFactorEnrollmentResponse": {
             "type": "object",
             "properties": {
-                "data": {},
-                "message": {
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "k7qz-m2xa-4nvb-p9cd"
+                    ]
+                },
+                "secret": {
                     "type": "string",
-                    "example": "Success"
+                    "example": "JBSWY3DPEHPK3PXP"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "uri": {
+                    "type": "string",
+                    "example": "otpauth://totp/go-pos:admin@example.com?algorithm=SHA1\u0026digits=6\u0026issuer=go-pos\u0026period=30\u0026secret=JBSWY3DPEHPK3PXP"
                 }
             }
         },
@@ -2028,6 +8550,7 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "category_id",
+                "cost",
                 "image",
                 "name",
                 "price",
@@ -2039,6 +8562,11 @@ const docTemplate = `{
                     "minimum": 1,
                     "example": 1
                 },
+                "cost": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 1500
+                },
                 "image": {
                     "type": "string",
                     "example": "https://example.com/nutrisari-jeruk.png"
@@ -2053,9 +8581,44 @@ const docTemplate = `{
                     "example": 2000
                 },
                 "stock": {
-                    "type": "integer",
+                    "type": "number",
                     "minimum": 0,
                     "example": 200
+                },
+                "valuation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ValuationMethod"
+                        }
+                    ],
+                    "example": "fifo"
+                }
+            }
+        },
+        "http.updateRoleRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "maxLength": 50,
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.UserRole"
+                        }
+                    ],
+                    "example": "supervisor"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Permission"
+                    },
+                    "example": [
+                        "products.edit_price",
+                        "reports.view"
+                    ]
                 }
             }
         },
@@ -2082,6 +8645,7 @@ const docTemplate = `{
                     "example": "12345678"
                 },
                 "role": {
+                    "maxLength": 50,
                     "allOf": [
                         {
                             "$ref": "#/definitions/domain.UserRole"
@@ -0,0 +1,189 @@
+package paseto
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"aidanwoods.dev/go-paseto"
+)
+
+const (
+	// Local is the type of tokens encrypted with a symmetric key
+	Local = "local"
+	// Public is the type of tokens signed with an Ed25519 secret key
+	Public = "public"
+)
+
+/**
+ * Key is a symmetric or Ed25519 secret key identified by an id,
+ * written as "id:hex" in configuration and key files
+ */
+type Key struct {
+	ID       string
+	material []byte
+}
+
+// GenerateKey generates a new key of a token type with a random id
+func GenerateKey(tokenType string) (Key, error) {
+	id := make([]byte, 4)
+	_, err := rand.Read(id)
+	if err != nil {
+		return Key{}, err
+	}
+
+	var material []byte
+	switch tokenType {
+	case Local:
+		material = paseto.NewV4SymmetricKey().ExportBytes()
+	case Public:
+		material = paseto.NewV4AsymmetricSecretKey().ExportBytes()
+	default:
+		return Key{}, fmt.Errorf("unsupported token type %q", tokenType)
+	}
+
+	return Key{
+		ID:       hex.EncodeToString(id),
+		material: material,
+	}, nil
+}
+
+// ParseKey parses a key written as "id:hex"
+func ParseKey(s string) (Key, error) {
+	id, hexKey, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok || id == "" {
+		return Key{}, fmt.Errorf("key must be written as id:hex")
+	}
+
+	material, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return Key{}, fmt.Errorf("key %s: %w", id, err)
+	}
+
+	return Key{
+		ID:       id,
+		material: material,
+	}, nil
+}
+
+// String writes the key as "id:hex"
+func (k Key) String() string {
+	return k.ID + ":" + hex.EncodeToString(k.material)
+}
+
+// symmetric returns the key as a v4.local key
+func (k Key) symmetric() (paseto.V4SymmetricKey, error) {
+	return paseto.V4SymmetricKeyFromBytes(k.material)
+}
+
+// secret returns the key as a v4.public secret key
+func (k Key) secret() (paseto.V4AsymmetricSecretKey, error) {
+	return paseto.NewV4AsymmetricSecretKeyFromBytes(k.material)
+}
+
+// ParseKeys parses a comma separated list of keys, the first of which signs new tokens
+func ParseKeys(s string) ([]Key, error) {
+	var keys []Key
+	for _, field := range strings.Split(s, ",") {
+		if strings.TrimSpace(field) == "" {
+			continue
+		}
+
+		key, err := ParseKey(field)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// ReadKeyFile reads the keys of a key file, one per line, the first of which signs new tokens
+func ReadKeyFile(path string) ([]Key, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var keys []Key
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, err := ParseKey(line)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, scanner.Err()
+}
+
+// WriteKeyFile replaces the content of a key file with the keys, readable by its owner only
+func WriteKeyFile(path string, keys []Key) error {
+	var b strings.Builder
+	b.WriteString("# PASETO keys, the first one signs new tokens\n")
+	for _, key := range keys {
+		b.WriteString(key.String())
+		b.WriteString("\n")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.WriteString(b.String())
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// RotateKeyFile prepends a new signing key of a token type to a key file, creating it if needed,
+// and keeps at most keep keys so tokens signed by the previous ones stay valid until they expire
+func RotateKeyFile(path, tokenType string, keep int) (Key, error) {
+	if keep < 1 {
+		return Key{}, fmt.Errorf("at least one key must be kept")
+	}
+
+	keys, err := ReadKeyFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return Key{}, err
+	}
+
+	key, err := GenerateKey(tokenType)
+	if err != nil {
+		return Key{}, err
+	}
+
+	keys = append([]Key{key}, keys...)
+	if len(keys) > keep {
+		keys = keys[:keep]
+	}
+
+	err = WriteKeyFile(path, keys)
+	if err != nil {
+		return Key{}, err
+	}
+
+	return key, nil
+}
@@ -1,6 +1,11 @@
 package paseto
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 
 	"aidanwoods.dev/go-paseto"
@@ -12,16 +17,31 @@ import (
 
 /**
  * PasetoToken implements port.TokenService interface
- * and provides an access to the paseto library
+ * and provides an access to the paseto library.
+ * Every token is built from scratch, so it is safe for concurrent use
  */
 type PasetoToken struct {
-	token    *paseto.Token
-	key      *paseto.V4SymmetricKey
-	parser   *paseto.Parser
-	duration time.Duration
+	key    *Key
+	keys   map[string]paseto.V4SymmetricKey
+	parser *paseto.Parser
+	claims *claims
 }
 
-// New creates a new paseto instance
+// claims holds the standard claims set on every token and validated when verifying it
+type claims struct {
+	issuer          string
+	audience        string
+	duration        time.Duration
+	refreshDuration time.Duration
+	resetDuration   time.Duration
+}
+
+// footer is the unencrypted footer of a token, identifying the key it was encrypted or signed with
+type footer struct {
+	KeyID string `json:"kid"`
+}
+
+// New creates a new paseto instance issuing tokens of the configured type
 func New(config *config.Token) (port.TokenService, error) {
 	durationStr := config.Duration
 	duration, err := time.ParseDuration(durationStr)
@@ -29,53 +49,196 @@ func New(config *config.Token) (port.TokenService, error) {
 		return nil, domain.ErrTokenDuration
 	}
 
-	token := paseto.NewToken()
-	key := paseto.NewV4SymmetricKey()
-	parser := paseto.NewParser()
+	refreshDuration, err := time.ParseDuration(config.RefreshDuration)
+	if err != nil || refreshDuration <= duration {
+		return nil, domain.ErrTokenDuration
+	}
+
+	resetDuration, err := time.ParseDuration(config.ResetDuration)
+	if err != nil || resetDuration <= 0 {
+		return nil, domain.ErrTokenDuration
+	}
+
+	if config.Issuer == "" || config.Audience == "" {
+		return nil, domain.ErrTokenClaims
+	}
+
+	claims := &claims{
+		config.Issuer,
+		config.Audience,
+		duration,
+		refreshDuration,
+		resetDuration,
+	}
+
+	keys, err := loadKeys(config)
+	if err != nil {
+		return nil, err
+	}
+
+	switch config.Type {
+	case "", Local:
+		return newLocal(keys, claims)
+	case Public:
+		return newPublic(keys, claims)
+	default:
+		return nil, domain.ErrTokenType
+	}
+}
+
+// newLocal creates a new paseto instance issuing v4.local tokens
+func newLocal(keys []Key, claims *claims) (*PasetoToken, error) {
+	keyByID := make(map[string]paseto.V4SymmetricKey, len(keys))
+	for _, key := range keys {
+		symmetric, err := key.symmetric()
+		if err != nil {
+			return nil, domain.ErrTokenKeys
+		}
+
+		if _, ok := keyByID[key.ID]; ok {
+			return nil, domain.ErrTokenKeys
+		}
+		keyByID[key.ID] = symmetric
+	}
+
+	parser := newParser(claims)
 
 	return &PasetoToken{
-		&token,
-		&key,
+		&keys[0],
+		keyByID,
 		&parser,
-		duration,
+		claims,
 	}, nil
 }
 
-// CreateToken creates a new paseto token
-func (pt *PasetoToken) CreateToken(user *domain.User) (string, error) {
+// loadKeys loads the keys from the configuration, or from the key file when none are configured
+func loadKeys(config *config.Token) ([]Key, error) {
+	var keys []Key
+	var err error
+
+	switch {
+	case config.Keys != "":
+		keys, err = ParseKeys(config.Keys)
+	case config.KeyFile != "":
+		keys, err = ReadKeyFile(config.KeyFile)
+	}
+	if err != nil || len(keys) == 0 {
+		return nil, domain.ErrTokenKeys
+	}
+
+	return keys, nil
+}
+
+// newParser creates a parser rejecting tokens that have expired, are not valid yet,
+// were issued by or for someone else, or whose subject is not the user of their payload
+func newParser(claims *claims) paseto.Parser {
+	return paseto.MakeParser([]paseto.Rule{
+		paseto.NotExpired(),
+		paseto.NotBeforeNbf(),
+		paseto.IssuedBy(claims.issuer),
+		paseto.ForAudience(claims.audience),
+		subjectIsPayloadUser(),
+	})
+}
+
+// subjectIsPayloadUser requires that the "sub" field of the token is the id of the user of its payload
+func subjectIsPayloadUser() paseto.Rule {
+	return func(token paseto.Token) error {
+		subject, err := token.GetSubject()
+		if err != nil {
+			return err
+		}
+
+		var payload domain.TokenPayload
+		err = token.Get("payload", &payload)
+		if err != nil {
+			return err
+		}
+
+		if subject != strconv.FormatUint(payload.UserID, 10) {
+			return fmt.Errorf("this token is not about user `%d'. `%s' found", payload.UserID, subject)
+		}
+
+		return nil
+	}
+}
+
+// newClaims creates a new token with the payload, standard and footer claims for a user with permissions and session
+func newClaims(user *domain.User, permissions []domain.Permission, sessionID uuid.UUID, claims *claims, keyID string) (*paseto.Token, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
-		return "", domain.ErrTokenCreation
+		return nil, err
 	}
 
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(claims.duration)
+
 	payload := &domain.TokenPayload{
-		ID:     id,
-		UserID: user.ID,
-		Role:   user.Role,
+		ID:          id,
+		UserID:      user.ID,
+		Role:        user.Role,
+		Permissions: permissions,
+		SessionID:   sessionID,
+		IssuedAt:    issuedAt,
+		ExpiredAt:   expiredAt,
 	}
 
-	err = pt.token.Set("payload", payload)
+	token := paseto.NewToken()
+
+	err = token.Set("payload", payload)
 	if err != nil {
-		return "", domain.ErrTokenCreation
+		return nil, err
 	}
 
-	issuedAt := time.Now()
-	expiredAt := issuedAt.Add(pt.duration)
+	token.SetJti(id.String())
+	token.SetIssuer(claims.issuer)
+	token.SetAudience(claims.audience)
+	token.SetSubject(strconv.FormatUint(user.ID, 10))
+	token.SetIssuedAt(issuedAt)
+	token.SetNotBefore(issuedAt)
+	token.SetExpiration(expiredAt)
 
-	pt.token.SetIssuedAt(issuedAt)
-	pt.token.SetNotBefore(issuedAt)
-	pt.token.SetExpiration(expiredAt)
+	footer, err := json.Marshal(footer{KeyID: keyID})
+	if err != nil {
+		return nil, err
+	}
 
-	token := pt.token.V4Encrypt(*pt.key, nil)
+	token.SetFooter(footer)
 
-	return token, nil
+	return &token, nil
 }
 
-// VerifyToken verifies the paseto token
-func (pt *PasetoToken) VerifyToken(token string) (*domain.TokenPayload, error) {
+// newOpaqueToken creates a new random token, such as a refresh or password reset token, valid for the duration
+func newOpaqueToken(duration time.Duration) (string, time.Time, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", time.Time{}, domain.ErrTokenCreation
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), time.Now().Add(duration), nil
+}
+
+// keyID reads the id of the key a token claims to be encrypted or signed with, before verifying it
+func keyID(parser *paseto.Parser, protocol paseto.Protocol, token string) (string, error) {
+	rawFooter, err := parser.UnsafeParseFooter(protocol, token)
+	if err != nil {
+		return "", domain.ErrInvalidToken
+	}
+
+	var footer footer
+	err = json.Unmarshal(rawFooter, &footer)
+	if err != nil {
+		return "", domain.ErrInvalidToken
+	}
+
+	return footer.KeyID, nil
+}
+
+// getPayload returns the payload of a parsed token
+func getPayload(parsedToken *paseto.Token, err error) (*domain.TokenPayload, error) {
 	var payload *domain.TokenPayload
 
-	parsedToken, err := pt.parser.ParseV4Local(*pt.key, token, nil)
 	if err != nil {
 		if err.Error() == "this token has expired" {
 			return nil, domain.ErrExpiredToken
@@ -90,3 +253,43 @@ func (pt *PasetoToken) VerifyToken(token string) (*domain.TokenPayload, error) {
 
 	return payload, nil
 }
+
+// CreateToken creates a new paseto token
+func (pt *PasetoToken) CreateToken(user *domain.User, permissions []domain.Permission, sessionID uuid.UUID) (string, error) {
+	token, err := newClaims(user, permissions, sessionID, pt.claims, pt.key.ID)
+	if err != nil {
+		return "", domain.ErrTokenCreation
+	}
+
+	return token.V4Encrypt(pt.keys[pt.key.ID], nil), nil
+}
+
+// VerifyToken verifies the paseto token
+func (pt *PasetoToken) VerifyToken(token string) (*domain.TokenPayload, error) {
+	id, err := keyID(pt.parser, paseto.V4Local, token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := pt.keys[id]
+	if !ok {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return getPayload(pt.parser.ParseV4Local(key, token, nil))
+}
+
+// CreateRefreshToken creates a new refresh token
+func (pt *PasetoToken) CreateRefreshToken() (string, time.Time, error) {
+	return newOpaqueToken(pt.claims.refreshDuration)
+}
+
+// CreatePasswordResetToken creates a new password reset token
+func (pt *PasetoToken) CreatePasswordResetToken() (string, time.Time, error) {
+	return newOpaqueToken(pt.claims.resetDuration)
+}
+
+// PublicKeys returns no keys, as local tokens can only be verified with the secret key
+func (pt *PasetoToken) PublicKeys() []domain.PublicKey {
+	return nil
+}
@@ -0,0 +1,452 @@
+package paseto_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gopaseto "aidanwoods.dev/go-paseto"
+	"github.com/bagashiz/go-pos/internal/adapter/auth/paseto"
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTokenService creates a token service of a token type with a new key
+func newTokenService(t *testing.T, tokenType, duration string) (port.TokenService, paseto.Key) {
+	t.Helper()
+
+	key, err := paseto.GenerateKey(tokenType)
+	require.NoError(t, err)
+
+	ts, err := paseto.New(&config.Token{
+		Type:            tokenType,
+		Issuer:          "go-pos",
+		Audience:        "go-pos",
+		Duration:        duration,
+		RefreshDuration: "24h",
+		ResetDuration:   "30m",
+		Keys:            key.String(),
+	})
+	require.NoError(t, err)
+
+	return ts, key
+}
+
+func TestPasetoToken_CreateTokenConcurrently(t *testing.T) {
+	const logins = 50
+	const tokensPerLogin = 20
+
+	for _, tokenType := range []string{paseto.Local, paseto.Public} {
+		tokenType := tokenType
+
+		t.Run(tokenType, func(t *testing.T) {
+			t.Parallel()
+			ts, _ := newTokenService(t, tokenType, "15m")
+
+			var wg sync.WaitGroup
+			for i := 1; i <= logins; i++ {
+				user := &domain.User{
+					ID:   uint64(i),
+					Role: domain.Cashier,
+				}
+				permissions := []domain.Permission{domain.AuthPINLogin}
+				if i%2 == 0 {
+					user.Role = domain.Admin
+					permissions = domain.Permissions
+				}
+				sessionID := uuid.New()
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					for j := 0; j < tokensPerLogin; j++ {
+						token, err := ts.CreateToken(user, permissions, sessionID)
+						if err != nil {
+							t.Errorf("user %d: create token: %v", user.ID, err)
+							return
+						}
+
+						payload, err := ts.VerifyToken(token)
+						if err != nil {
+							t.Errorf("user %d: verify token: %v", user.ID, err)
+							return
+						}
+
+						if payload.UserID != user.ID || payload.Role != user.Role || payload.SessionID != sessionID || len(payload.Permissions) != len(permissions) {
+							t.Errorf("user %d: got the claims of user %d", user.ID, payload.UserID)
+							return
+						}
+					}
+				}()
+			}
+			wg.Wait()
+		})
+	}
+}
+
+func TestPasetoToken_VerifyToken(t *testing.T) {
+	ts, key := newTokenService(t, paseto.Local, "15m")
+	user := &domain.User{
+		ID:   42,
+		Role: domain.Cashier,
+	}
+
+	// sign builds a v4.local token with the key of the token service, letting each case alter its claims
+	sign := func(alter func(token *gopaseto.Token)) string {
+		hexKey := key.String()[strings.Index(key.String(), ":")+1:]
+		symmetricKey, err := gopaseto.V4SymmetricKeyFromHex(hexKey)
+		require.NoError(t, err)
+
+		now := time.Now()
+		token := gopaseto.NewToken()
+		require.NoError(t, token.Set("payload", &domain.TokenPayload{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			Role:      user.Role,
+			IssuedAt:  now,
+			ExpiredAt: now.Add(time.Minute),
+		}))
+		token.SetIssuer("go-pos")
+		token.SetAudience("go-pos")
+		token.SetSubject("42")
+		token.SetIssuedAt(now)
+		token.SetNotBefore(now)
+		token.SetExpiration(now.Add(time.Minute))
+		footer, err := json.Marshal(map[string]string{"kid": key.ID})
+		require.NoError(t, err)
+		token.SetFooter(footer)
+
+		alter(&token)
+
+		return token.V4Encrypt(symmetricKey, nil)
+	}
+
+	otherService, _ := newTokenService(t, paseto.Local, "15m")
+	otherToken, err := otherService.CreateToken(user, nil, uuid.New())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		token    string
+		expected error
+	}{
+		{
+			desc:     "Success",
+			token:    sign(func(token *gopaseto.Token) {}),
+			expected: nil,
+		},
+		{
+			desc: "Fail_OtherIssuer",
+			token: sign(func(token *gopaseto.Token) {
+				token.SetIssuer("other-pos")
+			}),
+			expected: domain.ErrInvalidToken,
+		},
+		{
+			desc: "Fail_OtherAudience",
+			token: sign(func(token *gopaseto.Token) {
+				token.SetAudience("reporting")
+			}),
+			expected: domain.ErrInvalidToken,
+		},
+		{
+			desc: "Fail_SubjectMismatch",
+			token: sign(func(token *gopaseto.Token) {
+				token.SetSubject("1")
+			}),
+			expected: domain.ErrInvalidToken,
+		},
+		{
+			desc: "Fail_NotValidYet",
+			token: sign(func(token *gopaseto.Token) {
+				token.SetNotBefore(time.Now().Add(time.Hour))
+			}),
+			expected: domain.ErrInvalidToken,
+		},
+		{
+			desc: "Fail_Expired",
+			token: sign(func(token *gopaseto.Token) {
+				token.SetExpiration(time.Now().Add(-time.Second))
+			}),
+			expected: domain.ErrExpiredToken,
+		},
+		{
+			desc:     "Fail_UnknownKey",
+			token:    otherToken,
+			expected: domain.ErrInvalidToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := ts.VerifyToken(tc.token)
+			assert.Equal(t, tc.expected, err, "Error mismatch")
+
+			if tc.expected == nil {
+				assert.Equal(t, user.ID, payload.UserID, "User mismatch")
+			}
+		})
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	key, err := paseto.GenerateKey(paseto.Local)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		input    string
+		expected string
+		isErr    bool
+	}{
+		{
+			desc:     "Success",
+			input:    key.String(),
+			expected: key.String(),
+			isErr:    false,
+		},
+		{
+			desc:     "Success_Whitespace",
+			input:    "  " + key.String() + "\n",
+			expected: key.String(),
+			isErr:    false,
+		},
+		{
+			desc:  "Fail_MissingID",
+			input: ":" + strings.Repeat("ab", 32),
+			isErr: true,
+		},
+		{
+			desc:  "Fail_MissingSeparator",
+			input: strings.Repeat("ab", 32),
+			isErr: true,
+		},
+		{
+			desc:  "Fail_InvalidHex",
+			input: "k1:not-hex",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := paseto.ParseKey(tc.input)
+			if tc.isErr {
+				assert.Error(t, err, "Error mismatch")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, key.ID, parsed.ID, "Key id mismatch")
+			assert.Equal(t, tc.expected, parsed.String(), "Key mismatch")
+		})
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	first, err := paseto.GenerateKey(paseto.Local)
+	require.NoError(t, err)
+	second, err := paseto.GenerateKey(paseto.Local)
+	require.NoError(t, err)
+
+	keys, err := paseto.ParseKeys(first.String() + ", ," + second.String() + ",")
+	require.NoError(t, err)
+	require.Len(t, keys, 2, "Keys mismatch")
+	assert.Equal(t, []string{first.String(), second.String()}, []string{keys[0].String(), keys[1].String()}, "Keys mismatch")
+
+	_, err = paseto.ParseKeys(first.String() + ",k2:not-hex")
+	assert.Error(t, err, "Error mismatch")
+
+	for _, keys := range []string{"k1:" + strings.Repeat("ab", 16), first.String() + "," + first.String()} {
+		_, err = paseto.New(&config.Token{
+			Type:            paseto.Local,
+			Issuer:          "go-pos",
+			Audience:        "go-pos",
+			Duration:        "15m",
+			RefreshDuration: "24h",
+			ResetDuration:   "30m",
+			Keys:            keys,
+		})
+		assert.Equal(t, domain.ErrTokenKeys, err, "Error mismatch")
+	}
+}
+
+func TestPasetoToken_KeyFromFooter(t *testing.T) {
+	user := &domain.User{
+		ID:   7,
+		Role: domain.Cashier,
+	}
+
+	for _, tokenType := range []string{paseto.Local, paseto.Public} {
+		tokenType := tokenType
+
+		t.Run(tokenType, func(t *testing.T) {
+			t.Parallel()
+
+			current, err := paseto.GenerateKey(tokenType)
+			require.NoError(t, err)
+			previous, err := paseto.GenerateKey(tokenType)
+			require.NoError(t, err)
+
+			// newService creates a token service signing new tokens with the first of the keys
+			newService := func(keys ...paseto.Key) port.TokenService {
+				var list []string
+				for _, key := range keys {
+					list = append(list, key.String())
+				}
+
+				ts, err := paseto.New(&config.Token{
+					Type:            tokenType,
+					Issuer:          "go-pos",
+					Audience:        "go-pos",
+					Duration:        "15m",
+					RefreshDuration: "24h",
+					ResetDuration:   "30m",
+					Keys:            strings.Join(list, ","),
+				})
+				require.NoError(t, err)
+
+				return ts
+			}
+
+			previousToken, err := newService(previous).CreateToken(user, nil, uuid.New())
+			require.NoError(t, err)
+
+			ts := newService(current, previous)
+
+			token, err := ts.CreateToken(user, nil, uuid.New())
+			require.NoError(t, err)
+			assert.Equal(t, current.ID, tokenKeyID(t, tokenType, token), "Signing key mismatch")
+
+			payload, err := ts.VerifyToken(previousToken)
+			require.NoError(t, err)
+			assert.Equal(t, user.ID, payload.UserID, "User mismatch")
+
+			// A token claiming to be signed with the current key while it was signed with the previous one
+			forgedToken := replaceFooter(t, previousToken, current.ID)
+			_, err = ts.VerifyToken(forgedToken)
+			assert.Equal(t, domain.ErrInvalidToken, err, "Error mismatch")
+
+			_, err = newService(current).VerifyToken(previousToken)
+			assert.Equal(t, domain.ErrInvalidToken, err, "Error mismatch")
+		})
+	}
+}
+
+func TestRotateKeyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.keys")
+	user := &domain.User{
+		ID:   7,
+		Role: domain.Cashier,
+	}
+
+	// newService creates a token service with the keys of the key file
+	newService := func() port.TokenService {
+		ts, err := paseto.New(&config.Token{
+			Type:            paseto.Local,
+			Issuer:          "go-pos",
+			Audience:        "go-pos",
+			Duration:        "15m",
+			RefreshDuration: "24h",
+			ResetDuration:   "30m",
+			KeyFile:         path,
+		})
+		require.NoError(t, err)
+
+		return ts
+	}
+
+	_, err := paseto.RotateKeyFile(path, paseto.Local, 0)
+	assert.Error(t, err, "Error mismatch")
+
+	first, err := paseto.RotateKeyFile(path, paseto.Local, 2)
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm(), "Key file mode mismatch")
+
+	firstToken, err := newService().CreateToken(user, nil, uuid.New())
+	require.NoError(t, err)
+
+	second, err := paseto.RotateKeyFile(path, paseto.Local, 2)
+	require.NoError(t, err)
+
+	keys, err := paseto.ReadKeyFile(path)
+	require.NoError(t, err)
+	require.Len(t, keys, 2, "Keys mismatch")
+	assert.Equal(t, []string{second.String(), first.String()}, []string{keys[0].String(), keys[1].String()}, "Keys mismatch")
+
+	ts := newService()
+
+	token, err := ts.CreateToken(user, nil, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, tokenKeyID(t, paseto.Local, token), "Signing key mismatch")
+
+	payload, err := ts.VerifyToken(firstToken)
+	require.NoError(t, err, "Token of the previous key must stay valid")
+	assert.Equal(t, user.ID, payload.UserID, "User mismatch")
+
+	_, err = paseto.RotateKeyFile(path, paseto.Local, 2)
+	require.NoError(t, err)
+
+	keys, err = paseto.ReadKeyFile(path)
+	require.NoError(t, err)
+	assert.Len(t, keys, 2, "Keys mismatch")
+
+	_, err = newService().VerifyToken(firstToken)
+	assert.Equal(t, domain.ErrInvalidToken, err, "Token of a dropped key must be rejected")
+}
+
+// tokenKeyID reads the key id in the footer of a token
+func tokenKeyID(t *testing.T, tokenType, token string) string {
+	t.Helper()
+
+	protocol := gopaseto.V4Local
+	if tokenType == paseto.Public {
+		protocol = gopaseto.V4Public
+	}
+
+	parser := gopaseto.NewParser()
+	rawFooter, err := parser.UnsafeParseFooter(protocol, token)
+	require.NoError(t, err)
+
+	var footer struct {
+		KeyID string `json:"kid"`
+	}
+	require.NoError(t, json.Unmarshal(rawFooter, &footer))
+
+	return footer.KeyID
+}
+
+// replaceFooter replaces the footer of a token with one claiming another key id
+func replaceFooter(t *testing.T, token, keyID string) string {
+	t.Helper()
+
+	footer, err := json.Marshal(map[string]string{"kid": keyID})
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 4)
+	parts[3] = base64.RawURLEncoding.EncodeToString(footer)
+
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,99 @@
+package paseto
+
+import (
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+/**
+ * PublicPasetoToken implements port.TokenService interface
+ * and issues v4.public tokens signed with Ed25519 keys,
+ * which other services can verify with the public keys only
+ */
+type PublicPasetoToken struct {
+	key        *Key
+	secretKey  paseto.V4AsymmetricSecretKey
+	keys       map[string]paseto.V4AsymmetricPublicKey
+	publicKeys []domain.PublicKey
+	parser     *paseto.Parser
+	claims     *claims
+}
+
+// newPublic creates a new paseto instance issuing v4.public tokens
+func newPublic(keys []Key, claims *claims) (*PublicPasetoToken, error) {
+	var secretKey paseto.V4AsymmetricSecretKey
+	keyByID := make(map[string]paseto.V4AsymmetricPublicKey, len(keys))
+	publicKeys := make([]domain.PublicKey, 0, len(keys))
+	for i, key := range keys {
+		secret, err := key.secret()
+		if err != nil {
+			return nil, domain.ErrTokenKeys
+		}
+		if i == 0 {
+			secretKey = secret
+		}
+
+		if _, ok := keyByID[key.ID]; ok {
+			return nil, domain.ErrTokenKeys
+		}
+		keyByID[key.ID] = secret.Public()
+		publicKeys = append(publicKeys, domain.PublicKey{
+			ID:  key.ID,
+			Key: secret.Public().ExportBytes(),
+		})
+	}
+
+	parser := newParser(claims)
+
+	return &PublicPasetoToken{
+		&keys[0],
+		secretKey,
+		keyByID,
+		publicKeys,
+		&parser,
+		claims,
+	}, nil
+}
+
+// CreateToken creates a new paseto token signed with the first key
+func (pt *PublicPasetoToken) CreateToken(user *domain.User, permissions []domain.Permission, sessionID uuid.UUID) (string, error) {
+	token, err := newClaims(user, permissions, sessionID, pt.claims, pt.key.ID)
+	if err != nil {
+		return "", domain.ErrTokenCreation
+	}
+
+	return token.V4Sign(pt.secretKey, nil), nil
+}
+
+// VerifyToken verifies the paseto token with the public key it was signed with
+func (pt *PublicPasetoToken) VerifyToken(token string) (*domain.TokenPayload, error) {
+	id, err := keyID(pt.parser, paseto.V4Public, token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := pt.keys[id]
+	if !ok {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return getPayload(pt.parser.ParseV4Public(key, token, nil))
+}
+
+// CreateRefreshToken creates a new refresh token
+func (pt *PublicPasetoToken) CreateRefreshToken() (string, time.Time, error) {
+	return newOpaqueToken(pt.claims.refreshDuration)
+}
+
+// CreatePasswordResetToken creates a new password reset token
+func (pt *PublicPasetoToken) CreatePasswordResetToken() (string, time.Time, error) {
+	return newOpaqueToken(pt.claims.resetDuration)
+}
+
+// PublicKeys returns the public keys of all configured keys, including the ones no longer signing new tokens
+func (pt *PublicPasetoToken) PublicKeys() []domain.PublicKey {
+	return pt.publicKeys
+}
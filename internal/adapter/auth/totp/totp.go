@@ -0,0 +1,46 @@
+package totp
+
+import (
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	gototp "github.com/pquerna/otp/totp"
+)
+
+/**
+ * TOTP implements port.TOTPService interface
+ * and provides an access to the otp library
+ */
+type TOTP struct {
+	issuer string
+}
+
+// New creates a new TOTP service instance, naming the issuer of the secrets shown in authenticator apps
+func New(config *config.TwoFactor) (port.TOTPService, error) {
+	if config.Issuer == "" {
+		return nil, domain.ErrTwoFactorIssuer
+	}
+
+	return &TOTP{
+		config.Issuer,
+	}, nil
+}
+
+// GenerateSecret generates a new secret of 6-digit codes changing every 30 seconds, which most authenticator apps support
+func (t *TOTP) GenerateSecret(accountName string) (string, string, error) {
+	key, err := gototp.Generate(gototp.GenerateOpts{
+		Issuer:      t.issuer,
+		AccountName: accountName,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return key.Secret(), key.URL(), nil
+}
+
+// ValidateCode checks whether a code is valid for a secret, also accepting the codes of the previous and next periods
+// to allow for clock drift
+func (t *TOTP) ValidateCode(secret, code string) bool {
+	return gototp.Validate(code, secret)
+}
@@ -0,0 +1,72 @@
+package totp_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/adapter/auth/totp"
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	gototp "github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTP_New(t *testing.T) {
+	_, err := totp.New(&config.TwoFactor{})
+	assert.Equal(t, domain.ErrTwoFactorIssuer, err, "Error mismatch")
+}
+
+func TestTOTP_ValidateCode(t *testing.T) {
+	ts, err := totp.New(&config.TwoFactor{Issuer: "go-pos"})
+	require.NoError(t, err)
+
+	secret, uri, err := ts.GenerateSecret("admin@example.com")
+	require.NoError(t, err)
+
+	provisioning, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", provisioning.Scheme, "Scheme mismatch")
+	assert.Equal(t, "totp", provisioning.Host, "Type mismatch")
+	assert.Equal(t, "go-pos", provisioning.Query().Get("issuer"), "Issuer mismatch")
+	assert.Equal(t, secret, provisioning.Query().Get("secret"), "Secret mismatch")
+
+	now := time.Now()
+	testCases := []struct {
+		desc     string
+		at       time.Time
+		expected bool
+	}{
+		{
+			desc:     "Success_CurrentPeriod",
+			at:       now,
+			expected: true,
+		},
+		{
+			desc:     "Success_PreviousPeriod",
+			at:       now.Add(-30 * time.Second),
+			expected: true,
+		},
+		{
+			desc:     "Fail_Expired",
+			at:       now.Add(-5 * time.Minute),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			code, err := gototp.GenerateCode(secret, tc.at)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, ts.ValidateCode(secret, code), "Validity mismatch")
+		})
+	}
+
+	assert.False(t, ts.ValidateCode(secret, "not-a-code"), "Validity mismatch")
+}
@@ -0,0 +1,245 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/csv"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/xuri/excelize/v2"
+)
+
+// sheetName is the name of the worksheet products are exported to in an XLSX catalog
+const sheetName = "Products"
+
+// numericColumns are the columns written as numbers rather than text in an XLSX catalog
+var numericColumns = []string{"price", "cost", "stock"}
+
+/**
+ * CatalogCodec implements port.CatalogCodec interface
+ * and provides an access to the csv and excelize libraries
+ */
+type CatalogCodec struct{}
+
+// New creates a new catalog codec instance
+func New() port.CatalogCodec {
+	return &CatalogCodec{}
+}
+
+// DecodeCatalog reads the product rows of a CSV or XLSX catalog. The first row is a header naming the columns,
+// in any order, and rows with no content are skipped. XLSX catalogs are read from their first worksheet
+func (cc *CatalogCodec) DecodeCatalog(catalog *domain.Catalog) ([]domain.CatalogRow, error) {
+	var records [][]string
+	var err error
+
+	switch catalog.Format {
+	case domain.CatalogCSV:
+		records, err = readCSV(catalog.Content)
+	case domain.CatalogXLSX:
+		records, err = readXLSX(catalog.Content)
+	default:
+		err = domain.ErrInvalidCatalog
+	}
+	if err != nil {
+		return nil, domain.ErrInvalidCatalog
+	}
+
+	if len(records) == 0 {
+		return nil, domain.ErrInvalidCatalog
+	}
+
+	header := make([]string, len(records[0]))
+	for i, column := range records[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+
+		if !slices.Contains(domain.CatalogColumns, header[i]) || slices.Contains(header[:i], header[i]) {
+			return nil, domain.ErrInvalidCatalog
+		}
+	}
+
+	var rows []domain.CatalogRow
+
+	for i, record := range records[1:] {
+		row := domain.CatalogRow{Row: i + 2}
+		empty := true
+
+		for j, value := range record {
+			value = strings.TrimSpace(value)
+			if j >= len(header) || value == "" {
+				continue
+			}
+
+			setCell(&row, header[j], value)
+			empty = false
+		}
+
+		if !empty {
+			rows = append(rows, row)
+		}
+	}
+
+	return rows, nil
+}
+
+// EncodeCatalog writes product rows under a header of every catalog column into a CSV or XLSX catalog
+func (cc *CatalogCodec) EncodeCatalog(format domain.CatalogFormat, rows []domain.CatalogRow) (*domain.Catalog, error) {
+	var content []byte
+	var err error
+
+	switch format {
+	case domain.CatalogCSV:
+		content, err = writeCSV(rows)
+	case domain.CatalogXLSX:
+		content, err = writeXLSX(rows)
+	default:
+		err = domain.ErrInvalidCatalog
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Catalog{
+		Format:  format,
+		Content: content,
+	}, nil
+}
+
+// readCSV reads the records of a CSV file, ignoring the byte order mark spreadsheet applications prepend
+func readCSV(content []byte) ([][]string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+
+	return reader.ReadAll()
+}
+
+// readXLSX reads the rows of the first worksheet of an XLSX file
+func readXLSX(content []byte) ([][]string, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, domain.ErrInvalidCatalog
+	}
+
+	return file.GetRows(sheets[0])
+}
+
+// writeCSV writes a header and product rows into a CSV file
+func writeCSV(rows []domain.CatalogRow) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+
+	err := writer.Write(domain.CatalogColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		err := writer.Write(cells(&row))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeXLSX writes a header and product rows into a worksheet of an XLSX file
+func writeXLSX(rows []domain.CatalogRow) ([]byte, error) {
+	file := excelize.NewFile()
+	defer file.Close()
+
+	err := file.SetSheetName(file.GetSheetName(0), sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, row := range append([]domain.CatalogRow{{}}, rows...) {
+		var values []any
+
+		for j, value := range cells(&row) {
+			column := domain.CatalogColumns[j]
+
+			switch {
+			case i == 0:
+				values = append(values, column)
+			case slices.Contains(numericColumns, column) && value != "":
+				number, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, number)
+			default:
+				values = append(values, value)
+			}
+		}
+
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, err
+		}
+
+		err = file.SetSheetRow(sheetName, cell, &values)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	buf, err := file.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// cells returns the cells of a product row in the order of the catalog columns
+func cells(row *domain.CatalogRow) []string {
+	return []string{
+		row.SKU,
+		row.Barcode,
+		row.Name,
+		row.Category,
+		row.Unit,
+		row.Price,
+		row.Cost,
+		row.Stock,
+	}
+}
+
+// setCell sets the cell of a product row under the given catalog column
+func setCell(row *domain.CatalogRow, column, value string) {
+	switch column {
+	case "sku":
+		row.SKU = value
+	case "barcode":
+		row.Barcode = value
+	case "name":
+		row.Name = value
+	case "category":
+		row.Category = value
+	case "unit":
+		row.Unit = value
+	case "price":
+		row.Price = value
+	case "cost":
+		row.Cost = value
+	case "stock":
+		row.Stock = value
+	}
+}
@@ -6,23 +6,35 @@ import (
 	"github.com/joho/godotenv"
 )
 
-// Container contains environment variables for the application, database, cache, token, and http server
+// Container contains environment variables for the application, database, cache, token, two-factor authentication, http server, scheduler, file storage and mail
 type (
 	Container struct {
-		App   *App
-		Token *Token
-		Redis *Redis
-		DB    *DB
-		HTTP  *HTTP
+		App       *App
+		Token     *Token
+		Redis     *Redis
+		DB        *DB
+		HTTP      *HTTP
+		Scheduler *Scheduler
+		Storage   *Storage
+		Mail      *Mail
+		TwoFactor *TwoFactor
 	}
 	// App contains all the environment variables for the application
 	App struct {
-		Name string
-		Env  string
+		Name     string
+		Env      string
+		TimeZone string
 	}
 	// Token contains all the environment variables for the token service
 	Token struct {
-		Duration string
+		Type            string
+		Issuer          string
+		Audience        string
+		Duration        string
+		RefreshDuration string
+		ResetDuration   string
+		Keys            string
+		KeyFile         string
 	}
 	// Redis contains all the environment variables for the cache service
 	Redis struct {
@@ -44,6 +56,37 @@ type (
 		URL            string
 		Port           string
 		AllowedOrigins string
+		TrustedProxies string
+	}
+	// Scheduler contains all the environment variables for the background job scheduler
+	Scheduler struct {
+		Interval string
+	}
+	// Storage contains all the environment variables for the file storage
+	Storage struct {
+		Driver    string
+		Path      string
+		URL       string
+		Endpoint  string
+		AccessKey string
+		SecretKey string
+		Bucket    string
+		Region    string
+		UseSSL    string
+	}
+	// Mail contains all the environment variables for the mail notifier
+	Mail struct {
+		Host     string
+		Port     string
+		Username string
+		Password string
+		From     string
+		ResetURL string
+	}
+	// TwoFactor contains all the environment variables for the two-factor authentication
+	TwoFactor struct {
+		Issuer       string
+		EnforceAdmin string
 	}
 )
 
@@ -57,12 +100,20 @@ func New() (*Container, error) {
 	}
 
 	app := &App{
-		Name: os.Getenv("APP_NAME"),
-		Env:  os.Getenv("APP_ENV"),
+		Name:     os.Getenv("APP_NAME"),
+		Env:      os.Getenv("APP_ENV"),
+		TimeZone: os.Getenv("APP_TIMEZONE"),
 	}
 
 	token := &Token{
-		Duration: os.Getenv("TOKEN_DURATION"),
+		Type:            os.Getenv("TOKEN_TYPE"),
+		Issuer:          os.Getenv("TOKEN_ISSUER"),
+		Audience:        os.Getenv("TOKEN_AUDIENCE"),
+		Duration:        os.Getenv("TOKEN_DURATION"),
+		RefreshDuration: os.Getenv("TOKEN_REFRESH_DURATION"),
+		ResetDuration:   os.Getenv("TOKEN_RESET_DURATION"),
+		Keys:            os.Getenv("TOKEN_KEYS"),
+		KeyFile:         os.Getenv("TOKEN_KEY_FILE"),
 	}
 
 	redis := &Redis{
@@ -84,6 +135,37 @@ func New() (*Container, error) {
 		URL:            os.Getenv("HTTP_URL"),
 		Port:           os.Getenv("HTTP_PORT"),
 		AllowedOrigins: os.Getenv("HTTP_ALLOWED_ORIGINS"),
+		TrustedProxies: os.Getenv("HTTP_TRUSTED_PROXIES"),
+	}
+
+	scheduler := &Scheduler{
+		Interval: os.Getenv("SCHEDULER_INTERVAL"),
+	}
+
+	storage := &Storage{
+		Driver:    os.Getenv("STORAGE_DRIVER"),
+		Path:      os.Getenv("STORAGE_PATH"),
+		URL:       os.Getenv("STORAGE_URL"),
+		Endpoint:  os.Getenv("STORAGE_ENDPOINT"),
+		AccessKey: os.Getenv("STORAGE_ACCESS_KEY"),
+		SecretKey: os.Getenv("STORAGE_SECRET_KEY"),
+		Bucket:    os.Getenv("STORAGE_BUCKET"),
+		Region:    os.Getenv("STORAGE_REGION"),
+		UseSSL:    os.Getenv("STORAGE_USE_SSL"),
+	}
+
+	mail := &Mail{
+		Host:     os.Getenv("MAIL_HOST"),
+		Port:     os.Getenv("MAIL_PORT"),
+		Username: os.Getenv("MAIL_USERNAME"),
+		Password: os.Getenv("MAIL_PASSWORD"),
+		From:     os.Getenv("MAIL_FROM"),
+		ResetURL: os.Getenv("MAIL_RESET_URL"),
+	}
+
+	twoFactor := &TwoFactor{
+		Issuer:       os.Getenv("TWO_FACTOR_ISSUER"),
+		EnforceAdmin: os.Getenv("TWO_FACTOR_ENFORCE_ADMIN"),
 	}
 
 	return &Container{
@@ -92,5 +174,9 @@ func New() (*Container, error) {
 		redis,
 		db,
 		http,
+		scheduler,
+		storage,
+		mail,
+		twoFactor,
 	}, nil
 }
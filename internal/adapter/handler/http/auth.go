@@ -1,6 +1,7 @@
 package http
 
 import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port"
 	"github.com/gin-gonic/gin"
 )
@@ -19,21 +20,23 @@ func NewAuthHandler(svc port.AuthService) *AuthHandler {
 
 // loginRequest represents the request body for logging in a user
 type loginRequest struct {
-	Email    string `json:"email" binding:"required,email" example:"test@example.com"`
-	Password string `json:"password" binding:"required,min=8" example:"12345678" minLength:"8"`
+	Email      string `json:"email" binding:"required,email" example:"test@example.com"`
+	Password   string `json:"password" binding:"required,min=8" example:"12345678" minLength:"8"`
+	DeviceName string `json:"device_name" binding:"omitempty,max=100" example:"Register 1"`
 }
 
 // Login godoc
 //
 //	@Summary		Login and get an access token
-//	@Description	Logs in a registered user and returns an access token if the credentials are valid.
+//	@Description	Logs in a registered user and returns a short-lived access token and a refresh token for the device if the credentials are valid. If the user has two-factor authentication enabled, or has a role that can manage users or roles while it is enforced, only a two-factor challenge is returned instead, to complete at /users/login/2fa. A user who has to enroll first also gets the enrollment in the challenge.
 //	@Tags			Users
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		loginRequest	true	"Login request body"
-//	@Success		200		{object}	authResponse	"Succesfully logged in"
+//	@Success		200		{object}	authResponse	"Succesfully logged in or challenged"
 //	@Failure		400		{object}	errorResponse	"Validation error"
 //	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		429		{object}	errorResponse	"Too many failed login attempts error"
 //	@Failure		500		{object}	errorResponse	"Internal server error"
 //	@Router			/users/login [post]
 func (ah *AuthHandler) Login(ctx *gin.Context) {
@@ -43,7 +46,7 @@ func (ah *AuthHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	token, err := ah.svc.Login(ctx, req.Email, req.Password)
+	token, err := ah.svc.Login(ctx, req.Email, req.Password, newDevice(ctx, req.DeviceName))
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -53,3 +56,202 @@ func (ah *AuthHandler) Login(ctx *gin.Context) {
 
 	handleSuccess(ctx, rsp)
 }
+
+// terminalCredentialHeader is the header a registered terminal sends its credential in
+const terminalCredentialHeader = "X-Terminal-Credential"
+
+// pinLoginRequest represents the request body for logging in a cashier with a PIN
+type pinLoginRequest struct {
+	UserID uint64 `json:"user_id" binding:"required,min=1" example:"1"`
+	PIN    string `json:"pin" binding:"required,numeric,min=4,max=8" example:"1234" minLength:"4" maxLength:"8"`
+}
+
+// LoginWithPIN godoc
+//
+//	@Summary		Login with a PIN on a registered terminal
+//	@Description	Logs in a user whose role allows PIN login with a numeric PIN and returns a short-lived access token and a refresh token for the terminal. Only accepted with the credential of a registered terminal. Like a login with a password, only a two-factor challenge is returned instead if the user has to pass two-factor authentication.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Terminal-Credential	header		string			true	"Terminal credential"
+//	@Param			request					body		pinLoginRequest	true	"PIN login request body"
+//	@Success		200						{object}	authResponse	"Succesfully logged in or challenged"
+//	@Failure		400						{object}	errorResponse	"Validation error"
+//	@Failure		401						{object}	errorResponse	"Unauthorized error"
+//	@Failure		429						{object}	errorResponse	"Too many failed login attempts error"
+//	@Failure		500						{object}	errorResponse	"Internal server error"
+//	@Router			/users/pin-login [post]
+func (ah *AuthHandler) LoginWithPIN(ctx *gin.Context) {
+	var req pinLoginRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	credential := ctx.GetHeader(terminalCredentialHeader)
+
+	token, err := ah.svc.LoginWithPIN(ctx, credential, req.UserID, req.PIN, newDevice(ctx, ""))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAuthResponse(token)
+
+	handleSuccess(ctx, rsp)
+}
+
+// refreshRequest represents the request body for refreshing an access token
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"5Jt4ZGl7WZQ1xV0yqk0c0pK3yC8m0A1uYkq2mX8bXhA"`
+	DeviceName   string `json:"device_name" binding:"omitempty,max=100" example:"Register 1"`
+}
+
+// Refresh godoc
+//
+//	@Summary		Refresh an access token
+//	@Description	Replaces a refresh token with a new one and returns it with a new access token. A refresh token can only be used once; using it again revokes every token obtained from the same login.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		refreshRequest	true	"Refresh request body"
+//	@Success		200		{object}	authResponse	"Succesfully refreshed"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/users/refresh [post]
+func (ah *AuthHandler) Refresh(ctx *gin.Context) {
+	var req refreshRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	token, err := ah.svc.Refresh(ctx, req.RefreshToken, newDevice(ctx, req.DeviceName))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAuthResponse(token)
+
+	handleSuccess(ctx, rsp)
+}
+
+// Logout godoc
+//
+//	@Summary		Logout
+//	@Description	Revokes the access token of the request and the refresh tokens obtained from the same login.
+//	@Tags			Users
+//	@Produce		json
+//	@Success		200	{object}	response		"Succesfully logged out"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/users/logout [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) Logout(ctx *gin.Context) {
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.Logout(ctx, payload)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// LogoutAll godoc
+//
+//	@Summary		Logout everywhere
+//	@Description	Revokes all access tokens and refresh tokens of the current user, on every device.
+//	@Tags			Users
+//	@Produce		json
+//	@Success		200	{object}	response		"Succesfully logged out everywhere"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/users/logout-all [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) LogoutAll(ctx *gin.Context) {
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.LogoutAll(ctx, payload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// unlockLoginRequest represents the request body for unlocking the login of a user
+type unlockLoginRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// UnlockLogin godoc
+//
+//	@Summary		Unlock the login of a user
+//	@Description	Lifts the lockout after too many failed login attempts with the email or PIN of a user and forgets those attempts. Lockouts of client IP addresses expire on their own.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			id	path		uint64			true	"User ID"
+//	@Success		200	{object}	response		"Login unlocked"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/users/{id}/unlock [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) UnlockLogin(ctx *gin.Context) {
+	var req unlockLoginRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.UnlockLogin(ctx, req.ID, payload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// newDevice describes the device a request is sent from
+func newDevice(ctx *gin.Context, name string) domain.Device {
+	return domain.Device{
+		Name:      name,
+		UserAgent: ctx.Request.UserAgent(),
+		IPAddress: ctx.ClientIP(),
+	}
+}
+
+// ListPublicKeys godoc
+//
+//	@Summary		List the public keys of access tokens
+//	@Description	Lists the Ed25519 public keys that verify the v4.public access tokens, including the keys no longer signing new tokens. Returns not found when the access tokens are v4.local.
+//	@Tags			Users
+//	@Produce		json
+//	@Success		200	{array}		publicKeyResponse	"Public keys displayed"
+//	@Failure		404	{object}	errorResponse		"Data not found error"
+//	@Failure		500	{object}	errorResponse		"Internal server error"
+//	@Router			/.well-known/paseto-keys [get]
+func (ah *AuthHandler) ListPublicKeys(ctx *gin.Context) {
+	keys, err := ah.svc.ListPublicKeys(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	list := make([]publicKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, newPublicKeyResponse(&key))
+	}
+
+	handleSuccess(ctx, list)
+}
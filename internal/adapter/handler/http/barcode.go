@@ -0,0 +1,221 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// BarcodeHandler represents the HTTP handler for barcode-related requests
+type BarcodeHandler struct {
+	svc port.BarcodeService
+}
+
+// NewBarcodeHandler creates a new BarcodeHandler instance
+func NewBarcodeHandler(svc port.BarcodeService) *BarcodeHandler {
+	return &BarcodeHandler{
+		svc,
+	}
+}
+
+// createBarcodeRequest represents a request body for adding a new barcode to a product
+type createBarcodeRequest struct {
+	ProductID uint64             `json:"product_id" binding:"required,min=1" example:"1"`
+	Code      string             `json:"code" binding:"required,numeric" example:"8991234567891"`
+	Type      domain.BarcodeType `json:"type" binding:"omitempty,barcode_type" example:"standard"`
+}
+
+// CreateBarcode godoc
+//
+//	@Summary		Add a new barcode to a product
+//	@Description	add a manufacturer EAN-13, EAN-8 or UPC-A barcode, or the 7-digit prefix of in-store barcodes that encode weight or price, to a product
+//	@Tags			Barcodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			createBarcodeRequest	body		createBarcodeRequest	true	"Create barcode request"
+//	@Success		200						{object}	barcodeResponse			"Barcode created"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		404						{object}	errorResponse			"Data not found error"
+//	@Failure		409						{object}	errorResponse			"Data conflict error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/barcodes [post]
+//	@Security		BearerAuth
+func (bh *BarcodeHandler) CreateBarcode(ctx *gin.Context) {
+	var req createBarcodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	barcode := domain.Barcode{
+		ProductID: req.ProductID,
+		Code:      req.Code,
+		Type:      req.Type,
+	}
+
+	_, err := bh.svc.CreateBarcode(ctx, &barcode)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newBarcodeResponse(&barcode)
+
+	handleSuccess(ctx, rsp)
+}
+
+// generateBarcodeRequest represents a request body for generating an in-store barcode of a product
+type generateBarcodeRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// GenerateBarcode godoc
+//
+//	@Summary		Generate an in-store barcode for a product
+//	@Description	add an in-store EAN-13 barcode generated from the product id to a product without a manufacturer barcode, so that its EAN-13 labels can be printed and scanned
+//	@Tags			Barcodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Product ID"
+//	@Success		200	{object}	barcodeResponse	"Barcode generated"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		409	{object}	errorResponse	"Data conflict error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/products/{id}/barcode [post]
+//	@Security		BearerAuth
+func (bh *BarcodeHandler) GenerateBarcode(ctx *gin.Context) {
+	var req generateBarcodeRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	barcode, err := bh.svc.GenerateBarcode(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newBarcodeResponse(barcode)
+
+	handleSuccess(ctx, rsp)
+}
+
+// listBarcodesRequest represents a request body for listing barcodes of a product
+type listBarcodesRequest struct {
+	ProductID uint64 `form:"product_id" binding:"required,min=1" example:"1"`
+}
+
+// ListBarcodes godoc
+//
+//	@Summary		List barcodes
+//	@Description	List barcodes of a product
+//	@Tags			Barcodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			product_id	query		uint64				true	"Product ID"
+//	@Success		200			{object}	[]barcodeResponse	"Barcodes retrieved"
+//	@Failure		400			{object}	errorResponse		"Validation error"
+//	@Failure		500			{object}	errorResponse		"Internal server error"
+//	@Router			/barcodes [get]
+//	@Security		BearerAuth
+func (bh *BarcodeHandler) ListBarcodes(ctx *gin.Context) {
+	var req listBarcodesRequest
+	var barcodesList []barcodeResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	barcodes, err := bh.svc.ListBarcodes(ctx, req.ProductID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, barcode := range barcodes {
+		barcodesList = append(barcodesList, newBarcodeResponse(&barcode))
+	}
+
+	handleSuccess(ctx, barcodesList)
+}
+
+// deleteBarcodeRequest represents a request body for deleting a barcode
+type deleteBarcodeRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// DeleteBarcode godoc
+//
+//	@Summary		Delete a barcode
+//	@Description	Delete a barcode by id
+//	@Tags			Barcodes
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Barcode ID"
+//	@Success		200	{object}	response		"Barcode deleted"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/barcodes/{id} [delete]
+//	@Security		BearerAuth
+func (bh *BarcodeHandler) DeleteBarcode(ctx *gin.Context) {
+	var req deleteBarcodeRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := bh.svc.DeleteBarcode(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// scanBarcodeRequest represents a request body for looking up a product by a scanned barcode
+type scanBarcodeRequest struct {
+	Code string `uri:"code" binding:"required,numeric" example:"8991234567891"`
+}
+
+// ScanBarcode godoc
+//
+//	@Summary		Look up a product by barcode
+//	@Description	look up the product of a scanned barcode, with the weight or price decoded from in-store weighted barcodes, or by the SKU printed on Code128 labels
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			code	path		string				true	"Barcode"
+//	@Success		200		{object}	barcodeScanResponse	"Product retrieved"
+//	@Failure		400		{object}	errorResponse		"Validation error"
+//	@Failure		404		{object}	errorResponse		"Data not found error"
+//	@Failure		500		{object}	errorResponse		"Internal server error"
+//	@Router			/products/barcode/{code} [get]
+//	@Security		BearerAuth
+func (bh *BarcodeHandler) ScanBarcode(ctx *gin.Context) {
+	var req scanBarcodeRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	scan, err := bh.svc.ScanBarcode(ctx, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newBarcodeScanResponse(scan)
+
+	handleSuccess(ctx, rsp)
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// CatalogHandler represents the HTTP handler for product catalog-related requests
+type CatalogHandler struct {
+	svc port.CatalogService
+}
+
+// NewCatalogHandler creates a new CatalogHandler instance
+func NewCatalogHandler(svc port.CatalogService) *CatalogHandler {
+	return &CatalogHandler{
+		svc,
+	}
+}
+
+// importCatalogRequest represents a request body for importing a product catalog
+type importCatalogRequest struct {
+	Format domain.CatalogFormat `form:"format" binding:"omitempty,catalog_format" example:"csv"`
+	DryRun bool                 `form:"dry_run" binding:"omitempty" example:"true"`
+}
+
+// ImportCatalog godoc
+//
+//	@Summary		Import products from a catalog file
+//	@Description	import products from a CSV or XLSX file whose header names any of the columns sku, barcode, name, category, unit, price, cost and stock. Rows update the product with their SKU, or else their barcode, keeping the values of empty cells, and create a new product otherwise, which requires a name, category name and price. Changing the price of an existing product requires the products.edit_price permission. A higher stock of an existing product is received at the cost of its row, and a lower stock consumes its oldest cost layers. Every row is validated first, and nothing is imported when any row has errors or the import is a dry run
+//	@Tags			Products
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file					true	"Catalog file"
+//	@Param			format	query		string					false	"File format, from the file extension by default"	Enums(csv, xlsx)
+//	@Param			dry_run	query		bool					false	"Only validate the file"
+//	@Success		200		{object}	catalogImportResponse	"Catalog imported or validated"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Unauthorized error"
+//	@Failure		403		{object}	errorResponse			"Forbidden error"
+//	@Failure		409		{object}	errorResponse			"Data conflict error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/products/import [post]
+//	@Security		BearerAuth
+func (ch *CatalogHandler) ImportCatalog(ctx *gin.Context) {
+	var req importCatalogRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	content, fileHeader, err := readFormFile(ctx, "file")
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if req.Format == "" {
+		req.Format = domain.CatalogFormat(strings.ToLower(strings.TrimPrefix(filepath.Ext(fileHeader.Filename), ".")))
+	}
+
+	catalog := domain.Catalog{
+		Format:  req.Format,
+		Content: content,
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	result, err := ch.svc.ImportCatalog(ctx, &catalog, req.DryRun, authPayload)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCatalogImportResponse(result)
+
+	handleSuccess(ctx, rsp)
+}
+
+// exportCatalogRequest represents a request body for exporting the product catalog
+type exportCatalogRequest struct {
+	Format domain.CatalogFormat `form:"format" binding:"omitempty,catalog_format" example:"xlsx"`
+}
+
+// ExportCatalog godoc
+//
+//	@Summary		Export products into a catalog file
+//	@Description	export every product with its SKU, first standard barcode, category name, unit, price, cost and current stock into a CSV or XLSX file that can be imported back
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			format	query		string			false	"File format"	Enums(csv, xlsx)
+//	@Success		200		{file}		binary			"Catalog exported"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/products/export [get]
+//	@Security		BearerAuth
+func (ch *CatalogHandler) ExportCatalog(ctx *gin.Context) {
+	var req exportCatalogRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if req.Format == "" {
+		req.Format = domain.CatalogCSV
+	}
+
+	catalog, err := ch.svc.ExportCatalog(ctx, req.Format)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleCatalog(ctx, "products", catalog)
+}
@@ -20,13 +20,14 @@ func NewCategoryHandler(svc port.CategoryService) *CategoryHandler {
 
 // createCategoryRequest represents a request body for creating a new category
 type createCategoryRequest struct {
-	Name string `json:"name" binding:"required" example:"Foods"`
+	Name     string `json:"name" binding:"required" example:"Foods"`
+	ParentID uint64 `json:"parent_id" binding:"omitempty,min=1" example:"1"`
 }
 
 // CreateCategory godoc
 //
 //	@Summary		Create a new category
-//	@Description	create a new category with name
+//	@Description	create a new category with name, optionally as a sub-category of a parent category
 //	@Tags			Categories
 //	@Accept			json
 //	@Produce		json
@@ -48,7 +49,8 @@ func (ch *CategoryHandler) CreateCategory(ctx *gin.Context) {
 	}
 
 	category := domain.Category{
-		Name: req.Name,
+		Name:     req.Name,
+		ParentID: req.ParentID,
 	}
 
 	_, err := ch.svc.CreateCategory(ctx, &category)
@@ -145,6 +147,30 @@ func (ch *CategoryHandler) ListCategories(ctx *gin.Context) {
 	handleSuccess(ctx, rsp)
 }
 
+// GetCategoryTree godoc
+//
+//	@Summary		Get the category tree
+//	@Description	get the top-level categories with their nested sub-categories, ordered by name
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		categoryTreeResponse	"Category tree retrieved"
+//	@Failure		401	{object}	errorResponse			"Unauthorized error"
+//	@Failure		500	{object}	errorResponse			"Internal server error"
+//	@Router			/categories/tree [get]
+//	@Security		BearerAuth
+func (ch *CategoryHandler) GetCategoryTree(ctx *gin.Context) {
+	tree, err := ch.svc.GetCategoryTree(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCategoryTreeResponse(tree)
+
+	handleSuccess(ctx, rsp)
+}
+
 // updateCategoryRequest represents a request body for updating a category
 type updateCategoryRequest struct {
 	Name string `json:"name" binding:"omitempty,required" example:"Beverages"`
@@ -198,6 +224,53 @@ func (ch *CategoryHandler) UpdateCategory(ctx *gin.Context) {
 	handleSuccess(ctx, rsp)
 }
 
+// moveCategoryRequest represents a request body for moving a category
+type moveCategoryRequest struct {
+	ParentID uint64 `json:"parent_id" binding:"min=0" example:"1"`
+}
+
+// MoveCategory godoc
+//
+//	@Summary		Move a category
+//	@Description	move a category under a parent category, or to the top level when the parent id is 0. A category cannot be moved under itself or one of its sub-categories
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		uint64				true	"Category ID"
+//	@Param			moveCategoryRequest	body		moveCategoryRequest	true	"Move category request"
+//	@Success		200					{object}	categoryResponse	"Category moved"
+//	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		401					{object}	errorResponse		"Unauthorized error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
+//	@Failure		404					{object}	errorResponse		"Data not found error"
+//	@Failure		500					{object}	errorResponse		"Internal server error"
+//	@Router			/categories/{id}/parent [put]
+//	@Security		BearerAuth
+func (ch *CategoryHandler) MoveCategory(ctx *gin.Context) {
+	var req moveCategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	id, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	category, err := ch.svc.MoveCategory(ctx, id, req.ParentID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newCategoryResponse(category)
+
+	handleSuccess(ctx, rsp)
+}
+
 // deleteCategoryRequest represents a request body for deleting a category
 type deleteCategoryRequest struct {
 	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
@@ -206,7 +279,7 @@ type deleteCategoryRequest struct {
 // DeleteCategory godoc
 //
 //	@Summary		Delete a category
-//	@Description	Delete a category by id
+//	@Description	Delete a category by id. A category with products or sub-categories cannot be deleted
 //	@Tags			Categories
 //	@Accept			json
 //	@Produce		json
@@ -216,6 +289,7 @@ type deleteCategoryRequest struct {
 //	@Failure		401	{object}	errorResponse	"Unauthorized error"
 //	@Failure		403	{object}	errorResponse	"Forbidden error"
 //	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		409	{object}	errorResponse	"Data conflict error"
 //	@Failure		500	{object}	errorResponse	"Internal server error"
 //	@Router			/categories/{id} [delete]
 //	@Security		BearerAuth
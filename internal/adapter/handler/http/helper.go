@@ -1,6 +1,8 @@
 package http
 
 import (
+	"io"
+	"mime/multipart"
 	"strconv"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
@@ -14,6 +16,27 @@ func stringToUint64(str string) (uint64, error) {
 	return num, err
 }
 
+// readFormFile is a helper function to read the content of a file uploaded in a multipart form
+func readFormFile(ctx *gin.Context, name string) ([]byte, *multipart.FileHeader, error) {
+	fileHeader, err := ctx.FormFile(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return content, fileHeader, nil
+}
+
 // getAuthPayload is a helper function to get the auth payload from the context
 func getAuthPayload(ctx *gin.Context, key string) *domain.TokenPayload {
 	return ctx.MustGet(key).(*domain.TokenPayload)
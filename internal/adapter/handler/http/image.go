@@ -0,0 +1,146 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// maxImageRequestSize is the largest request body accepted for an image upload, leaving room for the multipart framing
+const maxImageRequestSize = domain.MaxImageSize + 1<<20
+
+// ImageHandler represents the HTTP handler for image-related requests
+type ImageHandler struct {
+	svc port.ImageService
+}
+
+// NewImageHandler creates a new ImageHandler instance
+func NewImageHandler(svc port.ImageService) *ImageHandler {
+	return &ImageHandler{
+		svc,
+	}
+}
+
+// uploadProductImageRequest represents a request body for uploading the image of a product
+type uploadProductImageRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// UploadProductImage godoc
+//
+//	@Summary		Upload a product image
+//	@Description	upload a JPEG, PNG or GIF image of at most 5 MB as the image of a product, generating its thumbnail and replacing the previous image
+//	@Tags			Products
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		uint64			true	"Product ID"
+//	@Param			image	formData	file			true	"Product image"
+//	@Success		200		{object}	productResponse	"Product image uploaded"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/products/{id}/image [post]
+//	@Security		BearerAuth
+func (ih *ImageHandler) UploadProductImage(ctx *gin.Context) {
+	var req uploadProductImageRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImageRequestSize)
+
+	content, _, err := readFormFile(ctx, "image")
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	product, err := ih.svc.UploadProductImage(ctx, req.ID, content)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newProductResponse(product)
+
+	handleSuccess(ctx, rsp)
+}
+
+// uploadPaymentLogoRequest represents a request body for uploading the logo of a payment
+type uploadPaymentLogoRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// UploadPaymentLogo godoc
+//
+//	@Summary		Upload a payment logo
+//	@Description	upload a JPEG, PNG or GIF image of at most 5 MB as the logo of a payment, generating its thumbnail and replacing the previous logo
+//	@Tags			Payments
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		uint64			true	"Payment ID"
+//	@Param			logo	formData	file			true	"Payment logo"
+//	@Success		200		{object}	paymentResponse	"Payment logo uploaded"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		401		{object}	errorResponse	"Unauthorized error"
+//	@Failure		403		{object}	errorResponse	"Forbidden error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/payments/{id}/logo [post]
+//	@Security		BearerAuth
+func (ih *ImageHandler) UploadPaymentLogo(ctx *gin.Context) {
+	var req uploadPaymentLogoRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImageRequestSize)
+
+	content, _, err := readFormFile(ctx, "logo")
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payment, err := ih.svc.UploadPaymentLogo(ctx, req.ID, content)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newPaymentResponse(payment)
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetImage godoc
+//
+//	@Summary		Get an uploaded image
+//	@Description	get an uploaded product image, payment logo or one of their thumbnails by the key in its URL
+//	@Tags			Images
+//	@Produce		image/jpeg
+//	@Produce		image/png
+//	@Produce		image/gif
+//	@Param			key	path		string			true	"Image key"
+//	@Success		200	{file}		binary			"Image retrieved"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/images/{key} [get]
+func (ih *ImageHandler) GetImage(ctx *gin.Context) {
+	key := strings.TrimPrefix(ctx.Param("key"), "/")
+
+	file, err := ih.svc.GetImage(ctx, key)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleFile(ctx, file)
+}
@@ -0,0 +1,115 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// LabelHandler represents the HTTP handler for label-related requests
+type LabelHandler struct {
+	svc port.LabelService
+}
+
+// NewLabelHandler creates a new LabelHandler instance
+func NewLabelHandler(svc port.LabelService) *LabelHandler {
+	return &LabelHandler{
+		svc,
+	}
+}
+
+// getBarcodeLabelRequest represents a request body for rendering the barcode of a product
+type getBarcodeLabelRequest struct {
+	ID        uint64                  `uri:"id" binding:"required,min=1" example:"1"`
+	Symbology domain.BarcodeSymbology `form:"symbology" binding:"omitempty,barcode_symbology" example:"code128"`
+	Format    domain.LabelFormat      `form:"format" binding:"omitempty,barcode_format" example:"svg"`
+}
+
+// GetBarcodeLabel godoc
+//
+//	@Summary		Render the barcode of a product
+//	@Description	render the SKU of a product as a Code128 barcode, or its EAN-13 barcode, as an SVG or PNG image. Products without an EAN-13 or UPC-A barcode need an in-store barcode generated first
+//	@Tags			Labels
+//	@Accept			json
+//	@Produce		image/svg+xml
+//	@Produce		image/png
+//	@Param			id			path		uint64			true	"Product ID"
+//	@Param			symbology	query		string			false	"Barcode symbology"	Enums(code128, ean13)
+//	@Param			format		query		string			false	"Image format"		Enums(svg, png)
+//	@Success		200			{file}		binary			"Barcode rendered"
+//	@Failure		400			{object}	errorResponse	"Validation error"
+//	@Failure		404			{object}	errorResponse	"Data not found error"
+//	@Failure		500			{object}	errorResponse	"Internal server error"
+//	@Router			/products/{id}/barcode [get]
+//	@Security		BearerAuth
+func (lh *LabelHandler) GetBarcodeLabel(ctx *gin.Context) {
+	var req getBarcodeLabelRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if req.Symbology == "" {
+		req.Symbology = domain.SymbologyCode128
+	}
+
+	if req.Format == "" {
+		req.Format = domain.LabelSVG
+	}
+
+	label, err := lh.svc.GetBarcodeLabel(ctx, req.ID, req.Symbology, req.Format)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleLabel(ctx, "barcode", label)
+}
+
+// getShelfLabelsRequest represents a request body for rendering a sheet of shelf labels
+type getShelfLabelsRequest struct {
+	ProductIDs []uint64                `form:"product_ids" binding:"required_without=CategoryID,omitempty,dive,min=1" example:"1"`
+	CategoryID uint64                  `form:"category_id" binding:"required_without=ProductIDs,omitempty,min=1" example:"1"`
+	Symbology  domain.BarcodeSymbology `form:"symbology" binding:"omitempty,barcode_symbology" example:"ean13"`
+}
+
+// GetShelfLabels godoc
+//
+//	@Summary		Render shelf labels
+//	@Description	render a printable A4 PDF sheet of shelf labels with the name, price and barcode of the chosen products, or of the products of a category. EAN-13 labels require every product to have an EAN-13 or UPC-A barcode
+//	@Tags			Labels
+//	@Accept			json
+//	@Produce		application/pdf
+//	@Param			product_ids	query		[]uint64		false	"Product IDs"	collectionFormat(multi)
+//	@Param			category_id	query		uint64			false	"Category ID"
+//	@Param			symbology	query		string			false	"Barcode symbology"	Enums(code128, ean13)
+//	@Success		200			{file}		binary			"Shelf labels rendered"
+//	@Failure		400			{object}	errorResponse	"Validation error"
+//	@Failure		404			{object}	errorResponse	"Data not found error"
+//	@Failure		500			{object}	errorResponse	"Internal server error"
+//	@Router			/labels/shelf [get]
+//	@Security		BearerAuth
+func (lh *LabelHandler) GetShelfLabels(ctx *gin.Context) {
+	var req getShelfLabelsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	if req.Symbology == "" {
+		req.Symbology = domain.SymbologyEAN13
+	}
+
+	label, err := lh.svc.GetShelfLabels(ctx, req.ProductIDs, req.CategoryID, req.Symbology)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleLabel(ctx, "shelf-labels", label)
+}
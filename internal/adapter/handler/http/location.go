@@ -0,0 +1,163 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// LocationHandler represents the HTTP handler for location-related requests
+type LocationHandler struct {
+	svc port.LocationService
+}
+
+// NewLocationHandler creates a new LocationHandler instance
+func NewLocationHandler(svc port.LocationService) *LocationHandler {
+	return &LocationHandler{
+		svc,
+	}
+}
+
+// createLocationRequest represents a request body for creating a new location
+type createLocationRequest struct {
+	Name string `json:"name" binding:"required,max=100" example:"Warehouse"`
+}
+
+// CreateLocation godoc
+//
+//	@Summary		Create a new location
+//	@Description	create a new location, such as a warehouse, stock can be transferred to and from. The default location is the store, whose stock is the stock of the products
+//	@Tags			Locations
+//	@Accept			json
+//	@Produce		json
+//	@Param			createLocationRequest	body		createLocationRequest	true	"Create location request"
+//	@Success		200						{object}	locationResponse		"Location created"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		409						{object}	errorResponse			"Data conflict error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/locations [post]
+//	@Security		BearerAuth
+func (lh *LocationHandler) CreateLocation(ctx *gin.Context) {
+	var req createLocationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	location := domain.Location{
+		Name: req.Name,
+	}
+
+	_, err := lh.svc.CreateLocation(ctx, &location)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newLocationResponse(&location)
+
+	handleSuccess(ctx, rsp)
+}
+
+// listLocationsRequest represents a request body for listing locations
+type listLocationsRequest struct {
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListLocations godoc
+//
+//	@Summary		List locations
+//	@Description	List locations, the default location first, with pagination
+//	@Tags			Locations
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		uint64			true	"Skip"
+//	@Param			limit	query		uint64			true	"Limit"
+//	@Success		200		{object}	meta			"Locations retrieved"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/locations [get]
+//	@Security		BearerAuth
+func (lh *LocationHandler) ListLocations(ctx *gin.Context) {
+	var req listLocationsRequest
+	var locationsList []locationResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	locations, err := lh.svc.ListLocations(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, location := range locations {
+		locationsList = append(locationsList, newLocationResponse(&location))
+	}
+
+	total := uint64(len(locationsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, locationsList, "locations")
+
+	handleSuccess(ctx, rsp)
+}
+
+// listLocationStocksRequest represents a request body for listing the stock at a location
+type listLocationStocksRequest struct {
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListLocationStocks godoc
+//
+//	@Summary		List the stock at a location
+//	@Description	List the products in stock at a location with their quantities, with pagination. Stock in transit between locations is not included
+//	@Tags			Locations
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		uint64			true	"Location ID"
+//	@Param			skip	query		uint64			true	"Skip"
+//	@Param			limit	query		uint64			true	"Limit"
+//	@Success		200		{object}	meta			"Location stock retrieved"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		404		{object}	errorResponse	"Data not found error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/locations/{id}/stock [get]
+//	@Security		BearerAuth
+func (lh *LocationHandler) ListLocationStocks(ctx *gin.Context) {
+	var req listLocationStocksRequest
+	var stocksList []locationStockResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	id, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	stocks, err := lh.svc.ListLocationStocks(ctx, id, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, stock := range stocks {
+		stocksList = append(stocksList, newLocationStockResponse(&stock))
+	}
+
+	total := uint64(len(stocksList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, stocksList, "stocks")
+
+	handleSuccess(ctx, rsp)
+}
@@ -0,0 +1,207 @@
+package http
+
+import (
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// LotHandler represents the HTTP handler for lot-related requests
+type LotHandler struct {
+	svc port.LotService
+}
+
+// NewLotHandler creates a new LotHandler instance
+func NewLotHandler(svc port.LotService) *LotHandler {
+	return &LotHandler{
+		svc,
+	}
+}
+
+// createLotRequest represents a request body for receiving a new lot
+type createLotRequest struct {
+	ProductID uint64    `json:"product_id" binding:"required,min=1" example:"1"`
+	LotNumber string    `json:"lot_number" binding:"required" example:"LOT-2024-001"`
+	ExpiresAt time.Time `json:"expires_at" binding:"required" example:"2024-12-31T00:00:00Z"`
+	Quantity  float64   `json:"qty" binding:"required,gt=0" example:"24"`
+	Unit      string    `json:"unit" binding:"omitempty" example:"pcs"`
+	Cost      float64   `json:"cost" binding:"omitempty,min=0" example:"3500"`
+}
+
+// CreateLot godoc
+//
+//	@Summary		Receive a new lot
+//	@Description	receive a new lot of a lot-tracked product with its expiry date and add the quantity, converted from the given unit of measure, to the product stock
+//	@Tags			Lots
+//	@Accept			json
+//	@Produce		json
+//	@Param			createLotRequest	body		createLotRequest	true	"Create lot request"
+//	@Success		200					{object}	lotResponse			"Lot created"
+//	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		401					{object}	errorResponse		"Unauthorized error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
+//	@Failure		404					{object}	errorResponse		"Data not found error"
+//	@Failure		409					{object}	errorResponse		"Data conflict error"
+//	@Failure		500					{object}	errorResponse		"Internal server error"
+//	@Router			/lots [post]
+//	@Security		BearerAuth
+func (lh *LotHandler) CreateLot(ctx *gin.Context) {
+	var req createLotRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	lot := domain.Lot{
+		ProductID: req.ProductID,
+		LotNumber: req.LotNumber,
+		ExpiresAt: req.ExpiresAt,
+		Quantity:  req.Quantity,
+	}
+
+	_, err := lh.svc.CreateLot(ctx, &lot, req.Unit, req.Cost)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newLotResponse(&lot)
+
+	handleSuccess(ctx, rsp)
+}
+
+// getLotRequest represents a request body for retrieving a lot
+type getLotRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// GetLot godoc
+//
+//	@Summary		Get a lot
+//	@Description	get a lot by id
+//	@Tags			Lots
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Lot ID"
+//	@Success		200	{object}	lotResponse		"Lot retrieved"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/lots/{id} [get]
+//	@Security		BearerAuth
+func (lh *LotHandler) GetLot(ctx *gin.Context) {
+	var req getLotRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	lot, err := lh.svc.GetLot(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newLotResponse(lot)
+
+	handleSuccess(ctx, rsp)
+}
+
+// listLotsRequest represents a request body for listing lots of a product
+type listLotsRequest struct {
+	ProductID uint64 `form:"product_id" binding:"required,min=1" example:"1"`
+	Skip      uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit     uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListLots godoc
+//
+//	@Summary		List lots
+//	@Description	List lots of a product ordered by expiry date with pagination
+//	@Tags			Lots
+//	@Accept			json
+//	@Produce		json
+//	@Param			product_id	query		uint64			true	"Product ID"
+//	@Param			skip		query		uint64			true	"Skip"
+//	@Param			limit		query		uint64			true	"Limit"
+//	@Success		200			{object}	meta			"Lots retrieved"
+//	@Failure		400			{object}	errorResponse	"Validation error"
+//	@Failure		404			{object}	errorResponse	"Data not found error"
+//	@Failure		500			{object}	errorResponse	"Internal server error"
+//	@Router			/lots [get]
+//	@Security		BearerAuth
+func (lh *LotHandler) ListLots(ctx *gin.Context) {
+	var req listLotsRequest
+	var lotsList []lotResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	lots, err := lh.svc.ListLots(ctx, req.ProductID, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, lot := range lots {
+		lotsList = append(lotsList, newLotResponse(&lot))
+	}
+
+	total := uint64(len(lotsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, lotsList, "lots")
+
+	handleSuccess(ctx, rsp)
+}
+
+// listExpiringLotsRequest represents a request body for listing lots that expire soon
+type listExpiringLotsRequest struct {
+	Days  uint64 `form:"days" binding:"omitempty,min=0" example:"7"`
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListExpiringLots godoc
+//
+//	@Summary		List expiring lots
+//	@Description	List lots with remaining stock that expire within the given number of days, including already expired lots
+//	@Tags			Lots
+//	@Accept			json
+//	@Produce		json
+//	@Param			days	query		uint64			false	"Days until expiry"
+//	@Param			skip	query		uint64			true	"Skip"
+//	@Param			limit	query		uint64			true	"Limit"
+//	@Success		200		{object}	meta			"Expiring lots retrieved"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/lots/expiring [get]
+//	@Security		BearerAuth
+func (lh *LotHandler) ListExpiringLots(ctx *gin.Context) {
+	var req listExpiringLotsRequest
+	var lotsList []lotResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	lots, err := lh.svc.ListExpiringLots(ctx, req.Days, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, lot := range lots {
+		lotsList = append(lotsList, newLotResponse(&lot))
+	}
+
+	total := uint64(len(lotsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, lotsList, "lots")
+
+	handleSuccess(ctx, rsp)
+}
@@ -2,9 +2,11 @@ package http
 
 import (
 	"strings"
+	"time"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,8 +19,8 @@ const (
 	authorizationPayloadKey = "authorization_payload"
 )
 
-// authMiddleware is a middleware to check if the user is authenticated
-func authMiddleware(token port.TokenService) gin.HandlerFunc {
+// authMiddleware is a middleware to check if the user is authenticated with a token that has not been revoked
+func authMiddleware(token port.TokenService, cache port.CacheRepository) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 
@@ -51,21 +53,76 @@ func authMiddleware(token port.TokenService) gin.HandlerFunc {
 			return
 		}
 
+		revoked, err := isRevoked(ctx, cache, payload)
+		if err != nil {
+			handleAbort(ctx, err)
+			return
+		}
+
+		if revoked {
+			err := domain.ErrRevokedToken
+			handleAbort(ctx, err)
+			return
+		}
+
 		ctx.Set(authorizationPayloadKey, payload)
 		ctx.Next()
 	}
 }
 
-// adminMiddleware is a middleware to check if the user is an admin
-func adminMiddleware() gin.HandlerFunc {
+// isRevoked checks whether a token has been revoked by a logout,
+// or was issued before all tokens of its user or role were revoked.
+// A revocation that cannot be read is an error, so an unreachable cache does not accept revoked tokens
+func isRevoked(ctx *gin.Context, cache port.CacheRepository, payload *domain.TokenPayload) (bool, error) {
+	cacheKey := util.GenerateCacheKey("revoked_token", payload.ID)
+	_, err := cache.Get(ctx, cacheKey)
+	if err == nil {
+		return true, nil
+	}
+	if err != domain.ErrCacheMiss {
+		return false, domain.ErrInternal
+	}
+
+	cacheKey = util.GenerateCacheKey("revoked_user", payload.UserID)
+	revoked, err := isIssuedBeforeRevocation(ctx, cache, cacheKey, payload)
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	cacheKey = util.GenerateCacheKey("revoked_role", payload.Role)
+	return isIssuedBeforeRevocation(ctx, cache, cacheKey, payload)
+}
+
+// isIssuedBeforeRevocation checks whether a token was issued before the revocation time stored at a cache key
+func isIssuedBeforeRevocation(ctx *gin.Context, cache port.CacheRepository, cacheKey string, payload *domain.TokenPayload) (bool, error) {
+	revokedAtSerialized, err := cache.Get(ctx, cacheKey)
+	if err != nil {
+		if err == domain.ErrCacheMiss {
+			return false, nil
+		}
+		return false, domain.ErrInternal
+	}
+
+	var revokedAt time.Time
+	err = util.Deserialize(revokedAtSerialized, &revokedAt)
+	if err != nil {
+		return false, domain.ErrInternal
+	}
+
+	return !payload.IssuedAt.After(revokedAt), nil
+}
+
+// permissionMiddleware is a middleware to check if the role of the user has all the required permissions
+func permissionMiddleware(permissions ...domain.Permission) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		payload := getAuthPayload(ctx, authorizationPayloadKey)
 
-		isAdmin := payload.Role == domain.Admin
-		if !isAdmin {
-			err := domain.ErrForbidden
-			handleAbort(ctx, err)
-			return
+		for _, permission := range permissions {
+			if !payload.HasPermission(permission) {
+				err := domain.ErrForbidden
+				handleAbort(ctx, err)
+				return
+			}
 		}
 
 		ctx.Next()
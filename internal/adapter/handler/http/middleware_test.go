@@ -0,0 +1,175 @@
+package http_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthMiddleware_Revocation(t *testing.T) {
+	accessToken := "access-token"
+	payload := &domain.TokenPayload{
+		ID:        uuid.New(),
+		UserID:    1,
+		Role:      domain.Admin,
+		SessionID: uuid.New(),
+		IssuedAt:  time.Now().Add(-time.Minute),
+		ExpiredAt: time.Now().Add(4 * time.Minute),
+	}
+	tokenKey := util.GenerateCacheKey("revoked_token", payload.ID)
+	userKey := util.GenerateCacheKey("revoked_user", payload.UserID)
+	roleKey := util.GenerateCacheKey("revoked_role", payload.Role)
+	revokedAtSerialized, err := util.Serialize(time.Now())
+	if err != nil {
+		t.Fatalf("expected to serialize the revocation time; got %q", err)
+	}
+	errUnavailable := errors.New("dial tcp 127.0.0.1:6379: connect: connection refused")
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			cache *mock.MockCacheRepository,
+			authService *mock.MockAuthService,
+		)
+		expected int
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				cache *mock.MockCacheRepository,
+				authService *mock.MockAuthService,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Times(3).
+					Return(nil, domain.ErrCacheMiss)
+				authService.EXPECT().
+					Logout(gomock.Any(), gomock.Eq(payload)).
+					Times(1).
+					Return(nil)
+			},
+			expected: http.StatusOK,
+		},
+		{
+			desc: "Fail_RevokedToken",
+			mocks: func(
+				cache *mock.MockCacheRepository,
+				authService *mock.MockAuthService,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(tokenKey)).
+					Times(1).
+					Return([]byte("1"), nil)
+			},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc: "Fail_RevokedUser",
+			mocks: func(
+				cache *mock.MockCacheRepository,
+				authService *mock.MockAuthService,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(tokenKey)).
+					Times(1).
+					Return(nil, domain.ErrCacheMiss)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(userKey)).
+					Times(1).
+					Return(revokedAtSerialized, nil)
+			},
+			expected: http.StatusUnauthorized,
+		},
+		{
+			desc: "Fail_TokenRevocationUnavailable",
+			mocks: func(
+				cache *mock.MockCacheRepository,
+				authService *mock.MockAuthService,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(tokenKey)).
+					Times(1).
+					Return(nil, errUnavailable)
+			},
+			expected: http.StatusInternalServerError,
+		},
+		{
+			desc: "Fail_UserRevocationUnavailable",
+			mocks: func(
+				cache *mock.MockCacheRepository,
+				authService *mock.MockAuthService,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(tokenKey)).
+					Times(1).
+					Return(nil, domain.ErrCacheMiss)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(userKey)).
+					Times(1).
+					Return(nil, errUnavailable)
+			},
+			expected: http.StatusInternalServerError,
+		},
+		{
+			desc: "Fail_MalformedRoleRevocation",
+			mocks: func(
+				cache *mock.MockCacheRepository,
+				authService *mock.MockAuthService,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(tokenKey)).
+					Times(1).
+					Return(nil, domain.ErrCacheMiss)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(userKey)).
+					Times(1).
+					Return(nil, domain.ErrCacheMiss)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(roleKey)).
+					Times(1).
+					Return([]byte("not a time"), nil)
+			},
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			authService := mock.NewMockAuthService(ctrl)
+
+			tokenService.EXPECT().
+				VerifyToken(gomock.Eq(accessToken)).
+				Times(1).
+				Return(payload, nil)
+
+			tc.mocks(cache, authService)
+
+			router := newTestRouter(t, "", tokenService, cache, authService)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/users/logout", nil)
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tc.expected {
+				t.Errorf("[case: %s] expected to get status %d; got %d", tc.desc, tc.expected, rec.Code)
+			}
+		})
+	}
+}
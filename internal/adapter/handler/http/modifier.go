@@ -0,0 +1,176 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// ModifierHandler represents the HTTP handler for modifier-related requests
+type ModifierHandler struct {
+	svc port.ModifierService
+}
+
+// NewModifierHandler creates a new ModifierHandler instance
+func NewModifierHandler(svc port.ModifierService) *ModifierHandler {
+	return &ModifierHandler{
+		svc,
+	}
+}
+
+// modifierRequest represents a modifier request body
+type modifierRequest struct {
+	Name               string  `json:"name" binding:"required" example:"Oat milk"`
+	Price              float64 `json:"price" binding:"min=0" example:"5000"`
+	IngredientID       uint64  `json:"ingredient_id" binding:"omitempty,min=1" example:"2"`
+	IngredientQuantity float64 `json:"ingredient_qty" binding:"required_with=IngredientID,omitempty,gt=0" example:"0.2"`
+}
+
+// createModifierGroupRequest represents a request body for creating a new modifier group
+type createModifierGroupRequest struct {
+	Name          string            `json:"name" binding:"required" example:"Milk"`
+	MinSelections uint64            `json:"min_selections" binding:"min=0" example:"1"`
+	MaxSelections uint64            `json:"max_selections" binding:"required,min=1" example:"1"`
+	Modifiers     []modifierRequest `json:"modifiers" binding:"required,min=1,dive"`
+}
+
+// CreateModifierGroup godoc
+//
+//	@Summary		Create a new modifier group
+//	@Description	create a new group of modifiers for a product, like the milk of a coffee. Each order of the product chooses between the minimum and maximum selections of the group, so a group with a minimum is required. The price of a chosen modifier is added to each unit of the product, and its ingredient, if any, is consumed from stock by the given quantity for each unit
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			id							path		uint64						true	"Product ID"
+//	@Param			createModifierGroupRequest	body		createModifierGroupRequest	true	"Create modifier group request"
+//	@Success		200							{object}	modifierGroupResponse		"Modifier group created"
+//	@Failure		400							{object}	errorResponse				"Validation error"
+//	@Failure		401							{object}	errorResponse				"Unauthorized error"
+//	@Failure		403							{object}	errorResponse				"Forbidden error"
+//	@Failure		404							{object}	errorResponse				"Data not found error"
+//	@Failure		409							{object}	errorResponse				"Data conflict error"
+//	@Failure		500							{object}	errorResponse				"Internal server error"
+//	@Router			/products/{id}/modifier-groups [post]
+//	@Security		BearerAuth
+func (mh *ModifierHandler) CreateModifierGroup(ctx *gin.Context) {
+	var req createModifierGroupRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	productID, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	var modifiers []domain.Modifier
+	for _, modifier := range req.Modifiers {
+		modifiers = append(modifiers, domain.Modifier{
+			Name:               modifier.Name,
+			Price:              modifier.Price,
+			IngredientID:       modifier.IngredientID,
+			IngredientQuantity: modifier.IngredientQuantity,
+		})
+	}
+
+	group := domain.ModifierGroup{
+		ProductID:     productID,
+		Name:          req.Name,
+		MinSelections: req.MinSelections,
+		MaxSelections: req.MaxSelections,
+		Modifiers:     modifiers,
+	}
+
+	_, err = mh.svc.CreateModifierGroup(ctx, &group)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newModifierGroupResponse(&group)
+
+	handleSuccess(ctx, rsp)
+}
+
+// listModifierGroupsRequest represents a request body for listing the modifier groups of a product
+type listModifierGroupsRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// ListModifierGroups godoc
+//
+//	@Summary		List modifier groups
+//	@Description	list the modifier groups of a product with their modifiers
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64					true	"Product ID"
+//	@Success		200	{array}		modifierGroupResponse	"Modifier groups displayed"
+//	@Failure		400	{object}	errorResponse			"Validation error"
+//	@Failure		401	{object}	errorResponse			"Unauthorized error"
+//	@Failure		404	{object}	errorResponse			"Data not found error"
+//	@Failure		500	{object}	errorResponse			"Internal server error"
+//	@Router			/products/{id}/modifier-groups [get]
+//	@Security		BearerAuth
+func (mh *ModifierHandler) ListModifierGroups(ctx *gin.Context) {
+	var req listModifierGroupsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	groups, err := mh.svc.ListModifierGroups(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := []modifierGroupResponse{}
+	for _, group := range groups {
+		rsp = append(rsp, newModifierGroupResponse(&group))
+	}
+
+	handleSuccess(ctx, rsp)
+}
+
+// deleteModifierGroupRequest represents a request body for deleting a modifier group of a product
+type deleteModifierGroupRequest struct {
+	ID      uint64 `uri:"id" binding:"required,min=1" example:"1"`
+	GroupID uint64 `uri:"group_id" binding:"required,min=1" example:"1"`
+}
+
+// DeleteModifierGroup godoc
+//
+//	@Summary		Delete a modifier group
+//	@Description	delete a modifier group of a product with its modifiers. Orders keep the names and prices of the modifiers chosen on them
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		uint64			true	"Product ID"
+//	@Param			group_id	path		uint64			true	"Modifier group ID"
+//	@Success		200			{object}	response		"Modifier group deleted"
+//	@Failure		400			{object}	errorResponse	"Validation error"
+//	@Failure		401			{object}	errorResponse	"Unauthorized error"
+//	@Failure		403			{object}	errorResponse	"Forbidden error"
+//	@Failure		404			{object}	errorResponse	"Data not found error"
+//	@Failure		500			{object}	errorResponse	"Internal server error"
+//	@Router			/products/{id}/modifier-groups/{group_id} [delete]
+//	@Security		BearerAuth
+func (mh *ModifierHandler) DeleteModifierGroup(ctx *gin.Context) {
+	var req deleteModifierGroupRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := mh.svc.DeleteModifierGroup(ctx, req.ID, req.GroupID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
@@ -20,28 +20,33 @@ func NewOrderHandler(svc port.OrderService) *OrderHandler {
 
 // orderProductRequest represents an order product request body
 type orderProductRequest struct {
-	ProductID uint64 `json:"product_id" binding:"required,min=1" example:"1"`
-	Quantity  int64  `json:"qty" binding:"required,number" example:"1"`
+	ProductID     uint64   `json:"product_id" binding:"required,min=1" example:"1"`
+	Quantity      float64  `json:"qty" binding:"required,gt=0" example:"1"`
+	Unit          string   `json:"unit" binding:"omitempty" example:"pcs"`
+	SerialNumbers []string `json:"serial_numbers" binding:"omitempty,dive,required" example:"SN-0001"`
+	ModifierIDs   []uint64 `json:"modifier_ids" binding:"omitempty,dive,min=1" example:"1"`
 }
 
 // createOrderRequest represents a request body for creating a new order
 type createOrderRequest struct {
-	PaymentID    uint64                `json:"payment_id" binding:"required" example:"1"`
-	CustomerName string                `json:"customer_name" binding:"required" example:"John Doe"`
-	TotalPaid    int64                 `json:"total_paid" binding:"required" example:"100000"`
-	Products     []orderProductRequest `json:"products" binding:"required"`
+	PaymentID     uint64                `json:"payment_id" binding:"required" example:"1"`
+	CustomerName  string                `json:"customer_name" binding:"required" example:"John Doe"`
+	CustomerGroup domain.CustomerGroup  `json:"customer_group" binding:"omitempty,customer_group" example:"retail"`
+	TotalPaid     int64                 `json:"total_paid" binding:"required" example:"100000"`
+	Products      []orderProductRequest `json:"products" binding:"required"`
 }
 
 // CreateOrder godoc
 //
 //	@Summary		Create a new order
-//	@Description	Create a new order and return the order data with purchase details
+//	@Description	Create a new order, priced with the price lists that apply to the customer group at the time of the order plus the prices of the modifiers chosen on each product, and return the order data with purchase details. Pricing an order for a customer group other than retail requires the orders.customer_group permission
 //	@Tags			Orders
 //	@Accept			json
 //	@Produce		json
 //	@Param			createOrderRequest	body		createOrderRequest	true	"Create order request"
 //	@Success		200					{object}	orderResponse		"Order created"
 //	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
 //	@Failure		404					{object}	errorResponse		"Data not found error"
 //	@Failure		409					{object}	errorResponse		"Data conflict error"
 //	@Failure		500					{object}	errorResponse		"Internal server error"
@@ -57,20 +62,36 @@ func (oh *OrderHandler) CreateOrder(ctx *gin.Context) {
 	}
 
 	for _, product := range req.Products {
+		var modifiers []domain.OrderModifier
+		for _, modifierID := range product.ModifierIDs {
+			modifiers = append(modifiers, domain.OrderModifier{
+				ModifierID: modifierID,
+			})
+		}
+
 		products = append(products, domain.OrderProduct{
-			ProductID: product.ProductID,
-			Quantity:  product.Quantity,
+			ProductID:     product.ProductID,
+			Quantity:      product.Quantity,
+			Unit:          product.Unit,
+			SerialNumbers: product.SerialNumbers,
+			Modifiers:     modifiers,
 		})
 	}
 
 	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
 
+	if req.CustomerGroup != "" && req.CustomerGroup != domain.CustomerRetail && !authPayload.HasPermission(domain.OrdersCustomerGroup) {
+		handleError(ctx, domain.ErrForbidden)
+		return
+	}
+
 	order := domain.Order{
-		UserID:       authPayload.UserID,
-		PaymentID:    req.PaymentID,
-		CustomerName: req.CustomerName,
-		TotalPaid:    float64(req.TotalPaid),
-		Products:     products,
+		UserID:        authPayload.UserID,
+		PaymentID:     req.PaymentID,
+		CustomerName:  req.CustomerName,
+		CustomerGroup: req.CustomerGroup,
+		TotalPaid:     float64(req.TotalPaid),
+		Products:      products,
 	}
 
 	_, err := oh.svc.CreateOrder(ctx, &order)
@@ -167,3 +188,70 @@ func (oh *OrderHandler) ListOrders(ctx *gin.Context) {
 
 	handleSuccess(ctx, rsp)
 }
+
+// getOrderTicketRequest represents a request body for rendering a ticket of an order
+type getOrderTicketRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// GetReceipt godoc
+//
+//	@Summary		Print an order receipt
+//	@Description	Render the customer receipt of an order as plain text for a receipt printer, listing each product with the modifiers chosen on it and the totals of the order
+//	@Tags			Orders
+//	@Accept			json
+//	@Produce		plain
+//	@Param			id	path		uint64			true	"Order ID"
+//	@Success		200	{string}	string			"Receipt rendered"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/orders/{id}/receipt [get]
+//	@Security		BearerAuth
+func (oh *OrderHandler) GetReceipt(ctx *gin.Context) {
+	var req getOrderTicketRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	ticket, err := oh.svc.GetReceipt(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleTicket(ctx, "receipt", ticket)
+}
+
+// GetKitchenTicket godoc
+//
+//	@Summary		Print an order kitchen ticket
+//	@Description	Render the kitchen ticket of an order as plain text for a kitchen printer, listing each product with the modifiers chosen on it without prices
+//	@Tags			Orders
+//	@Accept			json
+//	@Produce		plain
+//	@Param			id	path		uint64			true	"Order ID"
+//	@Success		200	{string}	string			"Kitchen ticket rendered"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/orders/{id}/kitchen-ticket [get]
+//	@Security		BearerAuth
+func (oh *OrderHandler) GetKitchenTicket(ctx *gin.Context) {
+	var req getOrderTicketRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	ticket, err := oh.svc.GetKitchenTicket(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleTicket(ctx, "kitchen-ticket", ticket)
+}
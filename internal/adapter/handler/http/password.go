@@ -0,0 +1,126 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// PasswordHandler represents the HTTP handler for password-related requests
+type PasswordHandler struct {
+	svc port.PasswordService
+}
+
+// NewPasswordHandler creates a new PasswordHandler instance
+func NewPasswordHandler(svc port.PasswordService) *PasswordHandler {
+	return &PasswordHandler{
+		svc,
+	}
+}
+
+// changePasswordRequest represents the request body for changing the password of the current user
+type changePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required" example:"12345678"`
+	NewPassword     string `json:"new_password" binding:"required,min=8" example:"87654321" minLength:"8"`
+}
+
+// ChangePassword godoc
+//
+//	@Summary		Change the password of the current user
+//	@Description	Replaces the password of the current user if the current password is correct. Every access token and refresh token of the user is revoked, so the user has to log in again. Incorrect current passwords count as failed login attempts, delaying and then locking out further attempts for the user and the client IP address.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		changePasswordRequest	true	"Change password request body"
+//	@Success		200		{object}	response				"Password changed"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Unauthorized error"
+//	@Failure		403		{object}	errorResponse			"Incorrect current password error"
+//	@Failure		429		{object}	errorResponse			"Too many failed login attempts error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/users/password [put]
+//	@Security		BearerAuth
+func (ph *PasswordHandler) ChangePassword(ctx *gin.Context) {
+	var req changePasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ph.svc.ChangePassword(ctx, payload.UserID, req.CurrentPassword, req.NewPassword, ctx.ClientIP())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// requestPasswordResetRequest represents the request body for requesting a password reset
+type requestPasswordResetRequest struct {
+	Email string `json:"email" binding:"required,email" example:"test@example.com"`
+}
+
+// RequestPasswordReset godoc
+//
+//	@Summary		Request a password reset
+//	@Description	Sends a single-use password reset token that expires to the user with the email. Succeeds whether or not the email is registered, and sends at most one token per email a minute. The token is sent after the response, so the response time does not reveal whether the email is registered either.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		requestPasswordResetRequest	true	"Request password reset request body"
+//	@Success		200		{object}	response					"Password reset requested"
+//	@Failure		400		{object}	errorResponse				"Validation error"
+//	@Failure		500		{object}	errorResponse				"Internal server error"
+//	@Router			/users/password-reset [post]
+func (ph *PasswordHandler) RequestPasswordReset(ctx *gin.Context) {
+	var req requestPasswordResetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := ph.svc.RequestPasswordReset(ctx, req.Email)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// resetPasswordRequest represents the request body for resetting a password with a password reset token
+type resetPasswordRequest struct {
+	Token    string `json:"token" binding:"required" example:"q3Zc0b8m1TQ2yXk9lV4pN7aH5sJ6dR0eW1uC3fG8iKo"`
+	Password string `json:"password" binding:"required,min=8" example:"87654321" minLength:"8"`
+}
+
+// ResetPassword godoc
+//
+//	@Summary		Reset a password
+//	@Description	Replaces the password of the user a password reset token was sent to. The token can only be used once, and every access token, refresh token and other password reset token of the user is revoked.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		resetPasswordRequest	true	"Reset password request body"
+//	@Success		200		{object}	response				"Password reset"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Invalid or expired password reset token error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/users/password-reset/confirm [post]
+func (ph *PasswordHandler) ResetPassword(ctx *gin.Context) {
+	var req resetPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := ph.svc.ResetPassword(ctx, req.Token, req.Password)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
@@ -0,0 +1,201 @@
+package http
+
+import (
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// PriceHandler represents the HTTP handler for price history and scheduled price requests
+type PriceHandler struct {
+	svc port.PriceService
+}
+
+// NewPriceHandler creates a new PriceHandler instance
+func NewPriceHandler(svc port.PriceService) *PriceHandler {
+	return &PriceHandler{
+		svc,
+	}
+}
+
+// listPriceHistoryRequest represents a request body for listing the price history of a product
+type listPriceHistoryRequest struct {
+	ProductID uint64 `form:"product_id" binding:"required,min=1" example:"1"`
+	Skip      uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit     uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListPriceHistory godoc
+//
+//	@Summary		List price history
+//	@Description	List the price changes of a product with who made them, newest first, with pagination
+//	@Tags			Prices
+//	@Accept			json
+//	@Produce		json
+//	@Param			product_id	query		uint64			true	"Product ID"
+//	@Param			skip		query		uint64			true	"Skip"
+//	@Param			limit		query		uint64			true	"Limit"
+//	@Success		200			{object}	meta			"Price history retrieved"
+//	@Failure		400			{object}	errorResponse	"Validation error"
+//	@Failure		404			{object}	errorResponse	"Data not found error"
+//	@Failure		500			{object}	errorResponse	"Internal server error"
+//	@Router			/prices/history [get]
+//	@Security		BearerAuth
+func (ph *PriceHandler) ListPriceHistory(ctx *gin.Context) {
+	var req listPriceHistoryRequest
+	var priceChangesList []priceChangeResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	priceChanges, err := ph.svc.ListPriceHistory(ctx, req.ProductID, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, priceChange := range priceChanges {
+		priceChangesList = append(priceChangesList, newPriceChangeResponse(&priceChange))
+	}
+
+	total := uint64(len(priceChangesList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, priceChangesList, "price_changes")
+
+	handleSuccess(ctx, rsp)
+}
+
+// schedulePriceRequest represents a request body for scheduling a future price of a product
+type schedulePriceRequest struct {
+	ProductID   uint64    `json:"product_id" binding:"required,min=1" example:"1"`
+	Price       float64   `json:"price" binding:"required,min=0" example:"5500"`
+	EffectiveAt time.Time `json:"effective_at" binding:"required" example:"2024-12-31T00:00:00Z"`
+}
+
+// SchedulePrice godoc
+//
+//	@Summary		Schedule a price change
+//	@Description	schedule a future price of a product, applied by a background job once its effective time is reached
+//	@Tags			Prices
+//	@Accept			json
+//	@Produce		json
+//	@Param			schedulePriceRequest	body		schedulePriceRequest	true	"Schedule price request"
+//	@Success		200						{object}	scheduledPriceResponse	"Price scheduled"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		404						{object}	errorResponse			"Data not found error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/prices/scheduled [post]
+//	@Security		BearerAuth
+func (ph *PriceHandler) SchedulePrice(ctx *gin.Context) {
+	var req schedulePriceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	scheduledPrice := domain.ScheduledPrice{
+		ProductID:   req.ProductID,
+		UserID:      authPayload.UserID,
+		Price:       req.Price,
+		EffectiveAt: req.EffectiveAt,
+	}
+
+	_, err := ph.svc.SchedulePrice(ctx, &scheduledPrice)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newScheduledPriceResponse(&scheduledPrice)
+
+	handleSuccess(ctx, rsp)
+}
+
+// listScheduledPricesRequest represents a request body for listing the scheduled prices of a product
+type listScheduledPricesRequest struct {
+	ProductID uint64 `form:"product_id" binding:"required,min=1" example:"1"`
+	Pending   bool   `form:"pending" binding:"omitempty" example:"true"`
+}
+
+// ListScheduledPrices godoc
+//
+//	@Summary		List scheduled prices
+//	@Description	List the scheduled prices of a product ordered by effective time, optionally only the ones not applied yet
+//	@Tags			Prices
+//	@Accept			json
+//	@Produce		json
+//	@Param			product_id	query		uint64						true	"Product ID"
+//	@Param			pending		query		bool						false	"Only scheduled prices not applied yet"
+//	@Success		200			{object}	[]scheduledPriceResponse	"Scheduled prices retrieved"
+//	@Failure		400			{object}	errorResponse				"Validation error"
+//	@Failure		404			{object}	errorResponse				"Data not found error"
+//	@Failure		500			{object}	errorResponse				"Internal server error"
+//	@Router			/prices/scheduled [get]
+//	@Security		BearerAuth
+func (ph *PriceHandler) ListScheduledPrices(ctx *gin.Context) {
+	var req listScheduledPricesRequest
+	var scheduledPricesList []scheduledPriceResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	scheduledPrices, err := ph.svc.ListScheduledPrices(ctx, req.ProductID, req.Pending)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, scheduledPrice := range scheduledPrices {
+		scheduledPricesList = append(scheduledPricesList, newScheduledPriceResponse(&scheduledPrice))
+	}
+
+	handleSuccess(ctx, scheduledPricesList)
+}
+
+// cancelScheduledPriceRequest represents a request body for canceling a scheduled price
+type cancelScheduledPriceRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// CancelScheduledPrice godoc
+//
+//	@Summary		Cancel a scheduled price
+//	@Description	Cancel a scheduled price that has not taken effect yet
+//	@Tags			Prices
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Scheduled price ID"
+//	@Success		200	{object}	response		"Scheduled price canceled"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		409	{object}	errorResponse	"Data conflict error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/prices/scheduled/{id} [delete]
+//	@Security		BearerAuth
+func (ph *PriceHandler) CancelScheduledPrice(ctx *gin.Context) {
+	var req cancelScheduledPriceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := ph.svc.CancelScheduledPrice(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
@@ -0,0 +1,303 @@
+package http
+
+import (
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// PriceListHandler represents the HTTP handler for price list-related requests
+type PriceListHandler struct {
+	svc port.PriceListService
+}
+
+// NewPriceListHandler creates a new PriceListHandler instance
+func NewPriceListHandler(svc port.PriceListService) *PriceListHandler {
+	return &PriceListHandler{
+		svc,
+	}
+}
+
+// priceListItemRequest represents a price list item request body
+type priceListItemRequest struct {
+	ProductID uint64  `json:"product_id" binding:"required,min=1" example:"1"`
+	Price     float64 `json:"price" binding:"min=0" example:"4000"`
+}
+
+// priceListRequest represents a request body for creating or replacing a price list
+type priceListRequest struct {
+	Name          string                 `json:"name" binding:"required" example:"Happy Hour"`
+	CustomerGroup domain.CustomerGroup   `json:"customer_group" binding:"omitempty,customer_group" example:"retail"`
+	Priority      int                    `json:"priority" binding:"omitempty" example:"10"`
+	StartsAt      *time.Time             `json:"starts_at" binding:"omitempty" example:"2024-01-01T00:00:00Z"`
+	EndsAt        *time.Time             `json:"ends_at" binding:"omitempty" example:"2024-12-31T00:00:00Z"`
+	StartTime     string                 `json:"start_time" binding:"omitempty" example:"16:00"`
+	EndTime       string                 `json:"end_time" binding:"omitempty" example:"18:00"`
+	Items         []priceListItemRequest `json:"items" binding:"required,dive"`
+}
+
+// toPriceList is a helper function to create a price list from a price list request body
+func (req *priceListRequest) toPriceList(id uint64) domain.PriceList {
+	var items []domain.PriceListItem
+	for _, item := range req.Items {
+		items = append(items, domain.PriceListItem{
+			ProductID: item.ProductID,
+			Price:     item.Price,
+		})
+	}
+
+	return domain.PriceList{
+		ID:            id,
+		Name:          req.Name,
+		CustomerGroup: req.CustomerGroup,
+		Priority:      req.Priority,
+		StartsAt:      req.StartsAt,
+		EndsAt:        req.EndsAt,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		Items:         items,
+	}
+}
+
+// CreatePriceList godoc
+//
+//	@Summary		Create a new price list
+//	@Description	create a new price list of product prices for a customer group, or every customer group when none is given, within an optional validity period and daily time window in HH:MM, which spans midnight when it ends before it starts
+//	@Tags			PriceLists
+//	@Accept			json
+//	@Produce		json
+//	@Param			priceListRequest	body		priceListRequest	true	"Create price list request"
+//	@Success		200					{object}	priceListResponse	"Price list created"
+//	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		401					{object}	errorResponse		"Unauthorized error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
+//	@Failure		404					{object}	errorResponse		"Data not found error"
+//	@Failure		409					{object}	errorResponse		"Data conflict error"
+//	@Failure		500					{object}	errorResponse		"Internal server error"
+//	@Router			/price-lists [post]
+//	@Security		BearerAuth
+func (plh *PriceListHandler) CreatePriceList(ctx *gin.Context) {
+	var req priceListRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	priceList := req.toPriceList(0)
+
+	_, err := plh.svc.CreatePriceList(ctx, &priceList)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newPriceListResponse(&priceList)
+
+	handleSuccess(ctx, rsp)
+}
+
+// getPriceListRequest represents a request body for retrieving a price list
+type getPriceListRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// GetPriceList godoc
+//
+//	@Summary		Get a price list
+//	@Description	get a price list by id along with its product prices
+//	@Tags			PriceLists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64				true	"Price list ID"
+//	@Success		200	{object}	priceListResponse	"Price list retrieved"
+//	@Failure		400	{object}	errorResponse		"Validation error"
+//	@Failure		404	{object}	errorResponse		"Data not found error"
+//	@Failure		500	{object}	errorResponse		"Internal server error"
+//	@Router			/price-lists/{id} [get]
+//	@Security		BearerAuth
+func (plh *PriceListHandler) GetPriceList(ctx *gin.Context) {
+	var req getPriceListRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	priceList, err := plh.svc.GetPriceList(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newPriceListResponse(priceList)
+
+	handleSuccess(ctx, rsp)
+}
+
+// listPriceListsRequest represents a request body for listing price lists
+type listPriceListsRequest struct {
+	Skip  uint64 `form:"skip" binding:"required,min=0" example:"0"`
+	Limit uint64 `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListPriceLists godoc
+//
+//	@Summary		List price lists
+//	@Description	List price lists with pagination
+//	@Tags			PriceLists
+//	@Accept			json
+//	@Produce		json
+//	@Param			skip	query		uint64			true	"Skip"
+//	@Param			limit	query		uint64			true	"Limit"
+//	@Success		200		{object}	meta			"Price lists retrieved"
+//	@Failure		400		{object}	errorResponse	"Validation error"
+//	@Failure		500		{object}	errorResponse	"Internal server error"
+//	@Router			/price-lists [get]
+//	@Security		BearerAuth
+func (plh *PriceListHandler) ListPriceLists(ctx *gin.Context) {
+	var req listPriceListsRequest
+	var priceListsList []priceListResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	priceLists, err := plh.svc.ListPriceLists(ctx, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, priceList := range priceLists {
+		priceListsList = append(priceListsList, newPriceListResponse(&priceList))
+	}
+
+	total := uint64(len(priceListsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, priceListsList, "price_lists")
+
+	handleSuccess(ctx, rsp)
+}
+
+// UpdatePriceList godoc
+//
+//	@Summary		Update a price list
+//	@Description	replace the customer group, validity, time window and product prices of a price list
+//	@Tags			PriceLists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		uint64				true	"Price list ID"
+//	@Param			priceListRequest	body		priceListRequest	true	"Update price list request"
+//	@Success		200					{object}	priceListResponse	"Price list updated"
+//	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		401					{object}	errorResponse		"Unauthorized error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
+//	@Failure		404					{object}	errorResponse		"Data not found error"
+//	@Failure		409					{object}	errorResponse		"Data conflict error"
+//	@Failure		500					{object}	errorResponse		"Internal server error"
+//	@Router			/price-lists/{id} [put]
+//	@Security		BearerAuth
+func (plh *PriceListHandler) UpdatePriceList(ctx *gin.Context) {
+	var req priceListRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	id, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	priceList := req.toPriceList(id)
+
+	_, err = plh.svc.UpdatePriceList(ctx, &priceList)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newPriceListResponse(&priceList)
+
+	handleSuccess(ctx, rsp)
+}
+
+// deletePriceListRequest represents a request body for deleting a price list
+type deletePriceListRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// DeletePriceList godoc
+//
+//	@Summary		Delete a price list
+//	@Description	Delete a price list by id, keeping the orders priced with it
+//	@Tags			PriceLists
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Price list ID"
+//	@Success		200	{object}	response		"Price list deleted"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/price-lists/{id} [delete]
+//	@Security		BearerAuth
+func (plh *PriceListHandler) DeletePriceList(ctx *gin.Context) {
+	var req deletePriceListRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := plh.svc.DeletePriceList(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// getProductPriceRequest represents a request body for looking up the current price of a product
+type getProductPriceRequest struct {
+	ProductID     uint64               `form:"product_id" binding:"required,min=1" example:"1"`
+	CustomerGroup domain.CustomerGroup `form:"customer_group" binding:"omitempty,customer_group" example:"wholesale"`
+}
+
+// GetProductPrice godoc
+//
+//	@Summary		Look up the current price of a product
+//	@Description	get the price a product is sold at right now to a customer group, defaulting to retail, along with the price list it comes from, if any
+//	@Tags			PriceLists
+//	@Accept			json
+//	@Produce		json
+//	@Param			product_id		query		uint64					true	"Product ID"
+//	@Param			customer_group	query		string					false	"Customer group"	Enums(retail, wholesale, staff)
+//	@Success		200				{object}	productPriceResponse	"Product price retrieved"
+//	@Failure		400				{object}	errorResponse			"Validation error"
+//	@Failure		404				{object}	errorResponse			"Data not found error"
+//	@Failure		500				{object}	errorResponse			"Internal server error"
+//	@Router			/price-lists/price [get]
+//	@Security		BearerAuth
+func (plh *PriceListHandler) GetProductPrice(ctx *gin.Context) {
+	var req getProductPriceRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	productPrice, err := plh.svc.GetProductPrice(ctx, req.ProductID, req.CustomerGroup, time.Now())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newProductPriceResponse(productPrice)
+
+	handleSuccess(ctx, rsp)
+}
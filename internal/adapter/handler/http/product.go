@@ -18,19 +18,49 @@ func NewProductHandler(svc port.ProductService) *ProductHandler {
 	}
 }
 
+// productOptionRequest represents an option dimension request body of a parent product
+type productOptionRequest struct {
+	Name   string   `json:"name" binding:"required" example:"Size"`
+	Values []string `json:"values" binding:"required,min=1,dive,required" example:"S,M,L"`
+}
+
+// bundleComponentRequest represents a component request body of a bundle product
+type bundleComponentRequest struct {
+	ProductID uint64  `json:"product_id" binding:"required,min=1" example:"1"`
+	Quantity  float64 `json:"qty" binding:"required,gt=0" example:"2"`
+}
+
+// productUnitRequest represents an alternative unit of measure request body of a product
+type productUnitRequest struct {
+	Name   string  `json:"name" binding:"required" example:"carton"`
+	Factor float64 `json:"factor" binding:"required,gt=0" example:"24"`
+}
+
 // createProductRequest represents a request body for creating a new product
 type createProductRequest struct {
-	CategoryID uint64  `json:"category_id" binding:"required,min=1" example:"1"`
-	Name       string  `json:"name" binding:"required" example:"Chiki Ball"`
-	Image      string  `json:"image" binding:"required" example:"https://example.com/chiki-ball.png"`
-	Price      float64 `json:"price" binding:"required,min=0" example:"5000"`
-	Stock      int64   `json:"stock" binding:"required,min=0" example:"100"`
+	CategoryID   uint64                   `json:"category_id" binding:"required_without=ParentID" example:"1"`
+	ParentID     uint64                   `json:"parent_id" binding:"omitempty,min=1" example:"0"`
+	Name         string                   `json:"name" binding:"required_without=ParentID" example:"Chiki Ball"`
+	Image        string                   `json:"image" binding:"required_without=ParentID" example:"https://example.com/chiki-ball.png"`
+	Barcodes     []string                 `json:"barcodes" binding:"omitempty,dive,numeric" example:"8991234567891"`
+	Price        float64                  `json:"price" binding:"required,min=0" example:"5000"`
+	Cost         float64                  `json:"cost" binding:"omitempty,min=0" example:"3500"`
+	Valuation    domain.ValuationMethod   `json:"valuation" binding:"omitempty,valuation_method" example:"average"`
+	Stock        float64                  `json:"stock" binding:"required_without=Components,min=0" example:"100"`
+	Unit         string                   `json:"unit" binding:"omitempty" example:"pcs"`
+	Fractional   bool                     `json:"fractional" example:"false"`
+	Units        []productUnitRequest     `json:"units" binding:"omitempty,dive"`
+	TrackLots    bool                     `json:"track_lots" example:"false"`
+	TrackSerials bool                     `json:"track_serials" example:"false"`
+	Options      []productOptionRequest   `json:"options" binding:"omitempty,dive"`
+	OptionValues map[string]string        `json:"option_values" binding:"required_with=ParentID"`
+	Components   []bundleComponentRequest `json:"components" binding:"omitempty,dive"`
 }
 
 // CreateProduct godoc
 //
 //	@Summary		Create a new product
-//	@Description	create a new product with name, image, price, and stock
+//	@Description	create a new product with name, image, price, stock, units of measure, and whether it is tracked by lot or serial number, a variant of a parent product with its option values, or a bundle of component products whose stock is derived from the components
 //	@Tags			Products
 //	@Accept			json
 //	@Produce		json
@@ -51,12 +81,57 @@ func (ph *ProductHandler) CreateProduct(ctx *gin.Context) {
 		return
 	}
 
+	var options []domain.ProductOption
+	for _, option := range req.Options {
+		options = append(options, domain.ProductOption{
+			Name:   option.Name,
+			Values: option.Values,
+		})
+	}
+
+	var units []domain.ProductUnit
+	for _, unit := range req.Units {
+		units = append(units, domain.ProductUnit{
+			Name:   unit.Name,
+			Factor: unit.Factor,
+		})
+	}
+
+	var barcodes []domain.Barcode
+	for _, code := range req.Barcodes {
+		barcodes = append(barcodes, domain.Barcode{
+			Code: code,
+			Type: domain.BarcodeStandard,
+		})
+	}
+
+	var components []domain.BundleComponent
+	for _, component := range req.Components {
+		components = append(components, domain.BundleComponent{
+			ComponentID: component.ProductID,
+			Quantity:    component.Quantity,
+		})
+	}
+
 	product := domain.Product{
-		CategoryID: req.CategoryID,
-		Name:       req.Name,
-		Image:      req.Image,
-		Price:      req.Price,
-		Stock:      req.Stock,
+		CategoryID:   req.CategoryID,
+		ParentID:     req.ParentID,
+		Name:         req.Name,
+		Image:        req.Image,
+		Barcodes:     barcodes,
+		Price:        req.Price,
+		Cost:         req.Cost,
+		Valuation:    req.Valuation,
+		Stock:        req.Stock,
+		TrackLots:    req.TrackLots,
+		TrackSerials: req.TrackSerials,
+		Options:      options,
+		OptionValues: req.OptionValues,
+		IsBundle:     len(components) > 0,
+		Unit:         req.Unit,
+		Fractional:   req.Fractional,
+		Units:        units,
+		Components:   components,
 	}
 
 	_, err := ph.svc.CreateProduct(ctx, &product)
@@ -111,6 +186,7 @@ func (ph *ProductHandler) GetProduct(ctx *gin.Context) {
 type listProductsRequest struct {
 	CategoryID uint64 `form:"category_id" binding:"omitempty,min=1" example:"1"`
 	Query      string `form:"q" binding:"omitempty" example:"Chiki"`
+	Grouped    bool   `form:"grouped" binding:"omitempty" example:"false"`
 	Skip       uint64 `form:"skip" binding:"required,min=0" example:"0"`
 	Limit      uint64 `form:"limit" binding:"required,min=5" example:"5"`
 }
@@ -118,12 +194,13 @@ type listProductsRequest struct {
 // ListProducts godoc
 //
 //	@Summary		List products
-//	@Description	List products with pagination
+//	@Description	List products with pagination, optionally grouping variants under their parent product
 //	@Tags			Products
 //	@Accept			json
 //	@Produce		json
-//	@Param			category_id	query		uint64			false	"Category ID"
+//	@Param			category_id	query		uint64			false	"Category ID, including its sub-categories"
 //	@Param			q			query		string			false	"Query"
+//	@Param			grouped		query		bool			false	"Group variants under their parent product"
 //	@Param			skip		query		uint64			true	"Skip"
 //	@Param			limit		query		uint64			true	"Limit"
 //	@Success		200			{object}	meta			"Products retrieved"
@@ -140,7 +217,7 @@ func (ph *ProductHandler) ListProducts(ctx *gin.Context) {
 		return
 	}
 
-	products, err := ph.svc.ListProducts(ctx, req.Query, req.CategoryID, req.Skip, req.Limit)
+	products, err := ph.svc.ListProducts(ctx, req.Query, req.CategoryID, req.Skip, req.Limit, req.Grouped)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -159,17 +236,19 @@ func (ph *ProductHandler) ListProducts(ctx *gin.Context) {
 
 // updateProductRequest represents a request body for updating a product
 type updateProductRequest struct {
-	CategoryID uint64  `json:"category_id" binding:"omitempty,required,min=1" example:"1"`
-	Name       string  `json:"name" binding:"omitempty,required" example:"Nutrisari Jeruk"`
-	Image      string  `json:"image" binding:"omitempty,required" example:"https://example.com/nutrisari-jeruk.png"`
-	Price      float64 `json:"price" binding:"omitempty,required,min=0" example:"2000"`
-	Stock      int64   `json:"stock" binding:"omitempty,required,min=0" example:"200"`
+	CategoryID uint64                 `json:"category_id" binding:"omitempty,required,min=1" example:"1"`
+	Name       string                 `json:"name" binding:"omitempty,required" example:"Nutrisari Jeruk"`
+	Image      string                 `json:"image" binding:"omitempty,required" example:"https://example.com/nutrisari-jeruk.png"`
+	Price      float64                `json:"price" binding:"omitempty,required,min=0" example:"2000"`
+	Stock      float64                `json:"stock" binding:"omitempty,required,min=0" example:"200"`
+	Cost       float64                `json:"cost" binding:"omitempty,required,min=0" example:"1500"`
+	Valuation  domain.ValuationMethod `json:"valuation" binding:"omitempty,valuation_method" example:"fifo"`
 }
 
 // UpdateProduct godoc
 //
 //	@Summary		Update a product
-//	@Description	update a product's name, image, price, or stock by id
+//	@Description	update a product's name, image, price, stock, average cost, or valuation method by id. Changing the price requires the products.edit_price permission
 //	@Tags			Products
 //	@Accept			json
 //	@Produce		json
@@ -198,6 +277,13 @@ func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 		return
 	}
 
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	if req.Price != 0 && !authPayload.HasPermission(domain.ProductsEditPrice) {
+		handleError(ctx, domain.ErrForbidden)
+		return
+	}
+
 	product := domain.Product{
 		ID:         id,
 		CategoryID: req.CategoryID,
@@ -205,9 +291,11 @@ func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 		Image:      req.Image,
 		Price:      req.Price,
 		Stock:      req.Stock,
+		Cost:       req.Cost,
+		Valuation:  req.Valuation,
 	}
 
-	_, err = ph.svc.UpdateProduct(ctx, &product)
+	_, err = ph.svc.UpdateProduct(ctx, &product, authPayload.UserID)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -218,6 +306,55 @@ func (ph *ProductHandler) UpdateProduct(ctx *gin.Context) {
 	handleSuccess(ctx, rsp)
 }
 
+// receiveProductRequest represents a request body for receiving stock of a product
+type receiveProductRequest struct {
+	Quantity float64 `json:"qty" binding:"required,gt=0" example:"2"`
+	Unit     string  `json:"unit" binding:"omitempty" example:"carton"`
+	Cost     float64 `json:"cost" binding:"omitempty,min=0" example:"84000"`
+}
+
+// ReceiveProduct godoc
+//
+//	@Summary		Receive stock of a product
+//	@Description	add a received quantity, converted from the given unit of measure, to the stock of a product by id, updating its average cost with the cost per unit received
+//	@Tags			Products
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		uint64					true	"Product ID"
+//	@Param			receiveProductRequest	body		receiveProductRequest	true	"Receive product request"
+//	@Success		200						{object}	productResponse			"Product stock received"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		404						{object}	errorResponse			"Data not found error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/products/{id}/receive [post]
+//	@Security		BearerAuth
+func (ph *ProductHandler) ReceiveProduct(ctx *gin.Context) {
+	var req receiveProductRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	id, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	product, err := ph.svc.ReceiveProduct(ctx, id, req.Quantity, req.Unit, req.Cost)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newProductResponse(product)
+
+	handleSuccess(ctx, rsp)
+}
+
 // deleteProductRequest represents a request body for deleting a product
 type deleteProductRequest struct {
 	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
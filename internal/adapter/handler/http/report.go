@@ -0,0 +1,85 @@
+package http
+
+import (
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// ReportHandler represents the HTTP handler for report-related requests
+type ReportHandler struct {
+	svc port.ReportService
+}
+
+// NewReportHandler creates a new ReportHandler instance
+func NewReportHandler(svc port.ReportService) *ReportHandler {
+	return &ReportHandler{
+		svc,
+	}
+}
+
+// getMarginReportRequest represents a request body for reporting the gross margin of sales over a period
+type getMarginReportRequest struct {
+	From time.Time `form:"from" binding:"required" time_format:"2006-01-02" example:"2024-01-01"`
+	To   time.Time `form:"to" binding:"required,gtefield=From" time_format:"2006-01-02" example:"2024-01-31"`
+}
+
+// GetMarginReport godoc
+//
+//	@Summary		Report gross margin
+//	@Description	report the quantity sold, revenue, cost and gross margin per product of the orders created between two dates, both inclusive
+//	@Tags			Reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			from	query		string					true	"Start date"	format(date)
+//	@Param			to		query		string					true	"End date"		format(date)
+//	@Success		200		{object}	marginReportResponse	"Margin report retrieved"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Unauthorized error"
+//	@Failure		403		{object}	errorResponse			"Forbidden error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/reports/margin [get]
+//	@Security		BearerAuth
+func (rh *ReportHandler) GetMarginReport(ctx *gin.Context) {
+	var req getMarginReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	report, err := rh.svc.GetMarginReport(ctx, req.From, req.To.AddDate(0, 0, 1))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newMarginReportResponse(report)
+
+	handleSuccess(ctx, rsp)
+}
+
+// GetInventoryValuation godoc
+//
+//	@Summary		Report inventory valuation
+//	@Description	report the value of the stock on hand per product, by weighted average cost or FIFO as set on each product
+//	@Tags			Reports
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	inventoryValuationResponse	"Inventory valuation retrieved"
+//	@Failure		401	{object}	errorResponse				"Unauthorized error"
+//	@Failure		403	{object}	errorResponse				"Forbidden error"
+//	@Failure		500	{object}	errorResponse				"Internal server error"
+//	@Router			/reports/valuation [get]
+//	@Security		BearerAuth
+func (rh *ReportHandler) GetInventoryValuation(ctx *gin.Context) {
+	inventory, err := rh.svc.GetInventoryValuation(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newInventoryValuationResponse(inventory)
+
+	handleSuccess(ctx, rsp)
+}
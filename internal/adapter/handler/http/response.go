@@ -1,7 +1,10 @@
 package http
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -42,15 +45,98 @@ func newMeta(total, limit, skip uint64) meta {
 	}
 }
 
-// authResponse represents an authentication response body
+// authResponse represents an authentication response body,
+// holding either the tokens or the two-factor challenge of a login
 type authResponse struct {
-	AccessToken string `json:"token" example:"v2.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."`
+	AccessToken      string                      `json:"token,omitempty" example:"v2.local.Gdh5kiOTyyaQ3_bNykYDeYHO21Jg2..."`
+	RefreshToken     string                      `json:"refresh_token,omitempty" example:"5Jt4ZGl7WZQ1xV0yqk0c0pK3yC8m0A1uYkq2mX8bXhA"`
+	RefreshExpiresAt *time.Time                  `json:"refresh_expires_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	TwoFactor        *twoFactorChallengeResponse `json:"two_factor,omitempty"`
 }
 
 // newAuthResponse is a helper function to create a response body for handling authentication data
-func newAuthResponse(token string) authResponse {
+func newAuthResponse(token *domain.AuthToken) authResponse {
+	if token.Challenge != nil {
+		challenge := newTwoFactorChallengeResponse(token.Challenge)
+		return authResponse{
+			TwoFactor: &challenge,
+		}
+	}
+
 	return authResponse{
-		AccessToken: token,
+		AccessToken:      token.AccessToken,
+		RefreshToken:     token.RefreshToken,
+		RefreshExpiresAt: &token.RefreshExpiresAt,
+	}
+}
+
+// twoFactorChallengeResponse represents a two-factor challenge response body
+type twoFactorChallengeResponse struct {
+	Challenge  string                       `json:"challenge" example:"Zk3n9QvX1c7bT2yW5pL0aR8sD4fH6jK1mN3qU5wE7rY"`
+	ExpiresAt  time.Time                    `json:"expires_at" example:"1970-01-01T00:00:00Z"`
+	Enrollment *twoFactorEnrollmentResponse `json:"enrollment,omitempty"`
+}
+
+// newTwoFactorChallengeResponse is a helper function to create a response body for handling two-factor challenge data
+func newTwoFactorChallengeResponse(challenge *domain.TwoFactorChallenge) twoFactorChallengeResponse {
+	rsp := twoFactorChallengeResponse{
+		Challenge: challenge.Token,
+		ExpiresAt: challenge.ExpiresAt,
+	}
+
+	if challenge.Enrollment != nil {
+		enrollment := newTwoFactorEnrollmentResponse(challenge.Enrollment)
+		rsp.Enrollment = &enrollment
+	}
+
+	return rsp
+}
+
+// twoFactorEnrollmentResponse represents a two-factor enrollment response body
+type twoFactorEnrollmentResponse struct {
+	Secret        string   `json:"secret" example:"JBSWY3DPEHPK3PXP"`
+	URI           string   `json:"uri" example:"otpauth://totp/go-pos:admin@example.com?algorithm=SHA1&digits=6&issuer=go-pos&period=30&secret=JBSWY3DPEHPK3PXP"`
+	RecoveryCodes []string `json:"recovery_codes" example:"k7qz-m2xa-4nvb-p9cd"`
+}
+
+// newTwoFactorEnrollmentResponse is a helper function to create a response body for handling two-factor enrollment data
+func newTwoFactorEnrollmentResponse(enrollment *domain.TwoFactorEnrollment) twoFactorEnrollmentResponse {
+	return twoFactorEnrollmentResponse{
+		Secret:        enrollment.Secret,
+		URI:           enrollment.URI,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}
+}
+
+// recoveryCodesResponse represents a recovery codes response body
+type recoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"k7qz-m2xa-4nvb-p9cd"`
+}
+
+// newRecoveryCodesResponse is a helper function to create a response body for handling recovery codes
+func newRecoveryCodesResponse(recoveryCodes []string) recoveryCodesResponse {
+	return recoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	}
+}
+
+// publicKeyResponse represents a public key response body
+type publicKeyResponse struct {
+	ID      string `json:"kid" example:"5e7bb340"`
+	Version string `json:"version" example:"v4"`
+	Purpose string `json:"purpose" example:"public"`
+	Key     string `json:"key" example:"1eb9dbbbbc047c03fd70604e0071f0987e16b28b757225c11f00415d0e20b1a2"`
+	PASERK  string `json:"paserk" example:"k4.public.Hrnbu7wEfAP9cGBOAHHwmH4Wsot1ciXBHwBBXQ4gsaI"`
+}
+
+// newPublicKeyResponse is a helper function to create a response body for handling public key data
+func newPublicKeyResponse(key *domain.PublicKey) publicKeyResponse {
+	return publicKeyResponse{
+		ID:      key.ID,
+		Version: "v4",
+		Purpose: "public",
+		Key:     hex.EncodeToString(key.Key),
+		PASERK:  "k4.public." + base64.RawURLEncoding.EncodeToString(key.Key),
 	}
 }
 
@@ -74,112 +160,652 @@ func newUserResponse(user *domain.User) userResponse {
 	}
 }
 
+// terminalResponse represents a terminal response body.
+// Credential is only set when the terminal is registered
+type terminalResponse struct {
+	ID         uint64    `json:"id" example:"1"`
+	Name       string    `json:"name" example:"Register 1"`
+	Credential string    `json:"credential,omitempty" example:"Zk9xN2pUQ0l0dWZqV3JmYnJ0cEhQd3lWQ2NkS3h6bEE"`
+	CreatedAt  time.Time `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt  time.Time `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newTerminalResponse is a helper function to create a response body for handling terminal data
+func newTerminalResponse(terminal *domain.Terminal) terminalResponse {
+	return terminalResponse{
+		ID:         terminal.ID,
+		Name:       terminal.Name,
+		Credential: terminal.Credential,
+		CreatedAt:  terminal.CreatedAt,
+		UpdatedAt:  terminal.UpdatedAt,
+	}
+}
+
+// roleResponse represents a role response body
+type roleResponse struct {
+	ID          uint64              `json:"id" example:"1"`
+	Name        domain.UserRole     `json:"name" example:"supervisor"`
+	Permissions []domain.Permission `json:"permissions" example:"products.edit_price,reports.view"`
+	CreatedAt   time.Time           `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt   time.Time           `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newRoleResponse is a helper function to create a response body for handling role data
+func newRoleResponse(role *domain.Role) roleResponse {
+	return roleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		Permissions: role.Permissions,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
 // paymentResponse represents a payment response body
 type paymentResponse struct {
-	ID   uint64             `json:"id" example:"1"`
-	Name string             `json:"name" example:"Tunai"`
-	Type domain.PaymentType `json:"type" example:"CASH"`
-	Logo string             `json:"logo" example:"https://example.com/cash.png"`
+	ID            uint64             `json:"id" example:"1"`
+	Name          string             `json:"name" example:"Tunai"`
+	Type          domain.PaymentType `json:"type" example:"CASH"`
+	Logo          string             `json:"logo" example:"https://example.com/cash.png"`
+	LogoThumbnail string             `json:"logo_thumbnail,omitempty" example:"https://example.com/cash_thumb.png"`
 }
 
 // newPaymentResponse is a helper function to create a response body for handling payment data
 func newPaymentResponse(payment *domain.Payment) paymentResponse {
 	return paymentResponse{
-		ID:   payment.ID,
-		Name: payment.Name,
-		Type: payment.Type,
-		Logo: payment.Logo,
+		ID:            payment.ID,
+		Name:          payment.Name,
+		Type:          payment.Type,
+		Logo:          payment.Logo,
+		LogoThumbnail: payment.LogoThumbnail,
 	}
 }
 
 // categoryResponse represents a category response body
 type categoryResponse struct {
-	ID   uint64 `json:"id" example:"1"`
-	Name string `json:"name" example:"Foods"`
+	ID       uint64 `json:"id" example:"1"`
+	ParentID uint64 `json:"parent_id,omitempty" example:"1"`
+	Name     string `json:"name" example:"Foods"`
 }
 
 // newCategoryResponse is a helper function to create a response body for handling category data
 func newCategoryResponse(category *domain.Category) categoryResponse {
 	return categoryResponse{
-		ID:   category.ID,
-		Name: category.Name,
+		ID:       category.ID,
+		ParentID: category.ParentID,
+		Name:     category.Name,
+	}
+}
+
+// categoryTreeResponse represents a category with its sub-categories response body
+type categoryTreeResponse struct {
+	ID       uint64                 `json:"id" example:"1"`
+	Name     string                 `json:"name" example:"Foods"`
+	Children []categoryTreeResponse `json:"children"`
+}
+
+// newCategoryTreeResponse is a helper function to create a response body for handling category tree data
+func newCategoryTreeResponse(nodes []domain.CategoryNode) []categoryTreeResponse {
+	tree := []categoryTreeResponse{}
+
+	for _, node := range nodes {
+		tree = append(tree, categoryTreeResponse{
+			ID:       node.ID,
+			Name:     node.Name,
+			Children: newCategoryTreeResponse(node.Children),
+		})
 	}
+
+	return tree
+}
+
+// productOptionResponse represents an option dimension response body of a parent product
+type productOptionResponse struct {
+	Name   string   `json:"name" example:"Size"`
+	Values []string `json:"values" example:"S,M,L"`
+}
+
+// bundleComponentResponse represents a component response body of a bundle product
+type bundleComponentResponse struct {
+	ProductID uint64  `json:"product_id" example:"1"`
+	Name      string  `json:"name" example:"Chiki Ball"`
+	Quantity  float64 `json:"qty" example:"2"`
+}
+
+// productUnitResponse represents an alternative unit of measure response body of a product
+type productUnitResponse struct {
+	Name   string  `json:"name" example:"carton"`
+	Factor float64 `json:"factor" example:"24"`
 }
 
 // productResponse represents a product response body
 type productResponse struct {
-	ID        uint64           `json:"id" example:"1"`
-	SKU       string           `json:"sku" example:"9a4c25d3-9786-492c-b084-85cb75c1ee3e"`
-	Name      string           `json:"name" example:"Chiki Ball"`
-	Stock     int64            `json:"stock" example:"100"`
-	Price     float64          `json:"price" example:"5000"`
-	Image     string           `json:"image" example:"https://example.com/chiki-ball.png"`
-	Category  categoryResponse `json:"category"`
-	CreatedAt time.Time        `json:"created_at" example:"1970-01-01T00:00:00Z"`
-	UpdatedAt time.Time        `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	ID           uint64                    `json:"id" example:"1"`
+	SKU          string                    `json:"sku" example:"9a4c25d3-9786-492c-b084-85cb75c1ee3e"`
+	ParentID     uint64                    `json:"parent_id,omitempty" example:"1"`
+	Name         string                    `json:"name" example:"Chiki Ball"`
+	Barcodes     []string                  `json:"barcodes,omitempty" example:"8991234567891"`
+	Stock        float64                   `json:"stock" example:"100"`
+	Unit         string                    `json:"unit" example:"pcs"`
+	Fractional   bool                      `json:"fractional" example:"false"`
+	Units        []productUnitResponse     `json:"units,omitempty"`
+	Price        float64                   `json:"price" example:"5000"`
+	Cost         float64                   `json:"cost" example:"3500"`
+	Valuation    domain.ValuationMethod    `json:"valuation" example:"average"`
+	Image        string                    `json:"image" example:"https://example.com/chiki-ball.png"`
+	Thumbnail    string                    `json:"thumbnail,omitempty" example:"https://example.com/chiki-ball_thumb.png"`
+	TrackLots    bool                      `json:"track_lots" example:"false"`
+	TrackSerials bool                      `json:"track_serials" example:"false"`
+	Options      []productOptionResponse   `json:"options,omitempty"`
+	OptionValues map[string]string         `json:"option_values,omitempty"`
+	Variants     []productResponse         `json:"variants,omitempty"`
+	IsBundle     bool                      `json:"is_bundle" example:"false"`
+	Components   []bundleComponentResponse `json:"components,omitempty"`
+	Category     categoryResponse          `json:"category"`
+	CreatedAt    time.Time                 `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt    time.Time                 `json:"updated_at" example:"1970-01-01T00:00:00Z"`
 }
 
 // newProductResponse is a helper function to create a response body for handling product data
 func newProductResponse(product *domain.Product) productResponse {
+	var options []productOptionResponse
+	var variants []productResponse
+	var components []bundleComponentResponse
+	var units []productUnitResponse
+	var barcodes []string
+
+	for _, option := range product.Options {
+		options = append(options, productOptionResponse{
+			Name:   option.Name,
+			Values: option.Values,
+		})
+	}
+
+	for _, variant := range product.Variants {
+		variants = append(variants, newProductResponse(&variant))
+	}
+
+	for _, barcode := range product.Barcodes {
+		barcodes = append(barcodes, barcode.Code)
+	}
+
+	for _, unit := range product.Units {
+		units = append(units, productUnitResponse{
+			Name:   unit.Name,
+			Factor: unit.Factor,
+		})
+	}
+
+	for _, component := range product.Components {
+		rsp := bundleComponentResponse{
+			ProductID: component.ComponentID,
+			Quantity:  component.Quantity,
+		}
+
+		if component.Component != nil {
+			rsp.Name = component.Component.Name
+		}
+
+		components = append(components, rsp)
+	}
+
 	return productResponse{
-		ID:        product.ID,
-		SKU:       product.SKU.String(),
-		Name:      product.Name,
-		Stock:     product.Stock,
-		Price:     product.Price,
-		Image:     product.Image,
-		Category:  newCategoryResponse(product.Category),
-		CreatedAt: product.CreatedAt,
-		UpdatedAt: product.UpdatedAt,
+		ID:           product.ID,
+		SKU:          product.SKU.String(),
+		ParentID:     product.ParentID,
+		Name:         product.Name,
+		Barcodes:     barcodes,
+		Stock:        product.Stock,
+		Unit:         product.Unit,
+		Fractional:   product.Fractional,
+		Units:        units,
+		Price:        product.Price,
+		Cost:         product.Cost,
+		Valuation:    product.Valuation,
+		Image:        product.Image,
+		Thumbnail:    product.Thumbnail,
+		TrackLots:    product.TrackLots,
+		TrackSerials: product.TrackSerials,
+		Options:      options,
+		OptionValues: product.OptionValues,
+		Variants:     variants,
+		IsBundle:     product.IsBundle,
+		Components:   components,
+		Category:     newCategoryResponse(product.Category),
+		CreatedAt:    product.CreatedAt,
+		UpdatedAt:    product.UpdatedAt,
+	}
+}
+
+// barcodeResponse represents a barcode response body
+type barcodeResponse struct {
+	ID        uint64             `json:"id" example:"1"`
+	ProductID uint64             `json:"product_id" example:"1"`
+	Code      string             `json:"code" example:"8991234567891"`
+	Type      domain.BarcodeType `json:"type" example:"standard"`
+	CreatedAt time.Time          `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt time.Time          `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newBarcodeResponse is a helper function to create a response body for handling barcode data
+func newBarcodeResponse(barcode *domain.Barcode) barcodeResponse {
+	return barcodeResponse{
+		ID:        barcode.ID,
+		ProductID: barcode.ProductID,
+		Code:      barcode.Code,
+		Type:      barcode.Type,
+		CreatedAt: barcode.CreatedAt,
+		UpdatedAt: barcode.UpdatedAt,
+	}
+}
+
+// barcodeScanResponse represents a scanned barcode response body
+type barcodeScanResponse struct {
+	Code     string             `json:"code" example:"2100123012343"`
+	Type     domain.BarcodeType `json:"type" example:"weight"`
+	Quantity float64            `json:"qty" example:"1.234"`
+	Price    float64            `json:"price" example:"61700"`
+	Product  productResponse    `json:"product"`
+}
+
+// newBarcodeScanResponse is a helper function to create a response body for handling scanned barcode data
+func newBarcodeScanResponse(scan *domain.BarcodeScan) barcodeScanResponse {
+	return barcodeScanResponse{
+		Code:     scan.Code,
+		Type:     scan.Type,
+		Quantity: scan.Quantity,
+		Price:    scan.Price,
+		Product:  newProductResponse(scan.Product),
+	}
+}
+
+// lotResponse represents a lot response body
+type lotResponse struct {
+	ID          uint64    `json:"id" example:"1"`
+	ProductID   uint64    `json:"product_id" example:"1"`
+	ProductName string    `json:"product_name" example:"Chiki Ball"`
+	LotNumber   string    `json:"lot_number" example:"LOT-2024-001"`
+	ExpiresAt   time.Time `json:"expires_at" example:"2024-12-31T00:00:00Z"`
+	Quantity    float64   `json:"qty" example:"24"`
+	Expired     bool      `json:"expired" example:"false"`
+	CreatedAt   time.Time `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt   time.Time `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newLotResponse is a helper function to create a response body for handling lot data
+func newLotResponse(lot *domain.Lot) lotResponse {
+	rsp := lotResponse{
+		ID:        lot.ID,
+		ProductID: lot.ProductID,
+		LotNumber: lot.LotNumber,
+		ExpiresAt: lot.ExpiresAt,
+		Quantity:  lot.Quantity,
+		Expired:   lot.IsExpired(time.Now()),
+		CreatedAt: lot.CreatedAt,
+		UpdatedAt: lot.UpdatedAt,
+	}
+
+	if lot.Product != nil {
+		rsp.ProductName = lot.Product.Name
+	}
+
+	return rsp
+}
+
+// priceChangeResponse represents a price change response body
+type priceChangeResponse struct {
+	ID        uint64    `json:"id" example:"1"`
+	ProductID uint64    `json:"product_id" example:"1"`
+	UserID    uint64    `json:"user_id" example:"1"`
+	OldPrice  float64   `json:"old_price" example:"5000"`
+	NewPrice  float64   `json:"new_price" example:"5500"`
+	CreatedAt time.Time `json:"created_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newPriceChangeResponse is a helper function to create a response body for handling price change data
+func newPriceChangeResponse(priceChange *domain.PriceChange) priceChangeResponse {
+	return priceChangeResponse{
+		ID:        priceChange.ID,
+		ProductID: priceChange.ProductID,
+		UserID:    priceChange.UserID,
+		OldPrice:  priceChange.OldPrice,
+		NewPrice:  priceChange.NewPrice,
+		CreatedAt: priceChange.CreatedAt,
+	}
+}
+
+// scheduledPriceResponse represents a scheduled price response body
+type scheduledPriceResponse struct {
+	ID          uint64     `json:"id" example:"1"`
+	ProductID   uint64     `json:"product_id" example:"1"`
+	UserID      uint64     `json:"user_id" example:"1"`
+	Price       float64    `json:"price" example:"5500"`
+	EffectiveAt time.Time  `json:"effective_at" example:"2024-12-31T00:00:00Z"`
+	AppliedAt   *time.Time `json:"applied_at" example:"2024-12-31T00:00:30Z"`
+	CreatedAt   time.Time  `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt   time.Time  `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newScheduledPriceResponse is a helper function to create a response body for handling scheduled price data
+func newScheduledPriceResponse(scheduledPrice *domain.ScheduledPrice) scheduledPriceResponse {
+	return scheduledPriceResponse{
+		ID:          scheduledPrice.ID,
+		ProductID:   scheduledPrice.ProductID,
+		UserID:      scheduledPrice.UserID,
+		Price:       scheduledPrice.Price,
+		EffectiveAt: scheduledPrice.EffectiveAt,
+		AppliedAt:   scheduledPrice.AppliedAt,
+		CreatedAt:   scheduledPrice.CreatedAt,
+		UpdatedAt:   scheduledPrice.UpdatedAt,
+	}
+}
+
+// priceListItemResponse represents a price list item response body
+type priceListItemResponse struct {
+	ProductID uint64  `json:"product_id" example:"1"`
+	Price     float64 `json:"price" example:"4000"`
+}
+
+// priceListResponse represents a price list response body
+type priceListResponse struct {
+	ID            uint64                  `json:"id" example:"1"`
+	Name          string                  `json:"name" example:"Happy Hour"`
+	CustomerGroup domain.CustomerGroup    `json:"customer_group,omitempty" example:"retail"`
+	Priority      int                     `json:"priority" example:"10"`
+	StartsAt      *time.Time              `json:"starts_at" example:"2024-01-01T00:00:00Z"`
+	EndsAt        *time.Time              `json:"ends_at" example:"2024-12-31T00:00:00Z"`
+	StartTime     string                  `json:"start_time,omitempty" example:"16:00"`
+	EndTime       string                  `json:"end_time,omitempty" example:"18:00"`
+	Items         []priceListItemResponse `json:"items,omitempty"`
+	CreatedAt     time.Time               `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt     time.Time               `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newPriceListResponse is a helper function to create a response body for handling price list data
+func newPriceListResponse(priceList *domain.PriceList) priceListResponse {
+	var items []priceListItemResponse
+	for _, item := range priceList.Items {
+		items = append(items, priceListItemResponse{
+			ProductID: item.ProductID,
+			Price:     item.Price,
+		})
+	}
+
+	return priceListResponse{
+		ID:            priceList.ID,
+		Name:          priceList.Name,
+		CustomerGroup: priceList.CustomerGroup,
+		Priority:      priceList.Priority,
+		StartsAt:      priceList.StartsAt,
+		EndsAt:        priceList.EndsAt,
+		StartTime:     priceList.StartTime,
+		EndTime:       priceList.EndTime,
+		Items:         items,
+		CreatedAt:     priceList.CreatedAt,
+		UpdatedAt:     priceList.UpdatedAt,
+	}
+}
+
+// productPriceResponse represents a product price response body
+type productPriceResponse struct {
+	ProductID     uint64               `json:"product_id" example:"1"`
+	CustomerGroup domain.CustomerGroup `json:"customer_group" example:"wholesale"`
+	Price         float64              `json:"price" example:"4000"`
+	PriceList     *priceListResponse   `json:"price_list,omitempty"`
+}
+
+// newProductPriceResponse is a helper function to create a response body for handling product price data
+func newProductPriceResponse(productPrice *domain.ProductPrice) productPriceResponse {
+	rsp := productPriceResponse{
+		ProductID:     productPrice.ProductID,
+		CustomerGroup: productPrice.CustomerGroup,
+		Price:         productPrice.Price,
+	}
+
+	if productPrice.PriceList != nil {
+		priceList := newPriceListResponse(productPrice.PriceList)
+		rsp.PriceList = &priceList
 	}
+
+	return rsp
+}
+
+// serialResponse represents a serial response body
+type serialResponse struct {
+	ID           uint64              `json:"id" example:"1"`
+	ProductID    uint64              `json:"product_id" example:"1"`
+	ProductName  string              `json:"product_name" example:"Smartphone X"`
+	SerialNumber string              `json:"serial_number" example:"SN-0001"`
+	Status       domain.SerialStatus `json:"status" example:"sold"`
+	OrderID      uint64              `json:"order_id,omitempty" example:"1"`
+	ReceiptCode  string              `json:"receipt_id,omitempty" example:"4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"`
+	CreatedAt    time.Time           `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt    time.Time           `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newSerialResponse is a helper function to create a response body for handling serial data
+func newSerialResponse(serial *domain.Serial) serialResponse {
+	rsp := serialResponse{
+		ID:           serial.ID,
+		ProductID:    serial.ProductID,
+		SerialNumber: serial.SerialNumber,
+		Status:       serial.Status,
+		OrderID:      serial.OrderID,
+		CreatedAt:    serial.CreatedAt,
+		UpdatedAt:    serial.UpdatedAt,
+	}
+
+	if serial.Product != nil {
+		rsp.ProductName = serial.Product.Name
+	}
+
+	if serial.Order != nil {
+		rsp.ReceiptCode = serial.Order.ReceiptCode.String()
+	}
+
+	return rsp
+}
+
+// locationResponse represents a location response body
+type locationResponse struct {
+	ID        uint64    `json:"id" example:"1"`
+	Name      string    `json:"name" example:"Warehouse"`
+	IsDefault bool      `json:"is_default" example:"false"`
+	CreatedAt time.Time `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newLocationResponse is a helper function to create a response body for handling location data
+func newLocationResponse(location *domain.Location) locationResponse {
+	return locationResponse{
+		ID:        location.ID,
+		Name:      location.Name,
+		IsDefault: location.IsDefault,
+		CreatedAt: location.CreatedAt,
+		UpdatedAt: location.UpdatedAt,
+	}
+}
+
+// locationStockResponse represents a location stock response body
+type locationStockResponse struct {
+	LocationID  uint64  `json:"location_id" example:"2"`
+	ProductID   uint64  `json:"product_id" example:"1"`
+	ProductName string  `json:"product_name" example:"Chiki Ball"`
+	Quantity    float64 `json:"qty" example:"24"`
+}
+
+// newLocationStockResponse is a helper function to create a response body for handling location stock data
+func newLocationStockResponse(stock *domain.LocationStock) locationStockResponse {
+	rsp := locationStockResponse{
+		LocationID: stock.LocationID,
+		ProductID:  stock.ProductID,
+		Quantity:   stock.Quantity,
+	}
+
+	if stock.Product != nil {
+		rsp.ProductName = stock.Product.Name
+	}
+
+	return rsp
+}
+
+// transferResponse represents a transfer response body
+type transferResponse struct {
+	ID               uint64                  `json:"id" example:"1"`
+	FromLocationID   uint64                  `json:"from_location_id" example:"1"`
+	FromLocationName string                  `json:"from_location_name" example:"Store"`
+	ToLocationID     uint64                  `json:"to_location_id" example:"2"`
+	ToLocationName   string                  `json:"to_location_name" example:"Warehouse"`
+	UserID           uint64                  `json:"user_id,omitempty" example:"1"`
+	Status           domain.TransferStatus   `json:"status" example:"in_transit"`
+	Note             string                  `json:"note" example:"Weekly restock"`
+	Items            []transferItemResponse  `json:"items,omitempty"`
+	Movements        []stockMovementResponse `json:"movements,omitempty"`
+	DispatchedAt     *time.Time              `json:"dispatched_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	ReceivedAt       *time.Time              `json:"received_at,omitempty" example:"1970-01-01T00:00:00Z"`
+	CreatedAt        time.Time               `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt        time.Time               `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// transferItemResponse represents a transfer item response body
+type transferItemResponse struct {
+	ID               uint64   `json:"id" example:"1"`
+	ProductID        uint64   `json:"product_id" example:"1"`
+	ProductName      string   `json:"product_name" example:"Chiki Ball"`
+	Quantity         float64  `json:"qty" example:"24"`
+	ReceivedQuantity *float64 `json:"received_qty,omitempty" example:"23"`
+	Discrepancy      float64  `json:"discrepancy" example:"1"`
+	UnitCost         float64  `json:"unit_cost" example:"3500"`
+}
+
+// stockMovementResponse represents a stock movement response body
+type stockMovementResponse struct {
+	ID         uint64                   `json:"id" example:"1"`
+	LocationID uint64                   `json:"location_id" example:"1"`
+	ProductID  uint64                   `json:"product_id" example:"1"`
+	Type       domain.StockMovementType `json:"type" example:"transfer_out"`
+	Quantity   float64                  `json:"qty" example:"-24"`
+	CreatedAt  time.Time                `json:"created_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// newTransferResponse is a helper function to create a response body for handling transfer data
+func newTransferResponse(transfer *domain.Transfer) transferResponse {
+	rsp := transferResponse{
+		ID:             transfer.ID,
+		FromLocationID: transfer.FromLocationID,
+		ToLocationID:   transfer.ToLocationID,
+		UserID:         transfer.UserID,
+		Status:         transfer.Status,
+		Note:           transfer.Note,
+		DispatchedAt:   transfer.DispatchedAt,
+		ReceivedAt:     transfer.ReceivedAt,
+		CreatedAt:      transfer.CreatedAt,
+		UpdatedAt:      transfer.UpdatedAt,
+	}
+
+	if transfer.FromLocation != nil {
+		rsp.FromLocationName = transfer.FromLocation.Name
+	}
+
+	if transfer.ToLocation != nil {
+		rsp.ToLocationName = transfer.ToLocation.Name
+	}
+
+	for _, item := range transfer.Items {
+		itemRsp := transferItemResponse{
+			ID:               item.ID,
+			ProductID:        item.ProductID,
+			Quantity:         item.Quantity,
+			ReceivedQuantity: item.ReceivedQuantity,
+			Discrepancy:      item.Discrepancy(),
+			UnitCost:         item.UnitCost,
+		}
+
+		if item.Product != nil {
+			itemRsp.ProductName = item.Product.Name
+		}
+
+		rsp.Items = append(rsp.Items, itemRsp)
+	}
+
+	for _, movement := range transfer.Movements {
+		rsp.Movements = append(rsp.Movements, stockMovementResponse{
+			ID:         movement.ID,
+			LocationID: movement.LocationID,
+			ProductID:  movement.ProductID,
+			Type:       movement.Type,
+			Quantity:   movement.Quantity,
+			CreatedAt:  movement.CreatedAt,
+		})
+	}
+
+	return rsp
 }
 
 // orderResponse represents an order response body
 type orderResponse struct {
-	ID           uint64                 `json:"id" example:"1"`
-	UserID       uint64                 `json:"user_id" example:"1"`
-	PaymentID    uint64                 `json:"payment_type_id" example:"1"`
-	CustomerName string                 `json:"customer_name" example:"John Doe"`
-	TotalPrice   float64                `json:"total_price" example:"100000"`
-	TotalPaid    float64                `json:"total_paid" example:"100000"`
-	TotalReturn  float64                `json:"total_return" example:"0"`
-	ReceiptCode  string                 `json:"receipt_id" example:"4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"`
-	Products     []orderProductResponse `json:"products"`
-	PaymentType  paymentResponse        `json:"payment_type"`
-	CreatedAt    time.Time              `json:"created_at" example:"1970-01-01T00:00:00Z"`
-	UpdatedAt    time.Time              `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	ID            uint64                 `json:"id" example:"1"`
+	UserID        uint64                 `json:"user_id" example:"1"`
+	PaymentID     uint64                 `json:"payment_type_id" example:"1"`
+	CustomerName  string                 `json:"customer_name" example:"John Doe"`
+	CustomerGroup domain.CustomerGroup   `json:"customer_group" example:"retail"`
+	TotalPrice    float64                `json:"total_price" example:"100000"`
+	TotalPaid     float64                `json:"total_paid" example:"100000"`
+	TotalReturn   float64                `json:"total_return" example:"0"`
+	TotalCost     float64                `json:"total_cost" example:"70000"`
+	GrossMargin   float64                `json:"gross_margin" example:"30000"`
+	ReceiptCode   string                 `json:"receipt_id" example:"4979cf6e-d215-4ff8-9d0d-b3e99bcc7750"`
+	Products      []orderProductResponse `json:"products"`
+	PaymentType   paymentResponse        `json:"payment_type"`
+	CreatedAt     time.Time              `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt     time.Time              `json:"updated_at" example:"1970-01-01T00:00:00Z"`
 }
 
 // newOrderResponse is a helper function to create a response body for handling order data
 func newOrderResponse(order *domain.Order) orderResponse {
+	var totalCost float64
+	for _, orderProduct := range order.Products {
+		totalCost += orderProduct.Cost
+	}
+
 	return orderResponse{
-		ID:           order.ID,
-		UserID:       order.UserID,
-		PaymentID:    order.PaymentID,
-		CustomerName: order.CustomerName,
-		TotalPrice:   order.TotalPrice,
-		TotalPaid:    order.TotalPaid,
-		TotalReturn:  order.TotalReturn,
-		ReceiptCode:  order.ReceiptCode.String(),
-		Products:     newOrderProductResponse(order.Products),
-		PaymentType:  newPaymentResponse(order.Payment),
-		CreatedAt:    order.CreatedAt,
-		UpdatedAt:    order.UpdatedAt,
+		ID:            order.ID,
+		UserID:        order.UserID,
+		PaymentID:     order.PaymentID,
+		CustomerName:  order.CustomerName,
+		CustomerGroup: order.CustomerGroup,
+		TotalPrice:    order.TotalPrice,
+		TotalPaid:     order.TotalPaid,
+		TotalReturn:   order.TotalReturn,
+		TotalCost:     totalCost,
+		GrossMargin:   order.TotalPrice - totalCost,
+		ReceiptCode:   order.ReceiptCode.String(),
+		Products:      newOrderProductResponse(order.Products),
+		PaymentType:   newPaymentResponse(order.Payment),
+		CreatedAt:     order.CreatedAt,
+		UpdatedAt:     order.UpdatedAt,
 	}
 }
 
 // orderProductResponse represents an order product response body
 type orderProductResponse struct {
-	ID               uint64          `json:"id" example:"1"`
-	OrderID          uint64          `json:"order_id" example:"1"`
-	ProductID        uint64          `json:"product_id" example:"1"`
-	Quantity         int64           `json:"qty" example:"1"`
-	Price            float64         `json:"price" example:"100000"`
-	TotalNormalPrice float64         `json:"total_normal_price" example:"100000"`
-	TotalFinalPrice  float64         `json:"total_final_price" example:"100000"`
-	Product          productResponse `json:"product"`
-	CreatedAt        time.Time       `json:"created_at" example:"1970-01-01T00:00:00Z"`
-	UpdatedAt        time.Time       `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+	ID               uint64                  `json:"id" example:"1"`
+	OrderID          uint64                  `json:"order_id" example:"1"`
+	ProductID        uint64                  `json:"product_id" example:"1"`
+	Quantity         float64                 `json:"qty" example:"1"`
+	Unit             string                  `json:"unit" example:"pcs"`
+	BaseQuantity     float64                 `json:"base_qty" example:"1"`
+	Price            float64                 `json:"price" example:"100000"`
+	PriceListID      uint64                  `json:"price_list_id,omitempty" example:"1"`
+	TotalNormalPrice float64                 `json:"total_normal_price" example:"100000"`
+	TotalFinalPrice  float64                 `json:"total_final_price" example:"100000"`
+	Cost             float64                 `json:"cost" example:"70000"`
+	Margin           float64                 `json:"margin" example:"30000"`
+	SerialNumbers    []string                `json:"serial_numbers,omitempty" example:"SN-0001"`
+	Modifiers        []orderModifierResponse `json:"modifiers,omitempty"`
+	Product          productResponse         `json:"product"`
+	CreatedAt        time.Time               `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt        time.Time               `json:"updated_at" example:"1970-01-01T00:00:00Z"`
 }
 
 // newOrderProductResponse is a helper function to create a response body for handling order product data
@@ -192,9 +818,16 @@ func newOrderProductResponse(orderProduct []domain.OrderProduct) []orderProductR
 			OrderID:          orderProduct.OrderID,
 			ProductID:        orderProduct.ProductID,
 			Quantity:         orderProduct.Quantity,
-			Price:            orderProduct.Product.Price,
+			Unit:             orderProduct.Unit,
+			BaseQuantity:     orderProduct.BaseQuantity,
+			Price:            orderProduct.UnitPrice,
+			PriceListID:      orderProduct.PriceListID,
 			TotalNormalPrice: orderProduct.TotalPrice,
 			TotalFinalPrice:  orderProduct.TotalPrice,
+			Cost:             orderProduct.Cost,
+			Margin:           orderProduct.Margin(),
+			SerialNumbers:    orderProduct.SerialNumbers,
+			Modifiers:        newOrderModifierResponse(orderProduct.Modifiers),
 			Product:          newProductResponse(orderProduct.Product),
 			CreatedAt:        orderProduct.CreatedAt,
 			UpdatedAt:        orderProduct.UpdatedAt,
@@ -204,22 +837,291 @@ func newOrderProductResponse(orderProduct []domain.OrderProduct) []orderProductR
 	return orderProductResponses
 }
 
+// orderModifierResponse represents a modifier chosen on an order product response body
+type orderModifierResponse struct {
+	ModifierID uint64  `json:"modifier_id,omitempty" example:"1"`
+	GroupName  string  `json:"group_name" example:"Milk"`
+	Name       string  `json:"name" example:"Oat milk"`
+	Price      float64 `json:"price" example:"5000"`
+}
+
+// newOrderModifierResponse is a helper function to create a response body for handling order modifier data
+func newOrderModifierResponse(modifiers []domain.OrderModifier) []orderModifierResponse {
+	var modifierResponses []orderModifierResponse
+
+	for _, modifier := range modifiers {
+		modifierResponses = append(modifierResponses, orderModifierResponse{
+			ModifierID: modifier.ModifierID,
+			GroupName:  modifier.GroupName,
+			Name:       modifier.Name,
+			Price:      modifier.Price,
+		})
+	}
+
+	return modifierResponses
+}
+
+// modifierGroupResponse represents a modifier group response body
+type modifierGroupResponse struct {
+	ID            uint64             `json:"id" example:"1"`
+	ProductID     uint64             `json:"product_id" example:"1"`
+	Name          string             `json:"name" example:"Milk"`
+	Required      bool               `json:"required" example:"true"`
+	MinSelections uint64             `json:"min_selections" example:"1"`
+	MaxSelections uint64             `json:"max_selections" example:"1"`
+	Modifiers     []modifierResponse `json:"modifiers"`
+	CreatedAt     time.Time          `json:"created_at" example:"1970-01-01T00:00:00Z"`
+	UpdatedAt     time.Time          `json:"updated_at" example:"1970-01-01T00:00:00Z"`
+}
+
+// modifierResponse represents a modifier response body
+type modifierResponse struct {
+	ID                 uint64  `json:"id" example:"1"`
+	Name               string  `json:"name" example:"Oat milk"`
+	Price              float64 `json:"price" example:"5000"`
+	IngredientID       uint64  `json:"ingredient_id,omitempty" example:"2"`
+	IngredientQuantity float64 `json:"ingredient_qty,omitempty" example:"0.2"`
+}
+
+// newModifierGroupResponse is a helper function to create a response body for handling modifier group data
+func newModifierGroupResponse(group *domain.ModifierGroup) modifierGroupResponse {
+	modifiers := []modifierResponse{}
+	for _, modifier := range group.Modifiers {
+		modifiers = append(modifiers, modifierResponse{
+			ID:                 modifier.ID,
+			Name:               modifier.Name,
+			Price:              modifier.Price,
+			IngredientID:       modifier.IngredientID,
+			IngredientQuantity: modifier.IngredientQuantity,
+		})
+	}
+
+	return modifierGroupResponse{
+		ID:            group.ID,
+		ProductID:     group.ProductID,
+		Name:          group.Name,
+		Required:      group.IsRequired(),
+		MinSelections: group.MinSelections,
+		MaxSelections: group.MaxSelections,
+		Modifiers:     modifiers,
+		CreatedAt:     group.CreatedAt,
+		UpdatedAt:     group.UpdatedAt,
+	}
+}
+
+// productMarginResponse represents a product margin response body
+type productMarginResponse struct {
+	ProductID uint64  `json:"product_id" example:"1"`
+	Name      string  `json:"name" example:"Chiki Ball"`
+	Quantity  float64 `json:"qty" example:"120"`
+	Revenue   float64 `json:"revenue" example:"600000"`
+	Cost      float64 `json:"cost" example:"420000"`
+	Margin    float64 `json:"margin" example:"180000"`
+}
+
+// marginReportResponse represents a margin report response body
+type marginReportResponse struct {
+	From     time.Time               `json:"from" example:"2024-01-01T00:00:00Z"`
+	To       time.Time               `json:"to" example:"2024-02-01T00:00:00Z"`
+	Revenue  float64                 `json:"revenue" example:"600000"`
+	Cost     float64                 `json:"cost" example:"420000"`
+	Margin   float64                 `json:"margin" example:"180000"`
+	Products []productMarginResponse `json:"products"`
+}
+
+// newMarginReportResponse is a helper function to create a response body for handling margin report data
+func newMarginReportResponse(report *domain.MarginReport) marginReportResponse {
+	products := []productMarginResponse{}
+
+	for _, margin := range report.Products {
+		products = append(products, productMarginResponse{
+			ProductID: margin.ProductID,
+			Name:      margin.Name,
+			Quantity:  margin.Quantity,
+			Revenue:   margin.Revenue,
+			Cost:      margin.Cost,
+			Margin:    margin.Margin,
+		})
+	}
+
+	return marginReportResponse{
+		From:     report.From,
+		To:       report.To,
+		Revenue:  report.Revenue,
+		Cost:     report.Cost,
+		Margin:   report.Margin,
+		Products: products,
+	}
+}
+
+// stockValuationResponse represents a stock valuation response body
+type stockValuationResponse struct {
+	ProductID   uint64                 `json:"product_id" example:"1"`
+	Name        string                 `json:"name" example:"Chiki Ball"`
+	Stock       float64                `json:"stock" example:"100"`
+	Unit        string                 `json:"unit" example:"pcs"`
+	Method      domain.ValuationMethod `json:"valuation" example:"average"`
+	AverageCost float64                `json:"average_cost" example:"3500"`
+	Value       float64                `json:"value" example:"350000"`
+}
+
+// inventoryValuationResponse represents an inventory valuation response body
+type inventoryValuationResponse struct {
+	Value    float64                  `json:"value" example:"350000"`
+	Products []stockValuationResponse `json:"products"`
+}
+
+// newInventoryValuationResponse is a helper function to create a response body for handling inventory valuation data
+func newInventoryValuationResponse(inventory *domain.InventoryValuation) inventoryValuationResponse {
+	products := []stockValuationResponse{}
+
+	for _, valuation := range inventory.Products {
+		products = append(products, stockValuationResponse{
+			ProductID:   valuation.ProductID,
+			Name:        valuation.Name,
+			Stock:       valuation.Stock,
+			Unit:        valuation.Unit,
+			Method:      valuation.Method,
+			AverageCost: valuation.AverageCost,
+			Value:       valuation.Value,
+		})
+	}
+
+	return inventoryValuationResponse{
+		Value:    inventory.Value,
+		Products: products,
+	}
+}
+
+// catalogRowErrorResponse represents a catalog row error response body
+type catalogRowErrorResponse struct {
+	Row     int    `json:"row" example:"2"`
+	Column  string `json:"column" example:"category"`
+	Message string `json:"message" example:"Beverages does not exist"`
+}
+
+// catalogImportResponse represents a catalog import response body
+type catalogImportResponse struct {
+	DryRun   bool                      `json:"dry_run" example:"false"`
+	Imported bool                      `json:"imported" example:"true"`
+	Created  int                       `json:"created" example:"120"`
+	Updated  int                       `json:"updated" example:"35"`
+	Errors   []catalogRowErrorResponse `json:"errors"`
+}
+
+// newCatalogImportResponse is a helper function to create a response body for handling catalog import data
+func newCatalogImportResponse(result *domain.CatalogImport) catalogImportResponse {
+	rowErrors := []catalogRowErrorResponse{}
+	for _, rowError := range result.Errors {
+		rowErrors = append(rowErrors, catalogRowErrorResponse{
+			Row:     rowError.Row,
+			Column:  rowError.Column,
+			Message: rowError.Message,
+		})
+	}
+
+	return catalogImportResponse{
+		DryRun:   result.DryRun,
+		Imported: result.IsImported(),
+		Created:  result.Created,
+		Updated:  result.Updated,
+		Errors:   rowErrors,
+	}
+}
+
 // errorStatusMap is a map of defined error messages and their corresponding http status codes
 var errorStatusMap = map[error]int{
 	domain.ErrInternal:                   http.StatusInternalServerError,
 	domain.ErrDataNotFound:               http.StatusNotFound,
 	domain.ErrConflictingData:            http.StatusConflict,
+	domain.ErrInvalidResetToken:          http.StatusUnauthorized,
+	domain.ErrInvalidTwoFactorChallenge:  http.StatusUnauthorized,
+	domain.ErrInvalidTwoFactorCode:       http.StatusUnauthorized,
+	domain.ErrTwoFactorEnabled:           http.StatusConflict,
+	domain.ErrTwoFactorRequired:          http.StatusForbidden,
+	domain.ErrIncorrectPassword:          http.StatusForbidden,
 	domain.ErrInvalidCredentials:         http.StatusUnauthorized,
+	domain.ErrTooManyLoginAttempts:       http.StatusTooManyRequests,
 	domain.ErrUnauthorized:               http.StatusUnauthorized,
 	domain.ErrEmptyAuthorizationHeader:   http.StatusUnauthorized,
 	domain.ErrInvalidAuthorizationHeader: http.StatusUnauthorized,
 	domain.ErrInvalidAuthorizationType:   http.StatusUnauthorized,
 	domain.ErrInvalidToken:               http.StatusUnauthorized,
 	domain.ErrExpiredToken:               http.StatusUnauthorized,
+	domain.ErrRevokedToken:               http.StatusUnauthorized,
+	domain.ErrInvalidPIN:                 http.StatusUnauthorized,
+	domain.ErrInvalidTerminal:            http.StatusUnauthorized,
+	domain.ErrPINNotAllowed:              http.StatusForbidden,
+	domain.ErrBuiltInRole:                http.StatusForbidden,
+	domain.ErrInvalidRefreshToken:        http.StatusUnauthorized,
+	domain.ErrRefreshTokenReused:         http.StatusUnauthorized,
 	domain.ErrForbidden:                  http.StatusForbidden,
 	domain.ErrNoUpdatedData:              http.StatusBadRequest,
 	domain.ErrInsufficientStock:          http.StatusBadRequest,
 	domain.ErrInsufficientPayment:        http.StatusBadRequest,
+	domain.ErrTransferNotAllowed:         http.StatusBadRequest,
+	domain.ErrInvalidTransfer:            http.StatusBadRequest,
+	domain.ErrTransferStatus:             http.StatusConflict,
+	domain.ErrInvalidTransferReceipt:     http.StatusBadRequest,
+	domain.ErrExpiredStock:               http.StatusBadRequest,
+	domain.ErrLotNotTracked:              http.StatusBadRequest,
+	domain.ErrSerialNotTracked:           http.StatusBadRequest,
+	domain.ErrSerialNumberMismatch:       http.StatusBadRequest,
+	domain.ErrSerialNotInStock:           http.StatusBadRequest,
+	domain.ErrInvalidVariant:             http.StatusBadRequest,
+	domain.ErrVariantRequired:            http.StatusBadRequest,
+	domain.ErrInvalidBundle:              http.StatusBadRequest,
+	domain.ErrInvalidUnit:                http.StatusBadRequest,
+	domain.ErrFractionalQuantity:         http.StatusBadRequest,
+	domain.ErrReceiptNotAllowed:          http.StatusBadRequest,
+	domain.ErrInvalidBarcode:             http.StatusBadRequest,
+	domain.ErrBarcodeRequired:            http.StatusBadRequest,
+	domain.ErrInvalidSchedule:            http.StatusBadRequest,
+	domain.ErrScheduleApplied:            http.StatusConflict,
+	domain.ErrInvalidPriceList:           http.StatusBadRequest,
+	domain.ErrInvalidCatalog:             http.StatusBadRequest,
+	domain.ErrInvalidImage:               http.StatusBadRequest,
+	domain.ErrCategoryCycle:              http.StatusBadRequest,
+	domain.ErrInvalidModifierGroup:       http.StatusBadRequest,
+	domain.ErrInvalidIngredient:          http.StatusBadRequest,
+	domain.ErrInvalidModifiers:           http.StatusBadRequest,
+}
+
+// labelContentTypes is a map of label formats and their corresponding content types
+var labelContentTypes = map[domain.LabelFormat]string{
+	domain.LabelSVG: "image/svg+xml",
+	domain.LabelPNG: "image/png",
+	domain.LabelPDF: "application/pdf",
+}
+
+// handleLabel sends a rendered label as an inline file response
+func handleLabel(ctx *gin.Context, name string, label *domain.Label) {
+	ctx.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", name+"."+string(label.Format)))
+	ctx.Data(http.StatusOK, labelContentTypes[label.Format], label.Content)
+}
+
+// handleTicket sends a rendered ticket as an inline plain text file response
+func handleTicket(ctx *gin.Context, name string, ticket *domain.Ticket) {
+	ctx.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", name+".txt"))
+	ctx.Data(http.StatusOK, "text/plain; charset=utf-8", ticket.Content)
+}
+
+// catalogContentTypes is a map of catalog formats and their corresponding content types
+var catalogContentTypes = map[domain.CatalogFormat]string{
+	domain.CatalogCSV:  "text/csv",
+	domain.CatalogXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// handleCatalog sends an exported catalog as a downloaded file response
+func handleCatalog(ctx *gin.Context, name string, catalog *domain.Catalog) {
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+"."+string(catalog.Format)))
+	ctx.Data(http.StatusOK, catalogContentTypes[catalog.Format], catalog.Content)
+}
+
+// handleFile sends a stored file as a cacheable inline file response
+func handleFile(ctx *gin.Context, file *domain.File) {
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.Data(http.StatusOK, file.ContentType, file.Content)
 }
 
 // validationError sends an error response for some specific request validation error
@@ -0,0 +1,238 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// RoleHandler represents the HTTP handler for role-related requests
+type RoleHandler struct {
+	svc port.RoleService
+}
+
+// NewRoleHandler creates a new RoleHandler instance
+func NewRoleHandler(svc port.RoleService) *RoleHandler {
+	return &RoleHandler{
+		svc,
+	}
+}
+
+// createRoleRequest represents a request body for creating a role
+type createRoleRequest struct {
+	Name        domain.UserRole     `json:"name" binding:"required,max=50" example:"supervisor"`
+	Permissions []domain.Permission `json:"permissions" binding:"required,dive,permission" example:"products.edit_price,reports.view"`
+}
+
+// CreateRole godoc
+//
+//	@Summary		Create a new role
+//	@Description	create a new role with a name and the permissions of the users given it
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			createRoleRequest	body		createRoleRequest	true	"Create role request"
+//	@Success		200					{object}	roleResponse		"Role created"
+//	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		401					{object}	errorResponse		"Unauthorized error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
+//	@Failure		409					{object}	errorResponse		"Data conflict error"
+//	@Failure		500					{object}	errorResponse		"Internal server error"
+//	@Router			/roles [post]
+//	@Security		BearerAuth
+func (rh *RoleHandler) CreateRole(ctx *gin.Context) {
+	var req createRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	role := domain.Role{
+		Name:        req.Name,
+		Permissions: req.Permissions,
+	}
+
+	_, err := rh.svc.CreateRole(ctx, &role)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRoleResponse(&role)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListRoles godoc
+//
+//	@Summary		List roles
+//	@Description	List all roles with their permissions
+//	@Tags			Roles
+//	@Produce		json
+//	@Success		200	{array}		roleResponse	"Roles displayed"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/roles [get]
+//	@Security		BearerAuth
+func (rh *RoleHandler) ListRoles(ctx *gin.Context) {
+	roles, err := rh.svc.ListRoles(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	list := make([]roleResponse, 0, len(roles))
+	for _, role := range roles {
+		list = append(list, newRoleResponse(&role))
+	}
+
+	handleSuccess(ctx, list)
+}
+
+// ListPermissions godoc
+//
+//	@Summary		List permissions
+//	@Description	List every permission a role can be given
+//	@Tags			Roles
+//	@Produce		json
+//	@Success		200	{array}		string			"Permissions displayed"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Router			/roles/permissions [get]
+//	@Security		BearerAuth
+func (rh *RoleHandler) ListPermissions(ctx *gin.Context) {
+	handleSuccess(ctx, domain.Permissions)
+}
+
+// getRoleRequest represents a request body for retrieving a role
+type getRoleRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// GetRole godoc
+//
+//	@Summary		Get a role
+//	@Description	get a role by id
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Role ID"
+//	@Success		200	{object}	roleResponse	"Role retrieved"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/roles/{id} [get]
+//	@Security		BearerAuth
+func (rh *RoleHandler) GetRole(ctx *gin.Context) {
+	var req getRoleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	role, err := rh.svc.GetRole(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRoleResponse(role)
+
+	handleSuccess(ctx, rsp)
+}
+
+// updateRoleRequest represents a request body for updating a role
+type updateRoleRequest struct {
+	Name        domain.UserRole     `json:"name" binding:"omitempty,required,max=50" example:"supervisor"`
+	Permissions []domain.Permission `json:"permissions" binding:"omitempty,dive,permission" example:"products.edit_price,reports.view"`
+}
+
+// UpdateRole godoc
+//
+//	@Summary		Update a role
+//	@Description	update the name or permissions of a role by id. Built-in roles cannot be renamed and the admin role cannot be changed. Access tokens issued with the previous permissions are revoked and have to be refreshed.
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			id					path		uint64				true	"Role ID"
+//	@Param			updateRoleRequest	body		updateRoleRequest	true	"Update role request"
+//	@Success		200					{object}	roleResponse		"Role updated"
+//	@Failure		400					{object}	errorResponse		"Validation error"
+//	@Failure		401					{object}	errorResponse		"Unauthorized error"
+//	@Failure		403					{object}	errorResponse		"Forbidden error"
+//	@Failure		404					{object}	errorResponse		"Data not found error"
+//	@Failure		409					{object}	errorResponse		"Data conflict error"
+//	@Failure		500					{object}	errorResponse		"Internal server error"
+//	@Router			/roles/{id} [put]
+//	@Security		BearerAuth
+func (rh *RoleHandler) UpdateRole(ctx *gin.Context) {
+	var req updateRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	id, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	role := domain.Role{
+		ID:          id,
+		Name:        req.Name,
+		Permissions: req.Permissions,
+	}
+
+	_, err = rh.svc.UpdateRole(ctx, &role)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRoleResponse(&role)
+
+	handleSuccess(ctx, rsp)
+}
+
+// deleteRoleRequest represents a request body for deleting a role
+type deleteRoleRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// DeleteRole godoc
+//
+//	@Summary		Delete a role
+//	@Description	Delete a role by id. Built-in roles and roles given to users cannot be deleted.
+//	@Tags			Roles
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Role ID"
+//	@Success		200	{object}	response		"Role deleted"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		409	{object}	errorResponse	"Data conflict error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/roles/{id} [delete]
+//	@Security		BearerAuth
+func (rh *RoleHandler) DeleteRole(ctx *gin.Context) {
+	var req deleteRoleRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := rh.svc.DeleteRole(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
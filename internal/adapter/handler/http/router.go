@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -24,12 +25,28 @@ type Router struct {
 func NewRouter(
 	config *config.HTTP,
 	token port.TokenService,
+	cache port.CacheRepository,
 	userHandler UserHandler,
 	authHandler AuthHandler,
 	paymentHandler PaymentHandler,
 	categoryHandler CategoryHandler,
 	productHandler ProductHandler,
 	orderHandler OrderHandler,
+	locationHandler LocationHandler,
+	transferHandler TransferHandler,
+	lotHandler LotHandler,
+	serialHandler SerialHandler,
+	barcodeHandler BarcodeHandler,
+	labelHandler LabelHandler,
+	reportHandler ReportHandler,
+	priceHandler PriceHandler,
+	priceListHandler PriceListHandler,
+	catalogHandler CatalogHandler,
+	imageHandler ImageHandler,
+	modifierHandler ModifierHandler,
+	terminalHandler TerminalHandler,
+	roleHandler RoleHandler,
+	passwordHandler PasswordHandler,
 ) (*Router, error) {
 	// Disable debug mode in production
 	if config.Env == "production" {
@@ -43,12 +60,23 @@ func NewRouter(
 	ginConfig.AllowOrigins = originsList
 
 	router := gin.New()
+
+	// Client IP addresses are only read from the forwarding headers set by trusted proxies, none by default
+	var trustedProxies []string
+	if config.TrustedProxies != "" {
+		trustedProxies = strings.Split(config.TrustedProxies, ",")
+	}
+
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, err
+	}
+
 	router.Use(sloggin.New(slog.Default()), gin.Recovery(), cors.New(ginConfig))
 
 	// Custom validators
 	v, ok := binding.Validator.Engine().(*validator.Validate)
 	if ok {
-		if err := v.RegisterValidation("user_role", userRoleValidator); err != nil {
+		if err := v.RegisterValidation("permission", permissionValidator); err != nil {
 			return nil, err
 		}
 
@@ -56,6 +84,38 @@ func NewRouter(
 			return nil, err
 		}
 
+		if err := v.RegisterValidation("transfer_status", transferStatusValidator); err != nil {
+			return nil, err
+		}
+
+		if err := v.RegisterValidation("serial_status", serialStatusValidator); err != nil {
+			return nil, err
+		}
+
+		if err := v.RegisterValidation("barcode_type", barcodeTypeValidator); err != nil {
+			return nil, err
+		}
+
+		if err := v.RegisterValidation("barcode_symbology", barcodeSymbologyValidator); err != nil {
+			return nil, err
+		}
+
+		if err := v.RegisterValidation("barcode_format", barcodeFormatValidator); err != nil {
+			return nil, err
+		}
+
+		if err := v.RegisterValidation("valuation_method", valuationMethodValidator); err != nil {
+			return nil, err
+		}
+
+		if err := v.RegisterValidation("customer_group", customerGroupValidator); err != nil {
+			return nil, err
+		}
+
+		if err := v.RegisterValidation("catalog_format", catalogFormatValidator); err != nil {
+			return nil, err
+		}
+
 	}
 
 	// Swagger
@@ -63,64 +123,154 @@ func NewRouter(
 
 	v1 := router.Group("/v1")
 	{
+		v1.GET("/.well-known/paseto-keys", authHandler.ListPublicKeys)
 		user := v1.Group("/users")
 		{
 			user.POST("/", userHandler.Register)
 			user.POST("/login", authHandler.Login)
+			user.POST("/login/2fa", authHandler.CompleteTwoFactor)
+			user.POST("/refresh", authHandler.Refresh)
+			user.POST("/pin-login", authHandler.LoginWithPIN)
+			user.POST("/password-reset", passwordHandler.RequestPasswordReset)
+			user.POST("/password-reset/confirm", passwordHandler.ResetPassword)
 
-			authUser := user.Group("/").Use(authMiddleware(token))
+			authUser := user.Group("/").Use(authMiddleware(token, cache))
 			{
+				authUser.POST("/logout", authHandler.Logout)
+				authUser.POST("/logout-all", authHandler.LogoutAll)
+				authUser.PUT("/pin", userHandler.SetPIN)
+				authUser.PUT("/password", passwordHandler.ChangePassword)
+				authUser.POST("/2fa", authHandler.EnrollTwoFactor)
+				authUser.POST("/2fa/confirm", authHandler.ConfirmTwoFactor)
+				authUser.POST("/2fa/disable", authHandler.DisableTwoFactor)
+				authUser.POST("/2fa/recovery-codes", authHandler.RegenerateRecoveryCodes)
 				authUser.GET("/", userHandler.ListUsers)
 				authUser.GET("/:id", userHandler.GetUser)
-
-				admin := authUser.Use(adminMiddleware())
-				{
-					admin.PUT("/:id", userHandler.UpdateUser)
-					admin.DELETE("/:id", userHandler.DeleteUser)
-				}
+				authUser.PUT("/:id", permissionMiddleware(domain.UsersManage), userHandler.UpdateUser)
+				authUser.DELETE("/:id", permissionMiddleware(domain.UsersManage), userHandler.DeleteUser)
+				authUser.POST("/:id/unlock", permissionMiddleware(domain.UsersManage), authHandler.UnlockLogin)
+				authUser.DELETE("/:id/2fa", permissionMiddleware(domain.UsersManage), authHandler.ResetTwoFactor)
 			}
 		}
-		payment := v1.Group("/payments").Use(authMiddleware(token))
+		role := v1.Group("/roles").Use(authMiddleware(token, cache), permissionMiddleware(domain.RolesManage))
+		{
+			role.POST("/", roleHandler.CreateRole)
+			role.GET("/", roleHandler.ListRoles)
+			role.GET("/permissions", roleHandler.ListPermissions)
+			role.GET("/:id", roleHandler.GetRole)
+			role.PUT("/:id", roleHandler.UpdateRole)
+			role.DELETE("/:id", roleHandler.DeleteRole)
+		}
+		terminal := v1.Group("/terminals").Use(authMiddleware(token, cache), permissionMiddleware(domain.TerminalsManage))
+		{
+			terminal.POST("/", terminalHandler.RegisterTerminal)
+			terminal.GET("/", terminalHandler.ListTerminals)
+			terminal.DELETE("/:id", terminalHandler.DeleteTerminal)
+		}
+		v1.GET("/images/*key", imageHandler.GetImage)
+		payment := v1.Group("/payments").Use(authMiddleware(token, cache))
 		{
 			payment.GET("/", paymentHandler.ListPayments)
 			payment.GET("/:id", paymentHandler.GetPayment)
-
-			admin := payment.Use(adminMiddleware())
-			{
-				admin.POST("/", paymentHandler.CreatePayment)
-				admin.PUT("/:id", paymentHandler.UpdatePayment)
-				admin.DELETE("/:id", paymentHandler.DeletePayment)
-			}
+			payment.POST("/", permissionMiddleware(domain.PaymentsManage), paymentHandler.CreatePayment)
+			payment.PUT("/:id", permissionMiddleware(domain.PaymentsManage), paymentHandler.UpdatePayment)
+			payment.POST("/:id/logo", permissionMiddleware(domain.PaymentsManage), imageHandler.UploadPaymentLogo)
+			payment.DELETE("/:id", permissionMiddleware(domain.PaymentsManage), paymentHandler.DeletePayment)
 		}
-		category := v1.Group("/categories").Use(authMiddleware(token))
+		category := v1.Group("/categories").Use(authMiddleware(token, cache))
 		{
 			category.GET("/", categoryHandler.ListCategories)
+			category.GET("/tree", categoryHandler.GetCategoryTree)
 			category.GET("/:id", categoryHandler.GetCategory)
-
-			admin := category.Use(adminMiddleware())
-			{
-				admin.POST("/", categoryHandler.CreateCategory)
-				admin.PUT("/:id", categoryHandler.UpdateCategory)
-				admin.DELETE("/:id", categoryHandler.DeleteCategory)
-			}
+			category.POST("/", permissionMiddleware(domain.CategoriesManage), categoryHandler.CreateCategory)
+			category.PUT("/:id", permissionMiddleware(domain.CategoriesManage), categoryHandler.UpdateCategory)
+			category.PUT("/:id/parent", permissionMiddleware(domain.CategoriesManage), categoryHandler.MoveCategory)
+			category.DELETE("/:id", permissionMiddleware(domain.CategoriesManage), categoryHandler.DeleteCategory)
 		}
-		product := v1.Group("/products").Use(authMiddleware(token))
+		product := v1.Group("/products").Use(authMiddleware(token, cache))
 		{
 			product.GET("/", productHandler.ListProducts)
+			product.GET("/barcode/:code", barcodeHandler.ScanBarcode)
 			product.GET("/:id", productHandler.GetProduct)
-
-			admin := product.Use(adminMiddleware())
-			{
-				admin.POST("/", productHandler.CreateProduct)
-				admin.PUT("/:id", productHandler.UpdateProduct)
-				admin.DELETE("/:id", productHandler.DeleteProduct)
-			}
+			product.GET("/:id/barcode", labelHandler.GetBarcodeLabel)
+			product.GET("/:id/modifier-groups", modifierHandler.ListModifierGroups)
+			product.POST("/", permissionMiddleware(domain.ProductsManage), productHandler.CreateProduct)
+			product.POST("/import", permissionMiddleware(domain.CatalogManage), catalogHandler.ImportCatalog)
+			product.POST("/:id/barcode", permissionMiddleware(domain.ProductsManage), barcodeHandler.GenerateBarcode)
+			product.GET("/export", permissionMiddleware(domain.CatalogManage), catalogHandler.ExportCatalog)
+			product.PUT("/:id", permissionMiddleware(domain.ProductsManage), productHandler.UpdateProduct)
+			product.POST("/:id/receive", permissionMiddleware(domain.InventoryManage), productHandler.ReceiveProduct)
+			product.POST("/:id/image", permissionMiddleware(domain.ProductsManage), imageHandler.UploadProductImage)
+			product.POST("/:id/modifier-groups", permissionMiddleware(domain.ProductsManage), modifierHandler.CreateModifierGroup)
+			product.DELETE("/:id/modifier-groups/:group_id", permissionMiddleware(domain.ProductsManage), modifierHandler.DeleteModifierGroup)
+			product.DELETE("/:id", permissionMiddleware(domain.ProductsManage), productHandler.DeleteProduct)
+		}
+		lot := v1.Group("/lots").Use(authMiddleware(token, cache))
+		{
+			lot.GET("/", lotHandler.ListLots)
+			lot.GET("/expiring", lotHandler.ListExpiringLots)
+			lot.GET("/:id", lotHandler.GetLot)
+			lot.POST("/", permissionMiddleware(domain.InventoryManage), lotHandler.CreateLot)
+		}
+		serial := v1.Group("/serials").Use(authMiddleware(token, cache))
+		{
+			serial.GET("/", serialHandler.ListSerials)
+			serial.GET("/search", serialHandler.SearchSerials)
+			serial.POST("/", permissionMiddleware(domain.InventoryManage), serialHandler.CreateSerials)
+		}
+		barcode := v1.Group("/barcodes").Use(authMiddleware(token, cache))
+		{
+			barcode.GET("/", barcodeHandler.ListBarcodes)
+			barcode.POST("/", permissionMiddleware(domain.ProductsManage), barcodeHandler.CreateBarcode)
+			barcode.DELETE("/:id", permissionMiddleware(domain.ProductsManage), barcodeHandler.DeleteBarcode)
+		}
+		label := v1.Group("/labels").Use(authMiddleware(token, cache))
+		{
+			label.GET("/shelf", labelHandler.GetShelfLabels)
+		}
+		price := v1.Group("/prices").Use(authMiddleware(token, cache))
+		{
+			price.GET("/history", priceHandler.ListPriceHistory)
+			price.GET("/scheduled", priceHandler.ListScheduledPrices)
+			price.POST("/scheduled", permissionMiddleware(domain.ProductsEditPrice), priceHandler.SchedulePrice)
+			price.DELETE("/scheduled/:id", permissionMiddleware(domain.ProductsEditPrice), priceHandler.CancelScheduledPrice)
 		}
-		order := v1.Group("/orders").Use(authMiddleware(token))
+		priceList := v1.Group("/price-lists").Use(authMiddleware(token, cache))
+		{
+			priceList.GET("/", priceListHandler.ListPriceLists)
+			priceList.GET("/price", priceListHandler.GetProductPrice)
+			priceList.GET("/:id", priceListHandler.GetPriceList)
+			priceList.POST("/", permissionMiddleware(domain.PriceListsManage), priceListHandler.CreatePriceList)
+			priceList.PUT("/:id", permissionMiddleware(domain.PriceListsManage), priceListHandler.UpdatePriceList)
+			priceList.DELETE("/:id", permissionMiddleware(domain.PriceListsManage), priceListHandler.DeletePriceList)
+		}
+		report := v1.Group("/reports").Use(authMiddleware(token, cache), permissionMiddleware(domain.ReportsView))
+		{
+			report.GET("/margin", reportHandler.GetMarginReport)
+			report.GET("/valuation", reportHandler.GetInventoryValuation)
+		}
+		order := v1.Group("/orders").Use(authMiddleware(token, cache))
 		{
 			order.POST("/", orderHandler.CreateOrder)
 			order.GET("/", orderHandler.ListOrders)
 			order.GET("/:id", orderHandler.GetOrder)
+			order.GET("/:id/receipt", orderHandler.GetReceipt)
+			order.GET("/:id/kitchen-ticket", orderHandler.GetKitchenTicket)
+		}
+		location := v1.Group("/locations").Use(authMiddleware(token, cache))
+		{
+			location.GET("/", locationHandler.ListLocations)
+			location.GET("/:id/stock", locationHandler.ListLocationStocks)
+			location.POST("/", permissionMiddleware(domain.InventoryManage), locationHandler.CreateLocation)
+		}
+		transfer := v1.Group("/transfers").Use(authMiddleware(token, cache))
+		{
+			transfer.GET("/", transferHandler.ListTransfers)
+			transfer.GET("/:id", transferHandler.GetTransfer)
+			transfer.POST("/", permissionMiddleware(domain.InventoryManage), transferHandler.CreateTransfer)
+			transfer.POST("/:id/dispatch", permissionMiddleware(domain.InventoryManage), transferHandler.DispatchTransfer)
+			transfer.POST("/:id/receive", permissionMiddleware(domain.InventoryManage), transferHandler.ReceiveTransfer)
+			transfer.POST("/:id/cancel", permissionMiddleware(domain.InventoryManage), transferHandler.CancelTransfer)
 		}
 	}
 
@@ -0,0 +1,124 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	handler "github.com/bagashiz/go-pos/internal/adapter/handler/http"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/mock/gomock"
+)
+
+// newTestRouter creates a router with the auth handler of an auth service
+func newTestRouter(t *testing.T, trustedProxies string, token port.TokenService, cache port.CacheRepository, authService port.AuthService) *handler.Router {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	router, err := handler.NewRouter(
+		&config.HTTP{
+			Env:            "test",
+			AllowedOrigins: "http://127.0.0.1:3000",
+			TrustedProxies: trustedProxies,
+		},
+		token,
+		cache,
+		handler.UserHandler{},
+		*handler.NewAuthHandler(authService),
+		handler.PaymentHandler{},
+		handler.CategoryHandler{},
+		handler.ProductHandler{},
+		handler.OrderHandler{},
+		handler.LocationHandler{},
+		handler.TransferHandler{},
+		handler.LotHandler{},
+		handler.SerialHandler{},
+		handler.BarcodeHandler{},
+		handler.LabelHandler{},
+		handler.ReportHandler{},
+		handler.PriceHandler{},
+		handler.PriceListHandler{},
+		handler.CatalogHandler{},
+		handler.ImageHandler{},
+		handler.ModifierHandler{},
+		handler.TerminalHandler{},
+		handler.RoleHandler{},
+		handler.PasswordHandler{},
+	)
+	if err != nil {
+		t.Fatalf("expected to create the router; got %q", err)
+	}
+
+	return router
+}
+
+func TestRouter_LoginClientIP(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		trustedProxies string
+		remoteAddr     string
+		forwardedFor   string
+		expected       string
+	}{
+		{
+			desc:           "Success_SpoofedForwardedFor",
+			trustedProxies: "",
+			remoteAddr:     "192.0.2.10:51234",
+			forwardedFor:   "203.0.113.7",
+			expected:       "192.0.2.10",
+		},
+		{
+			desc:           "Success_UntrustedProxy",
+			trustedProxies: "10.0.0.1",
+			remoteAddr:     "192.0.2.10:51234",
+			forwardedFor:   "203.0.113.7",
+			expected:       "192.0.2.10",
+		},
+		{
+			desc:           "Success_TrustedProxy",
+			trustedProxies: "10.0.0.1",
+			remoteAddr:     "10.0.0.1:51234",
+			forwardedFor:   "203.0.113.7",
+			expected:       "203.0.113.7",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authService := mock.NewMockAuthService(ctrl)
+			authService.EXPECT().
+				Login(gomock.Any(), gomock.Eq("test@example.com"), gomock.Eq("12345678"), gomock.Cond(func(x any) bool {
+					return x.(domain.Device).IPAddress == tc.expected
+				})).
+				Times(1).
+				Return(nil, domain.ErrInvalidCredentials)
+
+			router := newTestRouter(t, tc.trustedProxies, mock.NewMockTokenService(ctrl), mock.NewMockCacheRepository(ctrl), authService)
+
+			body := `{"email":"test@example.com","password":"12345678"}`
+			req := httptest.NewRequest(http.MethodPost, "/v1/users/login", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Forwarded-For", tc.forwardedFor)
+			req.Header.Set("X-Real-IP", tc.forwardedFor)
+			req.RemoteAddr = tc.remoteAddr
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("[case: %s] expected to get status %d; got %d", tc.desc, http.StatusUnauthorized, rec.Code)
+			}
+		})
+	}
+}
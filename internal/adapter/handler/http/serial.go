@@ -0,0 +1,161 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// SerialHandler represents the HTTP handler for serial-related requests
+type SerialHandler struct {
+	svc port.SerialService
+}
+
+// NewSerialHandler creates a new SerialHandler instance
+func NewSerialHandler(svc port.SerialService) *SerialHandler {
+	return &SerialHandler{
+		svc,
+	}
+}
+
+// createSerialsRequest represents a request body for receiving new serial numbers
+type createSerialsRequest struct {
+	ProductID     uint64   `json:"product_id" binding:"required,min=1" example:"1"`
+	SerialNumbers []string `json:"serial_numbers" binding:"required,min=1,dive,required" example:"SN-0001,SN-0002"`
+	Cost          float64  `json:"cost" binding:"omitempty,min=0" example:"1500000"`
+}
+
+// CreateSerials godoc
+//
+//	@Summary		Receive serial numbers
+//	@Description	receive new serial numbers of a serial-tracked product and add them to the product stock
+//	@Tags			Serials
+//	@Accept			json
+//	@Produce		json
+//	@Param			createSerialsRequest	body		createSerialsRequest	true	"Create serials request"
+//	@Success		200						{object}	meta					"Serials created"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		404						{object}	errorResponse			"Data not found error"
+//	@Failure		409						{object}	errorResponse			"Data conflict error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/serials [post]
+//	@Security		BearerAuth
+func (sh *SerialHandler) CreateSerials(ctx *gin.Context) {
+	var req createSerialsRequest
+	var serialsList []serialResponse
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	serials, err := sh.svc.CreateSerials(ctx, req.ProductID, req.SerialNumbers, req.Cost)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, serial := range serials {
+		serialsList = append(serialsList, newSerialResponse(&serial))
+	}
+
+	total := uint64(len(serialsList))
+	meta := newMeta(total, total, 0)
+	rsp := toMap(meta, serialsList, "serials")
+
+	handleSuccess(ctx, rsp)
+}
+
+// listSerialsRequest represents a request body for listing serials of a product
+type listSerialsRequest struct {
+	ProductID uint64              `form:"product_id" binding:"required,min=1" example:"1"`
+	Status    domain.SerialStatus `form:"status" binding:"omitempty,serial_status" example:"in_stock"`
+	Skip      uint64              `form:"skip" binding:"required,min=0" example:"0"`
+	Limit     uint64              `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListSerials godoc
+//
+//	@Summary		List serials
+//	@Description	List serials of a product, optionally filtered by status, with pagination
+//	@Tags			Serials
+//	@Accept			json
+//	@Produce		json
+//	@Param			product_id	query		uint64				true	"Product ID"
+//	@Param			status		query		domain.SerialStatus	false	"Serial status"	Enums(in_stock, sold)
+//	@Param			skip		query		uint64				true	"Skip"
+//	@Param			limit		query		uint64				true	"Limit"
+//	@Success		200			{object}	meta				"Serials retrieved"
+//	@Failure		400			{object}	errorResponse		"Validation error"
+//	@Failure		404			{object}	errorResponse		"Data not found error"
+//	@Failure		500			{object}	errorResponse		"Internal server error"
+//	@Router			/serials [get]
+//	@Security		BearerAuth
+func (sh *SerialHandler) ListSerials(ctx *gin.Context) {
+	var req listSerialsRequest
+	var serialsList []serialResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	serials, err := sh.svc.ListSerials(ctx, req.ProductID, req.Status, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, serial := range serials {
+		serialsList = append(serialsList, newSerialResponse(&serial))
+	}
+
+	total := uint64(len(serialsList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, serialsList, "serials")
+
+	handleSuccess(ctx, rsp)
+}
+
+// searchSerialsRequest represents a request body for searching serials by serial number
+type searchSerialsRequest struct {
+	SerialNumber string `form:"serial_number" binding:"required" example:"SN-0001"`
+}
+
+// SearchSerials godoc
+//
+//	@Summary		Search a serial number
+//	@Description	Find the product and, if sold, the order receipt of a serial number
+//	@Tags			Serials
+//	@Accept			json
+//	@Produce		json
+//	@Param			serial_number	query		string				true	"Serial number"
+//	@Success		200				{object}	[]serialResponse	"Serials retrieved"
+//	@Failure		400				{object}	errorResponse		"Validation error"
+//	@Failure		404				{object}	errorResponse		"Data not found error"
+//	@Failure		500				{object}	errorResponse		"Internal server error"
+//	@Router			/serials/search [get]
+//	@Security		BearerAuth
+func (sh *SerialHandler) SearchSerials(ctx *gin.Context) {
+	var req searchSerialsRequest
+	var serialsList []serialResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	serials, err := sh.svc.SearchSerials(ctx, req.SerialNumber)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, serial := range serials {
+		serialsList = append(serialsList, newSerialResponse(&serial))
+	}
+
+	handleSuccess(ctx, serialsList)
+}
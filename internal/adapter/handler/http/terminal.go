@@ -0,0 +1,126 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// TerminalHandler represents the HTTP handler for terminal-related requests
+type TerminalHandler struct {
+	svc port.TerminalService
+}
+
+// NewTerminalHandler creates a new TerminalHandler instance
+func NewTerminalHandler(svc port.TerminalService) *TerminalHandler {
+	return &TerminalHandler{
+		svc,
+	}
+}
+
+// registerTerminalRequest represents a request body for registering a terminal
+type registerTerminalRequest struct {
+	Name string `json:"name" binding:"required,max=100" example:"Register 1"`
+}
+
+// RegisterTerminal godoc
+//
+//	@Summary		Register a terminal
+//	@Description	Registers a terminal cashiers can log in on with a PIN and returns its credential. The credential is only shown once and is sent by the terminal in the X-Terminal-Credential header.
+//	@Tags			Terminals
+//	@Accept			json
+//	@Produce		json
+//	@Param			registerTerminalRequest	body		registerTerminalRequest	true	"Register terminal request"
+//	@Success		200						{object}	terminalResponse		"Terminal registered"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		409						{object}	errorResponse			"Data conflict error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/terminals [post]
+//	@Security		BearerAuth
+func (th *TerminalHandler) RegisterTerminal(ctx *gin.Context) {
+	var req registerTerminalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	terminal := domain.Terminal{
+		Name: req.Name,
+	}
+
+	_, err := th.svc.RegisterTerminal(ctx, &terminal)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTerminalResponse(&terminal)
+
+	handleSuccess(ctx, rsp)
+}
+
+// ListTerminals godoc
+//
+//	@Summary		List terminals
+//	@Description	List the registered terminals, without their credentials
+//	@Tags			Terminals
+//	@Produce		json
+//	@Success		200	{array}		terminalResponse	"Terminals displayed"
+//	@Failure		401	{object}	errorResponse		"Unauthorized error"
+//	@Failure		403	{object}	errorResponse		"Forbidden error"
+//	@Failure		500	{object}	errorResponse		"Internal server error"
+//	@Router			/terminals [get]
+//	@Security		BearerAuth
+func (th *TerminalHandler) ListTerminals(ctx *gin.Context) {
+	terminals, err := th.svc.ListTerminals(ctx)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	list := make([]terminalResponse, 0, len(terminals))
+	for _, terminal := range terminals {
+		list = append(list, newTerminalResponse(&terminal))
+	}
+
+	handleSuccess(ctx, list)
+}
+
+// deleteTerminalRequest represents a request body for deleting a terminal
+type deleteTerminalRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// DeleteTerminal godoc
+//
+//	@Summary		Delete a terminal
+//	@Description	Delete a terminal by id, so PIN login is no longer accepted on it
+//	@Tags			Terminals
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64			true	"Terminal ID"
+//	@Success		200	{object}	response		"Terminal deleted"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/terminals/{id} [delete]
+//	@Security		BearerAuth
+func (th *TerminalHandler) DeleteTerminal(ctx *gin.Context) {
+	var req deleteTerminalRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	err := th.svc.DeleteTerminal(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
@@ -0,0 +1,316 @@
+package http
+
+import (
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/gin-gonic/gin"
+)
+
+// TransferHandler represents the HTTP handler for transfer-related requests
+type TransferHandler struct {
+	svc port.TransferService
+}
+
+// NewTransferHandler creates a new TransferHandler instance
+func NewTransferHandler(svc port.TransferService) *TransferHandler {
+	return &TransferHandler{
+		svc,
+	}
+}
+
+// transferItemRequest represents a product quantity of a transfer request body
+type transferItemRequest struct {
+	ProductID uint64  `json:"product_id" binding:"required,min=1" example:"1"`
+	Quantity  float64 `json:"qty" binding:"required,gt=0" example:"24"`
+}
+
+// createTransferRequest represents a request body for requesting a transfer
+type createTransferRequest struct {
+	FromLocationID uint64                `json:"from_location_id" binding:"required,min=1" example:"1"`
+	ToLocationID   uint64                `json:"to_location_id" binding:"required,min=1" example:"2"`
+	Note           string                `json:"note" binding:"omitempty,max=255" example:"Weekly restock"`
+	Items          []transferItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+// CreateTransfer godoc
+//
+//	@Summary		Request a transfer
+//	@Description	request a transfer of product quantities, in their base units, from one location to another. Stock is only taken out of the source location once the transfer is dispatched
+//	@Tags			Transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			createTransferRequest	body		createTransferRequest	true	"Create transfer request"
+//	@Success		200						{object}	transferResponse		"Transfer requested"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		404						{object}	errorResponse			"Data not found error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/transfers [post]
+//	@Security		BearerAuth
+func (th *TransferHandler) CreateTransfer(ctx *gin.Context) {
+	var req createTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	var items []domain.TransferItem
+	for _, item := range req.Items {
+		items = append(items, domain.TransferItem{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	transfer := domain.Transfer{
+		FromLocationID: req.FromLocationID,
+		ToLocationID:   req.ToLocationID,
+		UserID:         authPayload.UserID,
+		Note:           req.Note,
+		Items:          items,
+	}
+
+	created, err := th.svc.CreateTransfer(ctx, &transfer)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTransferResponse(created)
+
+	handleSuccess(ctx, rsp)
+}
+
+// getTransferRequest represents a request body for retrieving a transfer
+type getTransferRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// GetTransfer godoc
+//
+//	@Summary		Get a transfer
+//	@Description	get a transfer by id with its items, the discrepancies found on receipt and the stock movements it recorded
+//	@Tags			Transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64				true	"Transfer ID"
+//	@Success		200	{object}	transferResponse	"Transfer retrieved"
+//	@Failure		400	{object}	errorResponse		"Validation error"
+//	@Failure		404	{object}	errorResponse		"Data not found error"
+//	@Failure		500	{object}	errorResponse		"Internal server error"
+//	@Router			/transfers/{id} [get]
+//	@Security		BearerAuth
+func (th *TransferHandler) GetTransfer(ctx *gin.Context) {
+	var req getTransferRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	transfer, err := th.svc.GetTransfer(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTransferResponse(transfer)
+
+	handleSuccess(ctx, rsp)
+}
+
+// listTransfersRequest represents a request body for listing transfers
+type listTransfersRequest struct {
+	Status domain.TransferStatus `form:"status" binding:"omitempty,transfer_status" example:"in_transit"`
+	Skip   uint64                `form:"skip" binding:"required,min=0" example:"0"`
+	Limit  uint64                `form:"limit" binding:"required,min=5" example:"5"`
+}
+
+// ListTransfers godoc
+//
+//	@Summary		List transfers
+//	@Description	List transfers, the latest first, optionally filtered by status, with pagination
+//	@Tags			Transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			status	query		domain.TransferStatus	false	"Transfer status"	Enums(requested, in_transit, received, canceled)
+//	@Param			skip	query		uint64					true	"Skip"
+//	@Param			limit	query		uint64					true	"Limit"
+//	@Success		200		{object}	meta					"Transfers retrieved"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/transfers [get]
+//	@Security		BearerAuth
+func (th *TransferHandler) ListTransfers(ctx *gin.Context) {
+	var req listTransfersRequest
+	var transfersList []transferResponse
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	transfers, err := th.svc.ListTransfers(ctx, req.Status, req.Skip, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	for _, transfer := range transfers {
+		transfersList = append(transfersList, newTransferResponse(&transfer))
+	}
+
+	total := uint64(len(transfersList))
+	meta := newMeta(total, req.Limit, req.Skip)
+	rsp := toMap(meta, transfersList, "transfers")
+
+	handleSuccess(ctx, rsp)
+}
+
+// dispatchTransferRequest represents a request body for dispatching a transfer
+type dispatchTransferRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// DispatchTransfer godoc
+//
+//	@Summary		Dispatch a transfer
+//	@Description	take the items of a requested transfer out of the stock of its source location, recording the stock movements, and put them in transit until the transfer is received
+//	@Tags			Transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64				true	"Transfer ID"
+//	@Success		200	{object}	transferResponse	"Transfer dispatched"
+//	@Failure		400	{object}	errorResponse		"Validation error"
+//	@Failure		401	{object}	errorResponse		"Unauthorized error"
+//	@Failure		403	{object}	errorResponse		"Forbidden error"
+//	@Failure		404	{object}	errorResponse		"Data not found error"
+//	@Failure		409	{object}	errorResponse		"Transfer status error"
+//	@Failure		500	{object}	errorResponse		"Internal server error"
+//	@Router			/transfers/{id}/dispatch [post]
+//	@Security		BearerAuth
+func (th *TransferHandler) DispatchTransfer(ctx *gin.Context) {
+	var req dispatchTransferRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	transfer, err := th.svc.DispatchTransfer(ctx, req.ID, authPayload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTransferResponse(transfer)
+
+	handleSuccess(ctx, rsp)
+}
+
+// receivedItemRequest represents a product quantity received on a transfer request body
+type receivedItemRequest struct {
+	ProductID        uint64   `json:"product_id" binding:"required,min=1" example:"1"`
+	ReceivedQuantity *float64 `json:"received_qty" binding:"required,min=0" example:"23"`
+}
+
+// receiveTransferRequest represents a request body for receiving a transfer
+type receiveTransferRequest struct {
+	Items []receivedItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+// ReceiveTransfer godoc
+//
+//	@Summary		Receive a transfer
+//	@Description	add the quantity received of each product of a transfer in transit, in its base unit, to the stock of its destination location, recording the stock movements. Every product of the transfer must be listed; quantities that differ from those dispatched are kept on the items as discrepancies
+//	@Tags			Transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		uint64					true	"Transfer ID"
+//	@Param			receiveTransferRequest	body		receiveTransferRequest	true	"Receive transfer request"
+//	@Success		200						{object}	transferResponse		"Transfer received"
+//	@Failure		400						{object}	errorResponse			"Validation error"
+//	@Failure		401						{object}	errorResponse			"Unauthorized error"
+//	@Failure		403						{object}	errorResponse			"Forbidden error"
+//	@Failure		404						{object}	errorResponse			"Data not found error"
+//	@Failure		409						{object}	errorResponse			"Transfer status error"
+//	@Failure		500						{object}	errorResponse			"Internal server error"
+//	@Router			/transfers/{id}/receive [post]
+//	@Security		BearerAuth
+func (th *TransferHandler) ReceiveTransfer(ctx *gin.Context) {
+	var req receiveTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	idStr := ctx.Param("id")
+	id, err := stringToUint64(idStr)
+	if err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	authPayload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	var received []domain.TransferItem
+	for _, item := range req.Items {
+		received = append(received, domain.TransferItem{
+			ProductID:        item.ProductID,
+			ReceivedQuantity: item.ReceivedQuantity,
+		})
+	}
+
+	transfer, err := th.svc.ReceiveTransfer(ctx, id, received, authPayload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTransferResponse(transfer)
+
+	handleSuccess(ctx, rsp)
+}
+
+// cancelTransferRequest represents a request body for canceling a transfer
+type cancelTransferRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// CancelTransfer godoc
+//
+//	@Summary		Cancel a transfer
+//	@Description	cancel a transfer that has not been dispatched yet
+//	@Tags			Transfers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		uint64				true	"Transfer ID"
+//	@Success		200	{object}	transferResponse	"Transfer canceled"
+//	@Failure		400	{object}	errorResponse		"Validation error"
+//	@Failure		401	{object}	errorResponse		"Unauthorized error"
+//	@Failure		403	{object}	errorResponse		"Forbidden error"
+//	@Failure		404	{object}	errorResponse		"Data not found error"
+//	@Failure		409	{object}	errorResponse		"Transfer status error"
+//	@Failure		500	{object}	errorResponse		"Internal server error"
+//	@Router			/transfers/{id}/cancel [post]
+//	@Security		BearerAuth
+func (th *TransferHandler) CancelTransfer(ctx *gin.Context) {
+	var req cancelTransferRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	transfer, err := th.svc.CancelTransfer(ctx, req.ID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTransferResponse(transfer)
+
+	handleSuccess(ctx, rsp)
+}
@@ -0,0 +1,215 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// completeTwoFactorRequest represents the request body for completing a login with a two-factor code
+type completeTwoFactorRequest struct {
+	Challenge string `json:"challenge" binding:"required" example:"Zk3n9QvX1c7bT2yW5pL0aR8sD4fH6jK1mN3qU5wE7rY"`
+	Code      string `json:"code" binding:"required,max=32" example:"123456"`
+}
+
+// CompleteTwoFactor godoc
+//
+//	@Summary		Complete a login with a two-factor code
+//	@Description	Completes a login challenged for a two-factor code with a code from the authenticator app or an unused recovery code, and returns a short-lived access token and a refresh token for the device the login was challenged on. A challenge with an enrollment only accepts a code from the authenticator app, which enables two-factor authentication. A challenge expires after 5 minutes or 5 invalid codes.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		completeTwoFactorRequest	true	"Complete two-factor request body"
+//	@Success		200		{object}	authResponse				"Succesfully logged in"
+//	@Failure		400		{object}	errorResponse				"Validation error"
+//	@Failure		401		{object}	errorResponse				"Unauthorized error"
+//	@Failure		429		{object}	errorResponse				"Too many invalid codes error"
+//	@Failure		500		{object}	errorResponse				"Internal server error"
+//	@Router			/users/login/2fa [post]
+func (ah *AuthHandler) CompleteTwoFactor(ctx *gin.Context) {
+	var req completeTwoFactorRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	token, err := ah.svc.CompleteTwoFactor(ctx, req.Challenge, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newAuthResponse(token)
+
+	handleSuccess(ctx, rsp)
+}
+
+// EnrollTwoFactor godoc
+//
+//	@Summary		Enroll in two-factor authentication
+//	@Description	Generates a TOTP secret for the current user with its provisioning URI, to show as a QR code for an authenticator app, and recovery codes that are only shown once. Two-factor authentication is only enabled once it is confirmed with a code from the app; enrolling again before that replaces the secret and recovery codes.
+//	@Tags			Users
+//	@Produce		json
+//	@Success		200	{object}	twoFactorEnrollmentResponse	"Enrolled"
+//	@Failure		401	{object}	errorResponse				"Unauthorized error"
+//	@Failure		409	{object}	errorResponse				"Two-factor authentication already enabled error"
+//	@Failure		500	{object}	errorResponse				"Internal server error"
+//	@Router			/users/2fa [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) EnrollTwoFactor(ctx *gin.Context) {
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	enrollment, err := ah.svc.EnrollTwoFactor(ctx, payload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newTwoFactorEnrollmentResponse(enrollment)
+
+	handleSuccess(ctx, rsp)
+}
+
+// twoFactorCodeRequest represents the request body for an action confirmed with a two-factor code
+type twoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required,max=32" example:"123456"`
+}
+
+// ConfirmTwoFactor godoc
+//
+//	@Summary		Confirm two-factor authentication
+//	@Description	Enables the enrolled two-factor authentication of the current user with a code from the authenticator app.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		twoFactorCodeRequest	true	"Two-factor code request body"
+//	@Success		200		{object}	response				"Two-factor authentication enabled"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Unauthorized error"
+//	@Failure		404		{object}	errorResponse			"Data not found error"
+//	@Failure		409		{object}	errorResponse			"Two-factor authentication already enabled error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/users/2fa/confirm [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) ConfirmTwoFactor(ctx *gin.Context) {
+	var req twoFactorCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.ConfirmTwoFactor(ctx, payload.UserID, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// DisableTwoFactor godoc
+//
+//	@Summary		Disable two-factor authentication
+//	@Description	Disables the two-factor authentication of the current user with a code from the authenticator app or an unused recovery code. Not allowed for users whose role can manage users or roles while two-factor authentication is enforced.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		twoFactorCodeRequest	true	"Two-factor code request body"
+//	@Success		200		{object}	response				"Two-factor authentication disabled"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Unauthorized error"
+//	@Failure		403		{object}	errorResponse			"Two-factor authentication required error"
+//	@Failure		404		{object}	errorResponse			"Data not found error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/users/2fa/disable [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) DisableTwoFactor(ctx *gin.Context) {
+	var req twoFactorCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.DisableTwoFactor(ctx, payload.UserID, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
+
+// RegenerateRecoveryCodes godoc
+//
+//	@Summary		Regenerate recovery codes
+//	@Description	Replaces the recovery codes of the current user with new ones, shown only once, after checking a code from the authenticator app or an unused recovery code.
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		twoFactorCodeRequest	true	"Two-factor code request body"
+//	@Success		200		{object}	recoveryCodesResponse	"Recovery codes regenerated"
+//	@Failure		400		{object}	errorResponse			"Validation error"
+//	@Failure		401		{object}	errorResponse			"Unauthorized error"
+//	@Failure		404		{object}	errorResponse			"Data not found error"
+//	@Failure		500		{object}	errorResponse			"Internal server error"
+//	@Router			/users/2fa/recovery-codes [post]
+//	@Security		BearerAuth
+func (ah *AuthHandler) RegenerateRecoveryCodes(ctx *gin.Context) {
+	var req twoFactorCodeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	recoveryCodes, err := ah.svc.RegenerateRecoveryCodes(ctx, payload.UserID, req.Code)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	rsp := newRecoveryCodesResponse(recoveryCodes)
+
+	handleSuccess(ctx, rsp)
+}
+
+// resetTwoFactorRequest represents the request body for resetting the two-factor authentication of a user
+type resetTwoFactorRequest struct {
+	ID uint64 `uri:"id" binding:"required,min=1" example:"1"`
+}
+
+// ResetTwoFactor godoc
+//
+//	@Summary		Reset the two-factor authentication of a user
+//	@Description	Removes the two-factor authentication and recovery codes of a user who lost access to them. A user whose two-factor authentication is enforced enrolls again on the next login.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			id	path		uint64			true	"User ID"
+//	@Success		200	{object}	response		"Two-factor authentication reset"
+//	@Failure		400	{object}	errorResponse	"Validation error"
+//	@Failure		401	{object}	errorResponse	"Unauthorized error"
+//	@Failure		403	{object}	errorResponse	"Forbidden error"
+//	@Failure		404	{object}	errorResponse	"Data not found error"
+//	@Failure		500	{object}	errorResponse	"Internal server error"
+//	@Router			/users/{id}/2fa [delete]
+//	@Security		BearerAuth
+func (ah *AuthHandler) ResetTwoFactor(ctx *gin.Context) {
+	var req resetTwoFactorRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := ah.svc.ResetTwoFactor(ctx, req.ID, payload.UserID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
@@ -152,7 +152,7 @@ type updateUserRequest struct {
 	Name     string          `json:"name" binding:"omitempty,required" example:"John Doe"`
 	Email    string          `json:"email" binding:"omitempty,required,email" example:"test@example.com"`
 	Password string          `json:"password" binding:"omitempty,required,min=8" example:"12345678"`
-	Role     domain.UserRole `json:"role" binding:"omitempty,required,user_role" example:"admin"`
+	Role     domain.UserRole `json:"role" binding:"omitempty,required,max=50" example:"admin"`
 }
 
 // UpdateUser godoc
@@ -241,3 +241,42 @@ func (uh *UserHandler) DeleteUser(ctx *gin.Context) {
 
 	handleSuccess(ctx, nil)
 }
+
+// setPINRequest represents the request body for setting the PIN of the current user
+type setPINRequest struct {
+	PIN string `json:"pin" binding:"required,numeric,min=4,max=8" example:"1234" minLength:"4" maxLength:"8"`
+}
+
+// SetPIN godoc
+//
+//	@Summary		Set the PIN of the current user
+//	@Description	Sets the numeric PIN the current user logs in with on a registered terminal, if their role allows PIN login, replacing the previous one
+//	@Tags			Users
+//	@Accept			json
+//	@Produce		json
+//	@Param			setPINRequest	body		setPINRequest	true	"Set PIN request"
+//	@Success		200				{object}	response		"PIN set"
+//	@Failure		400				{object}	errorResponse	"Validation error"
+//	@Failure		401				{object}	errorResponse	"Unauthorized error"
+//	@Failure		403				{object}	errorResponse	"Forbidden error"
+//	@Failure		404				{object}	errorResponse	"Data not found error"
+//	@Failure		500				{object}	errorResponse	"Internal server error"
+//	@Router			/users/pin [put]
+//	@Security		BearerAuth
+func (uh *UserHandler) SetPIN(ctx *gin.Context) {
+	var req setPINRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		validationError(ctx, err)
+		return
+	}
+
+	payload := getAuthPayload(ctx, authorizationPayloadKey)
+
+	err := uh.svc.SetPIN(ctx, payload.UserID, req.PIN)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	handleSuccess(ctx, nil)
+}
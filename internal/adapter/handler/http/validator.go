@@ -1,20 +1,17 @@
 package http
 
 import (
+	"slices"
+
 	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/go-playground/validator/v10"
 )
 
-// userRoleValidator is a custom validator for validating user roles
-var userRoleValidator validator.Func = func(fl validator.FieldLevel) bool {
-	userRole := fl.Field().Interface().(domain.UserRole)
+// permissionValidator is a custom validator for validating role permissions
+var permissionValidator validator.Func = func(fl validator.FieldLevel) bool {
+	permission := fl.Field().Interface().(domain.Permission)
 
-	switch userRole {
-	case "admin", "cashier":
-		return true
-	default:
-		return false
-	}
+	return slices.Contains(domain.Permissions, permission)
 }
 
 // paymentTypeValidator is a custom validator for validating payment types
@@ -28,3 +25,99 @@ var paymentTypeValidator validator.Func = func(fl validator.FieldLevel) bool {
 		return false
 	}
 }
+
+// transferStatusValidator is a custom validator for validating transfer statuses
+var transferStatusValidator validator.Func = func(fl validator.FieldLevel) bool {
+	status := fl.Field().Interface().(domain.TransferStatus)
+
+	switch status {
+	case "requested", "in_transit", "received", "canceled":
+		return true
+	default:
+		return false
+	}
+}
+
+// serialStatusValidator is a custom validator for validating serial statuses
+var serialStatusValidator validator.Func = func(fl validator.FieldLevel) bool {
+	serialStatus := fl.Field().Interface().(domain.SerialStatus)
+
+	switch serialStatus {
+	case "in_stock", "sold":
+		return true
+	default:
+		return false
+	}
+}
+
+// barcodeTypeValidator is a custom validator for validating barcode types
+var barcodeTypeValidator validator.Func = func(fl validator.FieldLevel) bool {
+	barcodeType := fl.Field().Interface().(domain.BarcodeType)
+
+	switch barcodeType {
+	case "standard", "weight", "price":
+		return true
+	default:
+		return false
+	}
+}
+
+// barcodeSymbologyValidator is a custom validator for validating barcode symbologies
+var barcodeSymbologyValidator validator.Func = func(fl validator.FieldLevel) bool {
+	symbology := fl.Field().Interface().(domain.BarcodeSymbology)
+
+	switch symbology {
+	case "code128", "ean13":
+		return true
+	default:
+		return false
+	}
+}
+
+// barcodeFormatValidator is a custom validator for validating barcode image formats
+var barcodeFormatValidator validator.Func = func(fl validator.FieldLevel) bool {
+	format := fl.Field().Interface().(domain.LabelFormat)
+
+	switch format {
+	case "svg", "png":
+		return true
+	default:
+		return false
+	}
+}
+
+// valuationMethodValidator is a custom validator for validating inventory valuation methods
+var valuationMethodValidator validator.Func = func(fl validator.FieldLevel) bool {
+	method := fl.Field().Interface().(domain.ValuationMethod)
+
+	switch method {
+	case "average", "fifo":
+		return true
+	default:
+		return false
+	}
+}
+
+// customerGroupValidator is a custom validator for validating customer groups
+var customerGroupValidator validator.Func = func(fl validator.FieldLevel) bool {
+	group := fl.Field().Interface().(domain.CustomerGroup)
+
+	switch group {
+	case "retail", "wholesale", "staff":
+		return true
+	default:
+		return false
+	}
+}
+
+// catalogFormatValidator is a custom validator for validating product catalog file formats
+var catalogFormatValidator validator.Func = func(fl validator.FieldLevel) bool {
+	format := fl.Field().Interface().(domain.CatalogFormat)
+
+	switch format {
+	case "csv", "xlsx":
+		return true
+	default:
+		return false
+	}
+}
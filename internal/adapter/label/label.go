@@ -0,0 +1,238 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/ean"
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	// moduleWidth is the width in pixels of the narrowest bar of a rendered barcode
+	moduleWidth = 2
+	// quietZone is the number of blank modules on each side of a rendered barcode
+	quietZone = 10
+	// barHeight is the height in pixels of the bars of a rendered barcode
+	barHeight = 80
+	// textHeight is the height in pixels of the human-readable code under an SVG barcode
+	textHeight = 20
+)
+
+// shelf-label sheet layout in millimeters, 3 x 8 labels of 70 x 37 on an A4 page
+const (
+	sheetColumns   = 3
+	sheetRows      = 8
+	sheetMarginTop = 0.5
+	labelWidth     = 70.0
+	labelHeight    = 37.0
+	labelPadding   = 3.0
+	barcodeWidth   = 50.0
+	barcodeHeight  = 12.0
+)
+
+/**
+ * LabelRenderer implements port.LabelRenderer interface
+ * and provides an access to the barcode and gofpdf libraries
+ */
+type LabelRenderer struct{}
+
+// New creates a new label renderer instance
+func New() port.LabelRenderer {
+	return &LabelRenderer{}
+}
+
+// RenderBarcode renders a code as an SVG or PNG barcode image
+func (lr *LabelRenderer) RenderBarcode(symbology domain.BarcodeSymbology, code string, format domain.LabelFormat) (*domain.Label, error) {
+	bc, err := encode(symbology, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var content []byte
+
+	switch format {
+	case domain.LabelSVG:
+		content = renderSVG(bc)
+	case domain.LabelPNG:
+		content, err = renderPNG(bc)
+	default:
+		err = domain.ErrLabelRendering
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Label{
+		Format:  format,
+		Content: content,
+	}, nil
+}
+
+// RenderShelfLabels renders a printable PDF sheet of shelf labels with the name, price and barcode of products
+func (lr *LabelRenderer) RenderShelfLabels(labels []domain.ShelfLabel) (*domain.Label, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pageWidth, _ := pdf.GetPageSize()
+	marginLeft := (pageWidth - sheetColumns*labelWidth) / 2
+
+	for i, shelfLabel := range labels {
+		position := i % (sheetColumns * sheetRows)
+		if position == 0 {
+			pdf.AddPage()
+		}
+
+		x := marginLeft + float64(position%sheetColumns)*labelWidth + labelPadding
+		y := sheetMarginTop + float64(position/sheetColumns)*labelHeight + labelPadding
+		width := labelWidth - 2*labelPadding
+
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.SetXY(x, y)
+		pdf.CellFormat(width, 5, tr(fitText(pdf, shelfLabel.Name, width)), "", 0, "L", false, 0, "")
+
+		price := fmt.Sprintf("%.2f", shelfLabel.Price)
+		if shelfLabel.Unit != "" && shelfLabel.Unit != domain.DefaultUnit {
+			price += " / " + shelfLabel.Unit
+		}
+
+		pdf.SetFont("Helvetica", "B", 16)
+		pdf.SetXY(x, y+5)
+		pdf.CellFormat(width, 8, tr(price), "", 0, "L", false, 0, "")
+
+		bc, err := encode(shelfLabel.Symbology, shelfLabel.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		barcodeImage, err := renderPNG(bc)
+		if err != nil {
+			return nil, err
+		}
+
+		imageName := fmt.Sprintf("barcode-%d", i)
+		pdf.RegisterImageOptionsReader(imageName, gofpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(barcodeImage))
+		pdf.ImageOptions(imageName, x+(width-barcodeWidth)/2, y+14, barcodeWidth, barcodeHeight, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+		pdf.SetFont("Helvetica", "", 7)
+		pdf.SetXY(x, y+14+barcodeHeight)
+		pdf.CellFormat(width, 4, tr(shelfLabel.Code), "", 0, "C", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, domain.ErrLabelRendering
+	}
+
+	return &domain.Label{
+		Format:  domain.LabelPDF,
+		Content: buf.Bytes(),
+	}, nil
+}
+
+// encode encodes a code into a one-dimensional barcode of the given symbology
+func encode(symbology domain.BarcodeSymbology, code string) (barcode.Barcode, error) {
+	var bc barcode.Barcode
+	var err error
+
+	switch symbology {
+	case domain.SymbologyCode128:
+		bc, err = code128.Encode(code)
+	case domain.SymbologyEAN13:
+		if len(code) != 13 {
+			return nil, domain.ErrInvalidBarcode
+		}
+		bc, err = ean.Encode(code)
+	default:
+		return nil, domain.ErrLabelRendering
+	}
+	if err != nil {
+		return nil, domain.ErrInvalidBarcode
+	}
+
+	return bc, nil
+}
+
+// renderSVG renders a one-dimensional barcode as an SVG image with the human-readable code under the bars
+func renderSVG(bc barcode.Barcode) []byte {
+	modules := bc.Bounds().Dx()
+	width := (modules + 2*quietZone) * moduleWidth
+	height := barHeight + textHeight
+
+	var svg strings.Builder
+
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+	fmt.Fprintf(&svg, `<rect width="%d" height="%d" fill="#fff"/>`, width, height)
+
+	for x := 0; x < modules; {
+		if !isBar(bc.At(x, 0)) {
+			x++
+			continue
+		}
+
+		start := x
+		for x < modules && isBar(bc.At(x, 0)) {
+			x++
+		}
+
+		fmt.Fprintf(&svg, `<rect x="%d" y="0" width="%d" height="%d" fill="#000"/>`, (quietZone+start)*moduleWidth, (x-start)*moduleWidth, barHeight)
+	}
+
+	fmt.Fprintf(&svg, `<text x="%d" y="%d" font-family="monospace" font-size="14" text-anchor="middle">%s</text>`, width/2, height-4, html.EscapeString(bc.Content()))
+	svg.WriteString(`</svg>`)
+
+	return []byte(svg.String())
+}
+
+// renderPNG renders a one-dimensional barcode as a PNG image
+func renderPNG(bc barcode.Barcode) ([]byte, error) {
+	modules := bc.Bounds().Dx()
+
+	scaled, err := barcode.Scale(bc, modules*moduleWidth, barHeight)
+	if err != nil {
+		return nil, domain.ErrLabelRendering
+	}
+
+	img := image.NewGray(image.Rect(0, 0, (modules+2*quietZone)*moduleWidth, barHeight))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(img, scaled.Bounds().Add(image.Pt(quietZone*moduleWidth, 0)), scaled, image.Point{}, draw.Src)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, domain.ErrLabelRendering
+	}
+
+	return buf.Bytes(), nil
+}
+
+// isBar checks whether a barcode module is a dark bar
+func isBar(c color.Color) bool {
+	gray := color.GrayModel.Convert(c).(color.Gray)
+	return gray.Y < 128
+}
+
+// fitText truncates a text with an ellipsis so that it fits in the given width of the current font
+func fitText(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return string(runes) + "..."
+}
@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+/**
+ * AuditLogger implements port.AuditLogger interface
+ * and records audit events with the default logger
+ */
+type AuditLogger struct {
+	logger *slog.Logger
+}
+
+// NewAuditLogger creates a new audit logger instance, using the logger configured by Set
+func NewAuditLogger() *AuditLogger {
+	return &AuditLogger{
+		slog.Default().With("audit", true),
+	}
+}
+
+// Record logs an audit event
+func (al *AuditLogger) Record(ctx context.Context, event *domain.AuditEvent) {
+	al.logger.InfoContext(ctx, "Audit event",
+		"type", event.Type,
+		"actor_id", event.ActorID,
+		"user_id", event.UserID,
+		"subject", event.Subject,
+		"ip_address", event.IPAddress,
+		"occurred_at", event.OccurredAt,
+	)
+}
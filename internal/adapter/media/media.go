@@ -0,0 +1,71 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"net/http"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/disintegration/imaging"
+)
+
+const (
+	// thumbnailSize is the largest width and height in pixels of a thumbnail
+	thumbnailSize = 256
+	// maxPixels is the largest number of pixels of an image that is decoded, to keep small files of huge images out of memory
+	maxPixels = 50_000_000
+)
+
+// formats is a map of the content types of the supported image formats and their imaging formats
+var formats = map[string]imaging.Format{
+	"image/jpeg": imaging.JPEG,
+	"image/png":  imaging.PNG,
+	"image/gif":  imaging.GIF,
+}
+
+/**
+ * ImageProcessor implements port.ImageProcessor interface
+ * and provides an access to the imaging library
+ */
+type ImageProcessor struct{}
+
+// New creates a new image processor instance
+func New() port.ImageProcessor {
+	return &ImageProcessor{}
+}
+
+// Thumbnail detects the format of an image from its content and scales it down, keeping its aspect ratio and
+// applying its EXIF orientation, to fit a square thumbnail. Images smaller than a thumbnail keep their size
+func (ip *ImageProcessor) Thumbnail(content []byte) (*domain.File, error) {
+	contentType := http.DetectContentType(content)
+
+	format, ok := formats[contentType]
+	if !ok {
+		return nil, domain.ErrInvalidImage
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || config.Width*config.Height > maxPixels {
+		return nil, domain.ErrInvalidImage
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(content), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, domain.ErrInvalidImage
+	}
+
+	thumbnail := imaging.Fit(img, thumbnailSize, thumbnailSize, imaging.Lanczos)
+
+	var buf bytes.Buffer
+
+	err = imaging.Encode(&buf, thumbnail, format, imaging.JPEGQuality(85))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.File{
+		ContentType: contentType,
+		Content:     buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,168 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+// sendTimeout is how long sending an email may take when the context has no earlier deadline
+const sendTimeout = 30 * time.Second
+
+/**
+ * SMTPNotifier implements port.Notifier interface
+ * and sends notifications as emails through an SMTP server
+ */
+type SMTPNotifier struct {
+	host     string
+	addr     string
+	auth     smtp.Auth
+	from     *mail.Address
+	resetURL string
+}
+
+// New creates a new SMTP notifier instance, authenticating to the server only if a username is configured
+func New(config *config.Mail) (port.Notifier, error) {
+	if config.Host == "" || config.Port == "" || config.From == "" {
+		return nil, domain.ErrMailConfig
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, domain.ErrMailConfig
+	}
+
+	var auth smtp.Auth
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+
+	return &SMTPNotifier{
+		config.Host,
+		net.JoinHostPort(config.Host, config.Port),
+		auth,
+		from,
+		config.ResetURL,
+	}, nil
+}
+
+// SendPasswordReset emails a user a link to the password reset page with the password reset token
+func (sn *SMTPNotifier) SendPasswordReset(ctx context.Context, user *domain.User, resetToken *domain.PasswordResetToken) error {
+	link, err := url.Parse(sn.resetURL)
+	if err != nil {
+		return err
+	}
+
+	query := link.Query()
+	query.Set("token", resetToken.Token)
+	link.RawQuery = query.Encode()
+
+	body := fmt.Sprintf(
+		"Hi %s,\r\n\r\nUse the link below to reset your password. It expires at %s.\r\n\r\n%s\r\n\r\nIf you did not request a password reset, you can ignore this email.\r\n",
+		user.Name,
+		resetToken.ExpiresAt.Format(time.RFC1123),
+		link,
+	)
+
+	to := &mail.Address{Name: user.Name, Address: user.Email}
+
+	return sn.send(ctx, to, "Reset your password", body)
+}
+
+// send sends a plain text email to a recipient, unless the context is done or the send timeout passes before it is sent.
+// The connection to the server is closed once either happens, so that a slow or hung server cannot block the sender
+func (sn *SMTPNotifier) send(ctx context.Context, to *mail.Address, subject, body string) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", sn.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(body)
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", sn.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+
+	err = sn.sendMessage(conn, to, msg.Bytes())
+	if err != nil && ctx.Err() != nil {
+		// The connection was closed as the context is done
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// sendMessage sends a message to a recipient over a connection to the SMTP server, like smtp.SendMail,
+// using STARTTLS when the server supports it and authenticating if the notifier has credentials
+func (sn *SMTPNotifier) sendMessage(conn net.Conn, to *mail.Address, msg []byte) error {
+	client, err := smtp.NewClient(conn, sn.host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: sn.host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if sn.auth != nil {
+		err = client.Auth(sn.auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(sn.from.Address)
+	if err != nil {
+		return err
+	}
+
+	err = client.Rcpt(to.Address)
+	if err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,134 @@
+package notifier_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/adapter/notifier"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveSMTP accepts a single connection on the listener and answers it as a minimal SMTP server,
+// sending the data of the received message on the channel
+func serveSMTP(listener net.Listener, data chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost ESMTP")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		switch command := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(command, "EHLO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "DATA"):
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var msg strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				msg.WriteString(line)
+			}
+
+			data <- msg.String()
+			reply("250 OK")
+		case strings.HasPrefix(command, "QUIT"):
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+// newNotifier creates an SMTP notifier sending through the server listening on the listener
+func newNotifier(t *testing.T, listener net.Listener) *notifier.SMTPNotifier {
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	n, err := notifier.New(&config.Mail{
+		Host:     host,
+		Port:     port,
+		From:     "go-pos <no-reply@go-pos.local>",
+		ResetURL: "http://127.0.0.1:3000/reset-password",
+	})
+	require.NoError(t, err)
+
+	return n.(*notifier.SMTPNotifier)
+}
+
+func TestSMTPNotifier_SendPasswordReset(t *testing.T) {
+	user := &domain.User{
+		Name:  "John Doe",
+		Email: "john@example.com",
+	}
+	resetToken := &domain.PasswordResetToken{
+		Token:     "reset-token",
+		ExpiresAt: time.Now().Add(30 * time.Minute),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		data := make(chan string, 1)
+		go serveSMTP(listener, data)
+
+		err = newNotifier(t, listener).SendPasswordReset(context.Background(), user, resetToken)
+		require.NoError(t, err)
+
+		msg := <-data
+		assert.Contains(t, msg, "To: \"John Doe\" <john@example.com>", "Recipient mismatch")
+		assert.Contains(t, msg, "http://127.0.0.1:3000/reset-password?token=reset-token", "Link mismatch")
+	})
+
+	t.Run("Fail_HungServer", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		// The server accepts the connection but never greets the client
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			time.Sleep(5 * time.Second)
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err = newNotifier(t, listener).SendPasswordReset(ctx, user, resetToken)
+		assert.Equal(t, context.DeadlineExceeded, err, "Error mismatch")
+		assert.Less(t, time.Since(start), time.Second, "Send was not abandoned at the deadline")
+	})
+}
@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+/**
+ * Scheduler runs the background jobs of the application
+ * at a fixed interval
+ */
+type Scheduler struct {
+	interval     time.Duration
+	priceService port.PriceService
+}
+
+// New creates a new scheduler instance
+func New(config *config.Scheduler, priceService port.PriceService) (*Scheduler, error) {
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil || interval <= 0 {
+		return nil, domain.ErrSchedulerInterval
+	}
+
+	return &Scheduler{
+		interval,
+		priceService,
+	}, nil
+}
+
+// Start runs the background jobs in a separate goroutine until the context is canceled
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.run(ctx)
+			}
+		}
+	}()
+}
+
+// run runs the background jobs once
+func (s *Scheduler) run(ctx context.Context) {
+	applied, err := s.priceService.ApplyScheduledPrices(ctx, time.Now())
+	if err != nil {
+		slog.Error("Error applying scheduled prices", "error", err, "applied", applied)
+		return
+	}
+
+	if applied > 0 {
+		slog.Info("Applied scheduled prices", "applied", applied)
+	}
+}
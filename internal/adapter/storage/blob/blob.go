@@ -0,0 +1,51 @@
+package blob
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+// New creates a new file storage instance of the configured driver, the local filesystem by default
+func New(ctx context.Context, config *config.Storage) (port.FileStorage, error) {
+	switch config.Driver {
+	case "", "local":
+		return NewLocalStorage(config)
+	case "s3":
+		return NewS3Storage(ctx, config)
+	default:
+		return nil, domain.ErrStorageDriver
+	}
+}
+
+// publicURL builds the public URLs stored files are served at from a base URL
+type publicURL string
+
+// URL returns the public URL a stored file is served at
+func (u publicURL) URL(key string) string {
+	return strings.TrimSuffix(string(u), "/") + "/" + key
+}
+
+// Key returns the key of the stored file served at a URL, if the URL is one of a stored file
+func (u publicURL) Key(url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, u.URL(""))
+	if !ok || !isValidKey(key) {
+		return "", false
+	}
+
+	return key, true
+}
+
+// isValidKey checks whether a key is a clean relative slash-separated path that stays within the storage
+func isValidKey(key string) bool {
+	return key != "" &&
+		!strings.HasPrefix(key, "/") &&
+		!strings.Contains(key, "\\") &&
+		path.Clean(key) == key &&
+		key != ".." &&
+		!strings.HasPrefix(key, "../")
+}
@@ -0,0 +1,106 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+/**
+ * LocalStorage implements port.FileStorage interface
+ * and provides an access to a directory of the local filesystem
+ */
+type LocalStorage struct {
+	publicURL
+	root string
+}
+
+// NewLocalStorage creates a new local filesystem storage instance, creating its directory if needed
+func NewLocalStorage(config *config.Storage) (*LocalStorage, error) {
+	err := os.MkdirAll(config.Path, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{
+		publicURL(config.URL),
+		config.Path,
+	}, nil
+}
+
+// Put writes a file under its key, through a temporary file so that it is never served partially written
+func (ls *LocalStorage) Put(ctx context.Context, file *domain.File) error {
+	if !isValidKey(file.Key) {
+		return domain.ErrDataNotFound
+	}
+
+	name := ls.path(file.Key)
+
+	err := os.MkdirAll(filepath.Dir(name), 0o755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(file.Content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+// Get reads a file by key, with the content type of its extension
+func (ls *LocalStorage) Get(ctx context.Context, key string) (*domain.File, error) {
+	if !isValidKey(key) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	content, err := os.ReadFile(ls.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &domain.File{
+		Key:         key,
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+		Content:     content,
+	}, nil
+}
+
+// Delete removes a file by key, if it exists
+func (ls *LocalStorage) Delete(ctx context.Context, key string) error {
+	if !isValidKey(key) {
+		return domain.ErrDataNotFound
+	}
+
+	err := os.Remove(ls.path(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// path returns the path of the file of a key on the local filesystem
+func (ls *LocalStorage) path(key string) string {
+	return filepath.Join(ls.root, filepath.FromSlash(key))
+}
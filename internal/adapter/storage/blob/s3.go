@@ -0,0 +1,106 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+/**
+ * S3Storage implements port.FileStorage interface
+ * and provides an access to a bucket of an S3-compatible object storage
+ */
+type S3Storage struct {
+	publicURL
+	client *minio.Client
+	bucket string
+}
+
+// NewS3Storage creates a new S3-compatible object storage instance, creating its bucket if needed
+func NewS3Storage(ctx context.Context, config *config.Storage) (*S3Storage, error) {
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL == "true",
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		err = client.MakeBucket(ctx, config.Bucket, minio.MakeBucketOptions{Region: config.Region})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &S3Storage{
+		publicURL(config.URL),
+		client,
+		config.Bucket,
+	}, nil
+}
+
+// Put uploads a file as an object under its key
+func (ss *S3Storage) Put(ctx context.Context, file *domain.File) error {
+	if !isValidKey(file.Key) {
+		return domain.ErrDataNotFound
+	}
+
+	_, err := ss.client.PutObject(ctx, ss.bucket, file.Key, bytes.NewReader(file.Content), int64(len(file.Content)), minio.PutObjectOptions{
+		ContentType: file.ContentType,
+	})
+
+	return err
+}
+
+// Get downloads the object of a key, with the content type it was uploaded with
+func (ss *S3Storage) Get(ctx context.Context, key string) (*domain.File, error) {
+	if !isValidKey(key) {
+		return nil, domain.ErrDataNotFound
+	}
+
+	object, err := ss.client.GetObject(ctx, ss.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	info, err := object.Stat()
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	content, err := io.ReadAll(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.File{
+		Key:         key,
+		ContentType: info.ContentType,
+		Content:     content,
+	}, nil
+}
+
+// Delete removes the object of a key, which succeeds whether it exists or not
+func (ss *S3Storage) Delete(ctx context.Context, key string) error {
+	if !isValidKey(key) {
+		return domain.ErrDataNotFound
+	}
+
+	return ss.client.RemoveObject(ctx, ss.bucket, key, minio.RemoveObjectOptions{})
+}
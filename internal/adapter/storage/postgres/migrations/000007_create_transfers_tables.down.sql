@@ -0,0 +1,46 @@
+ALTER TABLE
+    IF EXISTS "stock_movements" DROP CONSTRAINT "fk_users_stock_movements";
+
+ALTER TABLE
+    IF EXISTS "stock_movements" DROP CONSTRAINT "fk_transfers_stock_movements";
+
+ALTER TABLE
+    IF EXISTS "stock_movements" DROP CONSTRAINT "fk_products_stock_movements";
+
+ALTER TABLE
+    IF EXISTS "stock_movements" DROP CONSTRAINT "fk_locations_stock_movements";
+
+ALTER TABLE
+    IF EXISTS "transfer_items" DROP CONSTRAINT "fk_products_transfer_items";
+
+ALTER TABLE
+    IF EXISTS "transfer_items" DROP CONSTRAINT "fk_transfers_transfer_items";
+
+ALTER TABLE
+    IF EXISTS "transfers" DROP CONSTRAINT "fk_users_transfers";
+
+ALTER TABLE
+    IF EXISTS "transfers" DROP CONSTRAINT "fk_locations_transfers_to";
+
+ALTER TABLE
+    IF EXISTS "transfers" DROP CONSTRAINT "fk_locations_transfers_from";
+
+ALTER TABLE
+    IF EXISTS "location_stocks" DROP CONSTRAINT "fk_products_location_stocks";
+
+ALTER TABLE
+    IF EXISTS "location_stocks" DROP CONSTRAINT "fk_locations_location_stocks";
+
+DROP TABLE IF EXISTS "stock_movements";
+
+DROP TYPE IF EXISTS "stock_movements_type_enum";
+
+DROP TABLE IF EXISTS "transfer_items";
+
+DROP TABLE IF EXISTS "transfers";
+
+DROP TYPE IF EXISTS "transfers_status_enum";
+
+DROP TABLE IF EXISTS "location_stocks";
+
+DROP TABLE IF EXISTS "locations";
\ No newline at end of file
@@ -0,0 +1,127 @@
+CREATE TABLE "locations" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "is_default" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "location_name" ON "locations" ("name");
+
+CREATE UNIQUE INDEX "location_default" ON "locations" ("is_default")
+WHERE
+    "is_default";
+
+INSERT INTO
+    "locations" ("name", "is_default")
+VALUES
+    ('Store', true);
+
+CREATE TABLE "location_stocks" (
+    "location_id" bigint NOT NULL,
+    "product_id" bigint NOT NULL,
+    "quantity" bigint NOT NULL DEFAULT 0,
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("location_id", "product_id")
+);
+
+CREATE TYPE "transfers_status_enum" AS ENUM ('requested', 'in_transit', 'received', 'canceled');
+
+CREATE TABLE "transfers" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "from_location_id" bigint NOT NULL,
+    "to_location_id" bigint NOT NULL,
+    "user_id" bigint,
+    "status" transfers_status_enum NOT NULL DEFAULT 'requested',
+    "note" varchar NOT NULL DEFAULT '',
+    "dispatched_at" timestamptz,
+    "received_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "transfers_status" ON "transfers" ("status");
+
+CREATE TABLE "transfer_items" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "transfer_id" bigint NOT NULL,
+    "product_id" bigint NOT NULL,
+    "quantity" bigint NOT NULL,
+    "received_quantity" bigint,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "transfer_item_product" ON "transfer_items" ("transfer_id", "product_id");
+
+CREATE TYPE "stock_movements_type_enum" AS ENUM ('transfer_out', 'transfer_in');
+
+CREATE TABLE "stock_movements" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "location_id" bigint NOT NULL,
+    "product_id" bigint NOT NULL,
+    "type" stock_movements_type_enum NOT NULL,
+    "quantity" bigint NOT NULL,
+    "transfer_id" bigint,
+    "user_id" bigint,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "stock_movements_location_id_product_id" ON "stock_movements" ("location_id", "product_id");
+
+CREATE INDEX "stock_movements_transfer_id" ON "stock_movements" ("transfer_id");
+
+ALTER TABLE
+    "location_stocks"
+ADD
+    CONSTRAINT "fk_locations_location_stocks" FOREIGN KEY ("location_id") REFERENCES "locations" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "location_stocks"
+ADD
+    CONSTRAINT "fk_products_location_stocks" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "transfers"
+ADD
+    CONSTRAINT "fk_locations_transfers_from" FOREIGN KEY ("from_location_id") REFERENCES "locations" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "transfers"
+ADD
+    CONSTRAINT "fk_locations_transfers_to" FOREIGN KEY ("to_location_id") REFERENCES "locations" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "transfers"
+ADD
+    CONSTRAINT "fk_users_transfers" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "transfer_items"
+ADD
+    CONSTRAINT "fk_transfers_transfer_items" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "transfer_items"
+ADD
+    CONSTRAINT "fk_products_transfer_items" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "stock_movements"
+ADD
+    CONSTRAINT "fk_locations_stock_movements" FOREIGN KEY ("location_id") REFERENCES "locations" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "stock_movements"
+ADD
+    CONSTRAINT "fk_products_stock_movements" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "stock_movements"
+ADD
+    CONSTRAINT "fk_transfers_stock_movements" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "stock_movements"
+ADD
+    CONSTRAINT "fk_users_stock_movements" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE NO ACTION;
\ No newline at end of file
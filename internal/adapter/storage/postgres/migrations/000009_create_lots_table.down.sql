@@ -0,0 +1,4 @@
+ALTER TABLE
+    IF EXISTS "lots" DROP CONSTRAINT "fk_products_lots";
+
+DROP TABLE IF EXISTS "lots";
\ No newline at end of file
@@ -0,0 +1,20 @@
+CREATE TABLE "lots" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" bigint NOT NULL,
+    "lot_number" varchar NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "quantity" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "lots_product_id" ON "lots" ("product_id");
+
+CREATE INDEX "lots_expires_at" ON "lots" ("expires_at");
+
+CREATE UNIQUE INDEX "product_lot_number" ON "lots" ("product_id", "lot_number");
+
+ALTER TABLE
+    "lots"
+ADD
+    CONSTRAINT "fk_products_lots" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "products"
+ADD
+    COLUMN "track_serials" boolean NOT NULL DEFAULT false;
\ No newline at end of file
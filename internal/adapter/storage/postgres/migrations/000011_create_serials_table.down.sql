@@ -0,0 +1,9 @@
+ALTER TABLE
+    IF EXISTS "serials" DROP CONSTRAINT "fk_order_products_serials";
+
+ALTER TABLE
+    IF EXISTS "serials" DROP CONSTRAINT "fk_products_serials";
+
+DROP TABLE IF EXISTS "serials";
+
+DROP TYPE IF EXISTS "serials_status_enum";
\ No newline at end of file
@@ -0,0 +1,27 @@
+CREATE TYPE "serials_status_enum" AS ENUM ('in_stock', 'sold');
+
+CREATE TABLE "serials" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" bigint NOT NULL,
+    "serial_number" varchar NOT NULL,
+    "status" serials_status_enum NOT NULL DEFAULT 'in_stock',
+    "order_product_id" bigint,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "serials_serial_number" ON "serials" ("serial_number");
+
+CREATE INDEX "serials_order_product_id" ON "serials" ("order_product_id");
+
+CREATE UNIQUE INDEX "product_serial_number" ON "serials" ("product_id", "serial_number");
+
+ALTER TABLE
+    "serials"
+ADD
+    CONSTRAINT "fk_products_serials" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "serials"
+ADD
+    CONSTRAINT "fk_order_products_serials" FOREIGN KEY ("order_product_id") REFERENCES "order_products" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE
+    IF EXISTS "products" DROP CONSTRAINT "fk_products_variants";
+
+DROP INDEX IF EXISTS "product_variant_option_values";
+
+DROP INDEX IF EXISTS "barcode";
+
+DROP INDEX IF EXISTS "products_parent_id";
+
+ALTER TABLE
+    IF EXISTS "products" DROP COLUMN IF EXISTS "option_values",
+    DROP COLUMN IF EXISTS "options",
+    DROP COLUMN IF EXISTS "barcode",
+    DROP COLUMN IF EXISTS "parent_id";
\ No newline at end of file
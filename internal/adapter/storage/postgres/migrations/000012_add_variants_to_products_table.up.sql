@@ -0,0 +1,23 @@
+ALTER TABLE
+    "products"
+ADD
+    COLUMN "parent_id" bigint,
+ADD
+    COLUMN "barcode" varchar,
+ADD
+    COLUMN "options" jsonb NOT NULL DEFAULT '[]',
+ADD
+    COLUMN "option_values" jsonb NOT NULL DEFAULT '{}';
+
+CREATE INDEX "products_parent_id" ON "products" ("parent_id");
+
+CREATE UNIQUE INDEX "barcode" ON "products" ("barcode");
+
+CREATE UNIQUE INDEX "product_variant_option_values" ON "products" ("parent_id", "option_values")
+WHERE
+    "parent_id" IS NOT NULL;
+
+ALTER TABLE
+    "products"
+ADD
+    CONSTRAINT "fk_products_variants" FOREIGN KEY ("parent_id") REFERENCES "products" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE
+    IF EXISTS "products" DROP COLUMN IF EXISTS "is_bundle";
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE
+    "products"
+ADD
+    COLUMN "is_bundle" boolean NOT NULL DEFAULT false;
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE
+    IF EXISTS "bundle_components" DROP CONSTRAINT "fk_products_bundle_components";
+
+ALTER TABLE
+    IF EXISTS "bundle_components" DROP CONSTRAINT "fk_products_bundles";
+
+DROP TABLE IF EXISTS "bundle_components";
\ No newline at end of file
@@ -0,0 +1,22 @@
+CREATE TABLE "bundle_components" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "bundle_id" bigint NOT NULL,
+    "component_id" bigint NOT NULL,
+    "quantity" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "bundle_components_component_id" ON "bundle_components" ("component_id");
+
+CREATE UNIQUE INDEX "bundle_component" ON "bundle_components" ("bundle_id", "component_id");
+
+ALTER TABLE
+    "bundle_components"
+ADD
+    CONSTRAINT "fk_products_bundles" FOREIGN KEY ("bundle_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "bundle_components"
+ADD
+    CONSTRAINT "fk_products_bundle_components" FOREIGN KEY ("component_id") REFERENCES "products" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,39 @@
+ALTER TABLE
+    IF EXISTS "stock_movements"
+ALTER COLUMN
+    "quantity" TYPE bigint;
+
+ALTER TABLE
+    IF EXISTS "transfer_items"
+ALTER COLUMN
+    "received_quantity" TYPE bigint,
+ALTER COLUMN
+    "quantity" TYPE bigint;
+
+ALTER TABLE
+    IF EXISTS "location_stocks"
+ALTER COLUMN
+    "quantity" TYPE bigint;
+
+ALTER TABLE
+    IF EXISTS "bundle_components"
+ALTER COLUMN
+    "quantity" TYPE bigint;
+
+ALTER TABLE
+    IF EXISTS "lots"
+ALTER COLUMN
+    "quantity" TYPE bigint;
+
+ALTER TABLE
+    IF EXISTS "order_products" DROP COLUMN IF EXISTS "base_quantity",
+    DROP COLUMN IF EXISTS "unit",
+ALTER COLUMN
+    "quantity" TYPE bigint;
+
+ALTER TABLE
+    IF EXISTS "products" DROP COLUMN IF EXISTS "units",
+    DROP COLUMN IF EXISTS "fractional",
+    DROP COLUMN IF EXISTS "unit",
+ALTER COLUMN
+    "stock" TYPE bigint;
\ No newline at end of file
@@ -0,0 +1,58 @@
+ALTER TABLE
+    "products"
+ALTER COLUMN
+    "stock" TYPE decimal(18, 3),
+ADD
+    COLUMN "unit" varchar NOT NULL DEFAULT 'pcs',
+ADD
+    COLUMN "fractional" boolean NOT NULL DEFAULT false,
+ADD
+    COLUMN "units" jsonb NOT NULL DEFAULT '[]';
+
+ALTER TABLE
+    "order_products"
+ALTER COLUMN
+    "quantity" TYPE decimal(18, 3),
+ADD
+    COLUMN "unit" varchar NOT NULL DEFAULT 'pcs',
+ADD
+    COLUMN "base_quantity" decimal(18, 3);
+
+UPDATE
+    "order_products"
+SET
+    "base_quantity" = "quantity";
+
+ALTER TABLE
+    "order_products"
+ALTER COLUMN
+    "base_quantity"
+SET
+    NOT NULL;
+
+ALTER TABLE
+    "lots"
+ALTER COLUMN
+    "quantity" TYPE decimal(18, 3);
+
+ALTER TABLE
+    "bundle_components"
+ALTER COLUMN
+    "quantity" TYPE decimal(18, 3);
+
+ALTER TABLE
+    "location_stocks"
+ALTER COLUMN
+    "quantity" TYPE decimal(18, 3);
+
+ALTER TABLE
+    "transfer_items"
+ALTER COLUMN
+    "quantity" TYPE decimal(18, 3),
+ALTER COLUMN
+    "received_quantity" TYPE decimal(18, 3);
+
+ALTER TABLE
+    "stock_movements"
+ALTER COLUMN
+    "quantity" TYPE decimal(18, 3);
\ No newline at end of file
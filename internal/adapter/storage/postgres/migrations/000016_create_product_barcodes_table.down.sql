@@ -0,0 +1,30 @@
+ALTER TABLE
+    "products"
+ADD
+    COLUMN "barcode" varchar;
+
+UPDATE
+    "products"
+SET
+    "barcode" = (
+        SELECT
+            "code"
+        FROM
+            "product_barcodes"
+        WHERE
+            "product_barcodes"."product_id" = "products"."id"
+            AND "product_barcodes"."type" = 'standard'
+        ORDER BY
+            "product_barcodes"."id"
+        LIMIT
+            1
+    );
+
+CREATE UNIQUE INDEX "barcode" ON "products" ("barcode");
+
+ALTER TABLE
+    IF EXISTS "product_barcodes" DROP CONSTRAINT "fk_products_barcodes";
+
+DROP TABLE IF EXISTS "product_barcodes";
+
+DROP TYPE IF EXISTS "barcodes_type_enum";
\ No newline at end of file
@@ -0,0 +1,34 @@
+CREATE TYPE "barcodes_type_enum" AS ENUM ('standard', 'weight', 'price');
+
+CREATE TABLE "product_barcodes" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" bigint NOT NULL,
+    "code" varchar NOT NULL,
+    "type" barcodes_type_enum NOT NULL DEFAULT 'standard',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "product_barcodes_product_id" ON "product_barcodes" ("product_id");
+
+CREATE UNIQUE INDEX "product_barcode_code" ON "product_barcodes" ("code");
+
+ALTER TABLE
+    "product_barcodes"
+ADD
+    CONSTRAINT "fk_products_barcodes" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+INSERT INTO
+    "product_barcodes" ("product_id", "code")
+SELECT
+    "id",
+    "barcode"
+FROM
+    "products"
+WHERE
+    "barcode" IS NOT NULL;
+
+DROP INDEX IF EXISTS "barcode";
+
+ALTER TABLE
+    "products" DROP COLUMN "barcode";
\ No newline at end of file
@@ -0,0 +1,16 @@
+ALTER TABLE
+    IF EXISTS "transfer_items" DROP COLUMN IF EXISTS "unit_cost";
+
+ALTER TABLE
+    IF EXISTS "cost_layers" DROP CONSTRAINT "fk_products_cost_layers";
+
+DROP TABLE IF EXISTS "cost_layers";
+
+ALTER TABLE
+    "order_products" DROP COLUMN "cost";
+
+ALTER TABLE
+    "products" DROP COLUMN "cost",
+    DROP COLUMN "valuation";
+
+DROP TYPE IF EXISTS "valuation_method_enum";
\ No newline at end of file
@@ -0,0 +1,35 @@
+CREATE TYPE "valuation_method_enum" AS ENUM ('average', 'fifo');
+
+ALTER TABLE
+    "products"
+ADD
+    COLUMN "cost" decimal(18, 4) NOT NULL DEFAULT 0,
+ADD
+    COLUMN "valuation" valuation_method_enum NOT NULL DEFAULT 'average';
+
+ALTER TABLE
+    "order_products"
+ADD
+    COLUMN "cost" decimal(18, 2) NOT NULL DEFAULT 0;
+
+CREATE TABLE "cost_layers" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" bigint NOT NULL,
+    "quantity" decimal(18, 3) NOT NULL,
+    "remaining" decimal(18, 3) NOT NULL,
+    "unit_cost" decimal(18, 4) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "cost_layers_product_id_remaining" ON "cost_layers" ("product_id", "remaining");
+
+ALTER TABLE
+    "cost_layers"
+ADD
+    CONSTRAINT "fk_products_cost_layers" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "transfer_items"
+ADD
+    COLUMN "unit_cost" decimal(18, 4) NOT NULL DEFAULT 0;
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE
+    IF EXISTS "scheduled_prices" DROP CONSTRAINT "fk_users_scheduled_prices";
+
+ALTER TABLE
+    IF EXISTS "scheduled_prices" DROP CONSTRAINT "fk_products_scheduled_prices";
+
+ALTER TABLE
+    IF EXISTS "price_changes" DROP CONSTRAINT "fk_users_price_changes";
+
+ALTER TABLE
+    IF EXISTS "price_changes" DROP CONSTRAINT "fk_products_price_changes";
+
+DROP TABLE IF EXISTS "scheduled_prices";
+
+DROP TABLE IF EXISTS "price_changes";
\ No newline at end of file
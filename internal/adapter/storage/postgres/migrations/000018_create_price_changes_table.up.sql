@@ -0,0 +1,47 @@
+CREATE TABLE "price_changes" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" bigint NOT NULL,
+    "user_id" bigint,
+    "old_price" decimal(18,2) NOT NULL,
+    "new_price" decimal(18,2) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "price_changes_product_id" ON "price_changes" ("product_id", "created_at");
+
+CREATE TABLE "scheduled_prices" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" bigint NOT NULL,
+    "user_id" bigint,
+    "price" decimal(18,2) NOT NULL,
+    "effective_at" timestamptz NOT NULL,
+    "applied_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "scheduled_prices_product_id" ON "scheduled_prices" ("product_id");
+
+CREATE INDEX "scheduled_prices_effective_at" ON "scheduled_prices" ("effective_at")
+WHERE
+    "applied_at" IS NULL;
+
+ALTER TABLE
+    "price_changes"
+ADD
+    CONSTRAINT "fk_products_price_changes" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "price_changes"
+ADD
+    CONSTRAINT "fk_users_price_changes" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "scheduled_prices"
+ADD
+    CONSTRAINT "fk_products_scheduled_prices" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "scheduled_prices"
+ADD
+    CONSTRAINT "fk_users_scheduled_prices" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE NO ACTION;
\ No newline at end of file
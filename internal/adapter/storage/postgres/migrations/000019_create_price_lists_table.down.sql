@@ -0,0 +1,21 @@
+ALTER TABLE
+    IF EXISTS "order_products" DROP CONSTRAINT "fk_price_lists_order_products";
+
+ALTER TABLE
+    "order_products" DROP COLUMN "price_list_id",
+    DROP COLUMN "unit_price";
+
+ALTER TABLE
+    "orders" DROP COLUMN "customer_group";
+
+ALTER TABLE
+    IF EXISTS "price_list_items" DROP CONSTRAINT "fk_products_price_list_items";
+
+ALTER TABLE
+    IF EXISTS "price_list_items" DROP CONSTRAINT "fk_price_lists_items";
+
+DROP TABLE IF EXISTS "price_list_items";
+
+DROP TABLE IF EXISTS "price_lists";
+
+DROP TYPE IF EXISTS "customer_group_enum";
\ No newline at end of file
@@ -0,0 +1,63 @@
+CREATE TYPE "customer_group_enum" AS ENUM ('retail', 'wholesale', 'staff');
+
+CREATE TABLE "price_lists" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "customer_group" customer_group_enum,
+    "priority" integer NOT NULL DEFAULT 0,
+    "starts_at" timestamptz,
+    "ends_at" timestamptz,
+    "start_time" varchar(5),
+    "end_time" varchar(5),
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "price_list_name" ON "price_lists" ("name");
+
+CREATE TABLE "price_list_items" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "price_list_id" bigint NOT NULL,
+    "product_id" bigint NOT NULL,
+    "price" decimal(18, 2) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "price_list_items_product_id" ON "price_list_items" ("product_id");
+
+CREATE UNIQUE INDEX "price_list_item" ON "price_list_items" ("price_list_id", "product_id");
+
+ALTER TABLE
+    "price_list_items"
+ADD
+    CONSTRAINT "fk_price_lists_items" FOREIGN KEY ("price_list_id") REFERENCES "price_lists" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "price_list_items"
+ADD
+    CONSTRAINT "fk_products_price_list_items" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "orders"
+ADD
+    COLUMN "customer_group" customer_group_enum NOT NULL DEFAULT 'retail';
+
+ALTER TABLE
+    "order_products"
+ADD
+    COLUMN "unit_price" decimal(18, 2) NOT NULL DEFAULT 0,
+ADD
+    COLUMN "price_list_id" bigint;
+
+UPDATE
+    "order_products"
+SET
+    "unit_price" = ROUND("total_price" / "base_quantity", 2)
+WHERE
+    "base_quantity" > 0;
+
+ALTER TABLE
+    "order_products"
+ADD
+    CONSTRAINT "fk_price_lists_order_products" FOREIGN KEY ("price_list_id") REFERENCES "price_lists" ("id") ON DELETE SET NULL ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE
+    IF EXISTS "payments" DROP COLUMN IF EXISTS "logo_thumbnail";
+
+ALTER TABLE
+    IF EXISTS "products" DROP COLUMN IF EXISTS "thumbnail";
\ No newline at end of file
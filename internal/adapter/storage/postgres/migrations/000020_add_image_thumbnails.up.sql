@@ -0,0 +1,9 @@
+ALTER TABLE
+    "products"
+ADD
+    COLUMN "thumbnail" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE
+    "payments"
+ADD
+    COLUMN "logo_thumbnail" varchar NOT NULL DEFAULT '';
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE
+    IF EXISTS "categories" DROP CONSTRAINT "fk_categories_subcategories";
+
+DROP INDEX IF EXISTS "categories_parent_id";
+
+ALTER TABLE
+    IF EXISTS "categories" DROP COLUMN IF EXISTS "parent_id";
\ No newline at end of file
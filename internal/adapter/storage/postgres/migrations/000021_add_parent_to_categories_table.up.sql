@@ -0,0 +1,11 @@
+ALTER TABLE
+    "categories"
+ADD
+    COLUMN "parent_id" bigint;
+
+CREATE INDEX "categories_parent_id" ON "categories" ("parent_id");
+
+ALTER TABLE
+    "categories"
+ADD
+    CONSTRAINT "fk_categories_subcategories" FOREIGN KEY ("parent_id") REFERENCES "categories" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
\ No newline at end of file
@@ -0,0 +1,20 @@
+ALTER TABLE
+    IF EXISTS "order_product_modifiers" DROP CONSTRAINT "fk_modifiers_order_products";
+
+ALTER TABLE
+    IF EXISTS "order_product_modifiers" DROP CONSTRAINT "fk_order_products_modifiers";
+
+ALTER TABLE
+    IF EXISTS "modifiers" DROP CONSTRAINT "fk_products_modifiers";
+
+ALTER TABLE
+    IF EXISTS "modifiers" DROP CONSTRAINT "fk_modifier_groups_modifiers";
+
+ALTER TABLE
+    IF EXISTS "modifier_groups" DROP CONSTRAINT "fk_products_modifier_groups";
+
+DROP TABLE IF EXISTS "order_product_modifiers";
+
+DROP TABLE IF EXISTS "modifiers";
+
+DROP TABLE IF EXISTS "modifier_groups";
\ No newline at end of file
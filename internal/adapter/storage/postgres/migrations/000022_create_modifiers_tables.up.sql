@@ -0,0 +1,61 @@
+CREATE TABLE "modifier_groups" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" bigint NOT NULL,
+    "name" varchar NOT NULL,
+    "min_selections" integer NOT NULL DEFAULT 0,
+    "max_selections" integer NOT NULL DEFAULT 1,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "modifier_group_name" ON "modifier_groups" ("product_id", "name");
+
+CREATE TABLE "modifiers" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "group_id" bigint NOT NULL,
+    "name" varchar NOT NULL,
+    "price" decimal(18, 2) NOT NULL DEFAULT 0,
+    "ingredient_id" bigint,
+    "ingredient_quantity" decimal(18, 3) NOT NULL DEFAULT 0,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "modifier_name" ON "modifiers" ("group_id", "name");
+
+CREATE TABLE "order_product_modifiers" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "order_product_id" bigint NOT NULL,
+    "modifier_id" bigint,
+    "group_name" varchar NOT NULL,
+    "name" varchar NOT NULL,
+    "price" decimal(18, 2) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "order_product_modifiers_order_product_id" ON "order_product_modifiers" ("order_product_id");
+
+ALTER TABLE
+    "modifier_groups"
+ADD
+    CONSTRAINT "fk_products_modifier_groups" FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "modifiers"
+ADD
+    CONSTRAINT "fk_modifier_groups_modifiers" FOREIGN KEY ("group_id") REFERENCES "modifier_groups" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "modifiers"
+ADD
+    CONSTRAINT "fk_products_modifiers" FOREIGN KEY ("ingredient_id") REFERENCES "products" ("id") ON DELETE NO ACTION ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "order_product_modifiers"
+ADD
+    CONSTRAINT "fk_order_products_modifiers" FOREIGN KEY ("order_product_id") REFERENCES "order_products" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "order_product_modifiers"
+ADD
+    CONSTRAINT "fk_modifiers_order_products" FOREIGN KEY ("modifier_id") REFERENCES "modifiers" ("id") ON DELETE SET NULL ON UPDATE NO ACTION;
\ No newline at end of file
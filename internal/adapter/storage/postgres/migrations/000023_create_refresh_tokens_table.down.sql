@@ -0,0 +1,4 @@
+ALTER TABLE
+    IF EXISTS "refresh_tokens" DROP CONSTRAINT "fk_users_refresh_tokens";
+
+DROP TABLE IF EXISTS "refresh_tokens";
\ No newline at end of file
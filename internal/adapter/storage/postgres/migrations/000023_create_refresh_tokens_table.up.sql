@@ -0,0 +1,24 @@
+CREATE TABLE "refresh_tokens" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" bigint NOT NULL,
+    "family_id" uuid NOT NULL,
+    "token_hash" varchar NOT NULL,
+    "device_name" varchar NOT NULL DEFAULT '',
+    "user_agent" varchar NOT NULL DEFAULT '',
+    "ip_address" varchar NOT NULL DEFAULT '',
+    "expires_at" timestamptz NOT NULL,
+    "used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "refresh_token_hash" ON "refresh_tokens" ("token_hash");
+
+CREATE INDEX "refresh_tokens_user_id" ON "refresh_tokens" ("user_id");
+
+CREATE INDEX "refresh_tokens_family_id" ON "refresh_tokens" ("family_id");
+
+ALTER TABLE
+    "refresh_tokens"
+ADD
+    CONSTRAINT "fk_users_refresh_tokens" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
\ No newline at end of file
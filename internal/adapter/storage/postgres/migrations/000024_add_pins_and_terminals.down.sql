@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "terminals";
+
+ALTER TABLE
+    IF EXISTS "users" DROP COLUMN IF EXISTS "pin";
\ No newline at end of file
@@ -0,0 +1,16 @@
+ALTER TABLE
+    "users"
+ADD
+    COLUMN "pin" varchar;
+
+CREATE TABLE "terminals" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "credential_hash" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "terminal_name" ON "terminals" ("name");
+
+CREATE UNIQUE INDEX "terminal_credential_hash" ON "terminals" ("credential_hash");
\ No newline at end of file
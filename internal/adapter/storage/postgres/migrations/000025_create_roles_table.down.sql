@@ -0,0 +1,30 @@
+ALTER TABLE
+    IF EXISTS "users" DROP CONSTRAINT IF EXISTS "fk_roles_users";
+
+CREATE TYPE "users_role_enum" AS ENUM ('admin', 'cashier');
+
+UPDATE
+    "users"
+SET
+    "role" = 'cashier'
+WHERE
+    "role" NOT IN ('admin', 'cashier');
+
+ALTER TABLE
+    "users"
+ALTER COLUMN
+    "role" DROP DEFAULT;
+
+ALTER TABLE
+    "users"
+ALTER COLUMN
+    "role" TYPE users_role_enum USING "role"::users_role_enum;
+
+ALTER TABLE
+    "users"
+ALTER COLUMN
+    "role"
+SET
+    DEFAULT 'cashier';
+
+DROP TABLE IF EXISTS "roles";
\ No newline at end of file
@@ -0,0 +1,54 @@
+CREATE TABLE "roles" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "permissions" varchar[] NOT NULL DEFAULT '{}',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "role_name" ON "roles" ("name");
+
+INSERT INTO
+    "roles" ("name", "permissions")
+VALUES
+    (
+        'admin',
+        ARRAY [
+            'users.manage',
+            'roles.manage',
+            'terminals.manage',
+            'payments.manage',
+            'categories.manage',
+            'products.manage',
+            'products.edit_price',
+            'catalog.manage',
+            'inventory.manage',
+            'price_lists.manage',
+            'reports.view'
+        ]
+    ),
+    ('cashier', ARRAY ['auth.pin_login']);
+
+ALTER TABLE
+    "users"
+ALTER COLUMN
+    "role" DROP DEFAULT;
+
+ALTER TABLE
+    "users"
+ALTER COLUMN
+    "role" TYPE varchar USING "role"::varchar;
+
+ALTER TABLE
+    "users"
+ALTER COLUMN
+    "role"
+SET
+    DEFAULT 'cashier';
+
+DROP TYPE IF EXISTS "users_role_enum";
+
+ALTER TABLE
+    "users"
+ADD
+    CONSTRAINT "fk_roles_users" FOREIGN KEY ("role") REFERENCES "roles" ("name") ON DELETE NO ACTION ON UPDATE CASCADE;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE
+    IF EXISTS "password_reset_tokens" DROP CONSTRAINT "fk_users_password_reset_tokens";
+
+DROP TABLE IF EXISTS "password_reset_tokens";
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE
+    IF EXISTS "recovery_codes" DROP CONSTRAINT "fk_users_recovery_codes";
+
+ALTER TABLE
+    IF EXISTS "two_factors" DROP CONSTRAINT "fk_users_two_factors";
+
+DROP TABLE IF EXISTS "recovery_codes";
+
+DROP TABLE IF EXISTS "two_factors";
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE TABLE "two_factors" (
+    "user_id" bigint PRIMARY KEY,
+    "secret" varchar NOT NULL,
+    "confirmed_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "recovery_codes" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "user_id" bigint NOT NULL,
+    "code_hash" varchar NOT NULL,
+    "used_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "user_recovery_code" ON "recovery_codes" ("user_id", "code_hash");
+
+ALTER TABLE
+    "two_factors"
+ADD
+    CONSTRAINT "fk_users_two_factors" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE
+    "recovery_codes"
+ADD
+    CONSTRAINT "fk_users_recovery_codes" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE NO ACTION;
\ No newline at end of file
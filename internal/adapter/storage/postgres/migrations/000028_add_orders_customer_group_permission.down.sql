@@ -0,0 +1,4 @@
+UPDATE
+    "roles"
+SET
+    "permissions" = array_remove("permissions", 'orders.customer_group');
\ No newline at end of file
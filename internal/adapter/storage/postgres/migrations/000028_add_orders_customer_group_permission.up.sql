@@ -0,0 +1,6 @@
+UPDATE
+    "roles"
+SET
+    "permissions" = array_append("permissions", 'orders.customer_group')
+WHERE
+    "name" = 'admin';
\ No newline at end of file
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * BarcodeRepository implements port.BarcodeRepository interface
+ * and provides an access to the postgres database
+ */
+type BarcodeRepository struct {
+	db *postgres.DB
+}
+
+// NewBarcodeRepository creates a new barcode repository instance
+func NewBarcodeRepository(db *postgres.DB) *BarcodeRepository {
+	return &BarcodeRepository{
+		db,
+	}
+}
+
+// CreateBarcode creates a new barcode record in the database
+func (br *BarcodeRepository) CreateBarcode(ctx context.Context, barcode *domain.Barcode) (*domain.Barcode, error) {
+	query := br.db.QueryBuilder.Insert("product_barcodes").
+		Columns("product_id", "code", "type").
+		Values(barcode.ProductID, barcode.Code, barcode.Type).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = br.db.QueryRow(ctx, sql, args...).Scan(
+		&barcode.ID,
+		&barcode.ProductID,
+		&barcode.Code,
+		&barcode.Type,
+		&barcode.CreatedAt,
+		&barcode.UpdatedAt,
+	)
+	if err != nil {
+		if errCode := br.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return barcode, nil
+}
+
+// GetBarcodeByID retrieves a barcode record from the database by id
+func (br *BarcodeRepository) GetBarcodeByID(ctx context.Context, id uint64) (*domain.Barcode, error) {
+	query := br.db.QueryBuilder.Select("*").
+		From("product_barcodes").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	return br.getBarcode(ctx, query)
+}
+
+// GetBarcodeByCode retrieves a barcode record from the database by code
+func (br *BarcodeRepository) GetBarcodeByCode(ctx context.Context, code string) (*domain.Barcode, error) {
+	query := br.db.QueryBuilder.Select("*").
+		From("product_barcodes").
+		Where(sq.Eq{"code": code}).
+		Limit(1)
+
+	return br.getBarcode(ctx, query)
+}
+
+// getBarcode runs the given select query and scans the resulting barcode record
+func (br *BarcodeRepository) getBarcode(ctx context.Context, query sq.SelectBuilder) (*domain.Barcode, error) {
+	var barcode domain.Barcode
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = br.db.QueryRow(ctx, sql, args...).Scan(
+		&barcode.ID,
+		&barcode.ProductID,
+		&barcode.Code,
+		&barcode.Type,
+		&barcode.CreatedAt,
+		&barcode.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &barcode, nil
+}
+
+// ListBarcodes retrieves a list of barcodes of a product from the database
+func (br *BarcodeRepository) ListBarcodes(ctx context.Context, productID uint64) ([]domain.Barcode, error) {
+	var barcode domain.Barcode
+	var barcodes []domain.Barcode
+
+	query := br.db.QueryBuilder.Select("*").
+		From("product_barcodes").
+		Where(sq.Eq{"product_id": productID}).
+		OrderBy("id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := br.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&barcode.ID,
+			&barcode.ProductID,
+			&barcode.Code,
+			&barcode.Type,
+			&barcode.CreatedAt,
+			&barcode.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		barcodes = append(barcodes, barcode)
+	}
+
+	return barcodes, nil
+}
+
+// DeleteBarcode deletes a barcode record from the database by id
+func (br *BarcodeRepository) DeleteBarcode(ctx context.Context, id uint64) error {
+	query := br.db.QueryBuilder.Delete("product_barcodes").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = br.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
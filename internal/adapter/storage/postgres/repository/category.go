@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -28,8 +29,8 @@ func NewCategoryRepository(db *postgres.DB) *CategoryRepository {
 // CreateCategory creates a new category record in the database
 func (cr *CategoryRepository) CreateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
 	query := cr.db.QueryBuilder.Insert("categories").
-		Columns("name").
-		Values(category.Name).
+		Columns("name", "parent_id").
+		Values(category.Name, nullUint64(category.ParentID)).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
@@ -37,16 +38,14 @@ func (cr *CategoryRepository) CreateCategory(ctx context.Context, category *doma
 		return nil, err
 	}
 
-	err = cr.db.QueryRow(ctx, sql, args...).Scan(
-		&category.ID,
-		&category.Name,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-	)
+	err = scanCategory(cr.db.QueryRow(ctx, sql, args...), category)
 	if err != nil {
 		if errCode := cr.db.ErrorCode(err); errCode == "23505" {
 			return nil, domain.ErrConflictingData
 		}
+		if errCode := cr.db.ErrorCode(err); errCode == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
 		return nil, err
 	}
 
@@ -55,7 +54,7 @@ func (cr *CategoryRepository) CreateCategory(ctx context.Context, category *doma
 
 // GetCategoryByID retrieves a category record from the database by id
 func (cr *CategoryRepository) GetCategoryByID(ctx context.Context, id uint64) (*domain.Category, error) {
-	var category domain.Category
+	category := &domain.Category{}
 
 	query := cr.db.QueryBuilder.Select("*").
 		From("categories").
@@ -67,12 +66,32 @@ func (cr *CategoryRepository) GetCategoryByID(ctx context.Context, id uint64) (*
 		return nil, err
 	}
 
-	err = cr.db.QueryRow(ctx, sql, args...).Scan(
-		&category.ID,
-		&category.Name,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-	)
+	err = scanCategory(cr.db.QueryRow(ctx, sql, args...), category)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// GetCategoryByName retrieves a category record from the database by name
+func (cr *CategoryRepository) GetCategoryByName(ctx context.Context, name string) (*domain.Category, error) {
+	category := &domain.Category{}
+
+	query := cr.db.QueryBuilder.Select("*").
+		From("categories").
+		Where(sq.Eq{"name": name}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanCategory(cr.db.QueryRow(ctx, sql, args...), category)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, domain.ErrDataNotFound
@@ -80,7 +99,7 @@ func (cr *CategoryRepository) GetCategoryByID(ctx context.Context, id uint64) (*
 		return nil, err
 	}
 
-	return &category, nil
+	return category, nil
 }
 
 // ListCategories retrieves a list of categories from the database
@@ -105,12 +124,38 @@ func (cr *CategoryRepository) ListCategories(ctx context.Context, skip, limit ui
 	}
 
 	for rows.Next() {
-		err := rows.Scan(
-			&category.ID,
-			&category.Name,
-			&category.CreatedAt,
-			&category.UpdatedAt,
-		)
+		err := scanCategory(rows, &category)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+// ListAllCategories retrieves every category from the database ordered by name
+func (cr *CategoryRepository) ListAllCategories(ctx context.Context) ([]domain.Category, error) {
+	var category domain.Category
+	var categories []domain.Category
+
+	query := cr.db.QueryBuilder.Select("*").
+		From("categories").
+		OrderBy("name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := cr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := scanCategory(rows, &category)
 		if err != nil {
 			return nil, err
 		}
@@ -134,12 +179,7 @@ func (cr *CategoryRepository) UpdateCategory(ctx context.Context, category *doma
 		return nil, err
 	}
 
-	err = cr.db.QueryRow(ctx, sql, args...).Scan(
-		&category.ID,
-		&category.Name,
-		&category.CreatedAt,
-		&category.UpdatedAt,
-	)
+	err = scanCategory(cr.db.QueryRow(ctx, sql, args...), category)
 	if err != nil {
 		if errCode := cr.db.ErrorCode(err); errCode == "23505" {
 			return nil, domain.ErrConflictingData
@@ -150,6 +190,79 @@ func (cr *CategoryRepository) UpdateCategory(ctx context.Context, category *doma
 	return category, nil
 }
 
+// UpdateCategoryParent updates the parent of a category record in the database, unless the parent is the category
+// or one of its sub-categories. Moves are serialized, so that concurrent moves cannot both pass the check and form a cycle
+func (cr *CategoryRepository) UpdateCategoryParent(ctx context.Context, id, parentID uint64) (*domain.Category, error) {
+	category := &domain.Category{}
+
+	err := pgx.BeginFunc(ctx, cr.db, func(tx pgx.Tx) error {
+		lockQuery := cr.db.QueryBuilder.Select("pg_advisory_xact_lock('categories'::regclass::oid::bigint)")
+
+		sql, args, err := lockQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		if parentID != 0 {
+			var ancestorId uint64
+
+			cycleQuery := cr.db.QueryBuilder.Select("id").
+				Prefix(`WITH RECURSIVE ancestors AS (
+					SELECT id, parent_id FROM categories WHERE id = ?
+					UNION
+					SELECT c.id, c.parent_id FROM categories c JOIN ancestors a ON c.id = a.parent_id
+				)`, parentID).
+				From("ancestors").
+				Where(sq.Eq{"id": id})
+
+			sql, args, err := cycleQuery.ToSql()
+			if err != nil {
+				return err
+			}
+
+			err = tx.QueryRow(ctx, sql, args...).Scan(&ancestorId)
+			if err == nil {
+				return domain.ErrCategoryCycle
+			}
+			if err != pgx.ErrNoRows {
+				return err
+			}
+		}
+
+		query := cr.db.QueryBuilder.Update("categories").
+			Set("parent_id", nullUint64(parentID)).
+			Set("updated_at", time.Now()).
+			Where(sq.Eq{"id": id}).
+			Suffix("RETURNING *")
+
+		sql, args, err = query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		return scanCategory(tx.QueryRow(ctx, sql, args...), category)
+	})
+	if err != nil {
+		if err == domain.ErrCategoryCycle {
+			return nil, err
+		}
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		if errCode := cr.db.ErrorCode(err); errCode == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return category, nil
+}
+
 // DeleteCategory deletes a category record from the database by id
 func (cr *CategoryRepository) DeleteCategory(ctx context.Context, id uint64) error {
 	query := cr.db.QueryBuilder.Delete("categories").
@@ -161,9 +274,32 @@ func (cr *CategoryRepository) DeleteCategory(ctx context.Context, id uint64) err
 	}
 
 	_, err = cr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		if errCode := cr.db.ErrorCode(err); errCode == "23503" {
+			return domain.ErrConflictingData
+		}
+		return err
+	}
+
+	return nil
+}
+
+// scanCategory scans a category record, including its nullable parent id, into the given category
+func scanCategory(row pgx.Row, category *domain.Category) error {
+	var parentId sql.NullInt64
+
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&parentId,
+	)
 	if err != nil {
 		return err
 	}
 
+	category.ParentID = uint64(parentId.Int64)
+
 	return nil
 }
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// receiveStock adds a received quantity of a product to its stock within the given transaction,
+// updating its weighted average cost and recording a cost layer for FIFO valuation.
+// A zero unit cost receives the quantity at the current average cost of the product
+func receiveStock(ctx context.Context, db *postgres.DB, tx pgx.Tx, productID uint64, quantity, unitCost float64) (*domain.Product, error) {
+	var product domain.Product
+
+	averageCost := sq.Expr(
+		"CASE WHEN ?::decimal = 0 THEN cost WHEN stock <= 0 THEN ?::decimal ELSE ROUND((stock * cost + ?::decimal * ?::decimal) / (stock + ?::decimal), 4) END",
+		unitCost, unitCost, quantity, unitCost, quantity,
+	)
+
+	productQuery := db.QueryBuilder.Update("products").
+		Set("cost", averageCost).
+		Set("stock", sq.Expr("stock + ?", quantity)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": productID}).
+		Suffix("RETURNING *")
+
+	sql, args, err := productQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanProduct(tx.QueryRow(ctx, sql, args...), &product)
+	if err != nil {
+		return nil, err
+	}
+
+	if unitCost == 0 {
+		unitCost = product.Cost
+	}
+
+	layerQuery := db.QueryBuilder.Insert("cost_layers").
+		Columns("product_id", "quantity", "remaining", "unit_cost").
+		Values(productID, quantity, quantity, unitCost)
+
+	sql, args, err = layerQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// consumeCostLayers decrements the remaining quantity of the cost layers of a product in first-in-first-out order
+// within the given transaction, and returns the FIFO cost of the quantity consumed. Any quantity not covered
+// by cost layers, such as stock counted before costs were tracked, is costed at the given average cost
+func consumeCostLayers(ctx context.Context, db *postgres.DB, tx pgx.Tx, productID uint64, quantity, averageCost float64) (float64, error) {
+	var layer domain.CostLayer
+	var layers []domain.CostLayer
+	var cost float64
+
+	layersQuery := db.QueryBuilder.Select("id", "remaining", "unit_cost").
+		From("cost_layers").
+		Where(sq.Eq{"product_id": productID}).
+		Where(sq.Gt{"remaining": 0}).
+		OrderBy("id").
+		Suffix("FOR UPDATE")
+
+	sql, args, err := layersQuery.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&layer.ID,
+			&layer.Remaining,
+			&layer.UnitCost,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		layers = append(layers, layer)
+	}
+	rows.Close()
+
+	for _, layer := range layers {
+		if quantity <= 0 {
+			break
+		}
+
+		consumed := min(layer.Remaining, quantity)
+
+		layerQuery := db.QueryBuilder.Update("cost_layers").
+			Set("remaining", sq.Expr("remaining - ?", consumed)).
+			Set("updated_at", time.Now()).
+			Where(sq.Eq{"id": layer.ID})
+
+		sql, args, err := layerQuery.ToSql()
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return 0, err
+		}
+
+		cost += consumed * layer.UnitCost
+		quantity -= consumed
+	}
+
+	if quantity > 0 {
+		cost += quantity * averageCost
+	}
+
+	return cost, nil
+}
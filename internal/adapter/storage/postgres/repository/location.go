@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * LocationRepository implements port.LocationRepository interface
+ * and provides an access to the postgres database
+ */
+type LocationRepository struct {
+	db *postgres.DB
+}
+
+// NewLocationRepository creates a new location repository instance
+func NewLocationRepository(db *postgres.DB) *LocationRepository {
+	return &LocationRepository{
+		db,
+	}
+}
+
+// CreateLocation creates a new location record in the database
+func (lr *LocationRepository) CreateLocation(ctx context.Context, location *domain.Location) (*domain.Location, error) {
+	query := lr.db.QueryBuilder.Insert("locations").
+		Columns("name").
+		Values(location.Name).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = lr.db.QueryRow(ctx, sql, args...).Scan(
+		&location.ID,
+		&location.Name,
+		&location.IsDefault,
+		&location.CreatedAt,
+		&location.UpdatedAt,
+	)
+	if err != nil {
+		if errCode := lr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// GetLocationByID retrieves a location record from the database by id
+func (lr *LocationRepository) GetLocationByID(ctx context.Context, id uint64) (*domain.Location, error) {
+	var location domain.Location
+
+	query := lr.db.QueryBuilder.Select("*").
+		From("locations").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = lr.db.QueryRow(ctx, sql, args...).Scan(
+		&location.ID,
+		&location.Name,
+		&location.IsDefault,
+		&location.CreatedAt,
+		&location.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &location, nil
+}
+
+// ListLocations retrieves a list of locations from the database, the default location first
+func (lr *LocationRepository) ListLocations(ctx context.Context, skip, limit uint64) ([]domain.Location, error) {
+	var location domain.Location
+	var locations []domain.Location
+
+	query := lr.db.QueryBuilder.Select("*").
+		From("locations").
+		OrderBy("is_default DESC", "name").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := lr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&location.ID,
+			&location.Name,
+			&location.IsDefault,
+			&location.CreatedAt,
+			&location.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, location)
+	}
+
+	return locations, nil
+}
+
+// ListLocationStocks retrieves a list of the products in stock at a location from the database.
+// The stock of the default location is the stock of the products themselves
+func (lr *LocationRepository) ListLocationStocks(ctx context.Context, location *domain.Location, skip, limit uint64) ([]domain.LocationStock, error) {
+	var stock domain.LocationStock
+	var stocks []domain.LocationStock
+
+	query := lr.db.QueryBuilder.Select("product_id", "quantity").
+		From("location_stocks").
+		Where(sq.Eq{"location_id": location.ID}).
+		Where(sq.Gt{"quantity": 0}).
+		OrderBy("product_id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	if location.IsDefault {
+		query = lr.db.QueryBuilder.Select("id", "stock").
+			From("products").
+			Where(sq.Gt{"stock": 0}).
+			OrderBy("id").
+			Limit(limit).
+			Offset((skip - 1) * limit)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := lr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&stock.ProductID,
+			&stock.Quantity,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		stock.LocationID = location.ID
+		stocks = append(stocks, stock)
+	}
+
+	return stocks, nil
+}
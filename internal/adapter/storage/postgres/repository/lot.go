@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * LotRepository implements port.LotRepository interface
+ * and provides an access to the postgres database
+ */
+type LotRepository struct {
+	db *postgres.DB
+}
+
+// NewLotRepository creates a new lot repository instance
+func NewLotRepository(db *postgres.DB) *LotRepository {
+	return &LotRepository{
+		db,
+	}
+}
+
+// CreateLot creates a new lot record in the database and increments the product stock at the given unit cost
+func (lr *LotRepository) CreateLot(ctx context.Context, lot *domain.Lot, unitCost float64) (*domain.Lot, error) {
+	lotQuery := lr.db.QueryBuilder.Insert("lots").
+		Columns("product_id", "lot_number", "expires_at", "quantity").
+		Values(lot.ProductID, lot.LotNumber, lot.ExpiresAt, lot.Quantity).
+		Suffix("RETURNING *")
+
+	err := pgx.BeginFunc(ctx, lr.db, func(tx pgx.Tx) error {
+		sql, args, err := lotQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&lot.ID,
+			&lot.ProductID,
+			&lot.LotNumber,
+			&lot.ExpiresAt,
+			&lot.Quantity,
+			&lot.CreatedAt,
+			&lot.UpdatedAt,
+		)
+		if err != nil {
+			if errCode := lr.db.ErrorCode(err); errCode == "23505" {
+				return domain.ErrConflictingData
+			}
+			return err
+		}
+
+		_, err = receiveStock(ctx, lr.db, tx, lot.ProductID, lot.Quantity, unitCost)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lot, nil
+}
+
+// GetLotByID retrieves a lot record from the database by id
+func (lr *LotRepository) GetLotByID(ctx context.Context, id uint64) (*domain.Lot, error) {
+	var lot domain.Lot
+
+	query := lr.db.QueryBuilder.Select("*").
+		From("lots").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = lr.db.QueryRow(ctx, sql, args...).Scan(
+		&lot.ID,
+		&lot.ProductID,
+		&lot.LotNumber,
+		&lot.ExpiresAt,
+		&lot.Quantity,
+		&lot.CreatedAt,
+		&lot.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &lot, nil
+}
+
+// ListLots retrieves a list of lots of a product from the database
+func (lr *LotRepository) ListLots(ctx context.Context, productID, skip, limit uint64) ([]domain.Lot, error) {
+	query := lr.db.QueryBuilder.Select("*").
+		From("lots").
+		Where(sq.Eq{"product_id": productID}).
+		OrderBy("expires_at", "id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	return lr.listLots(ctx, query)
+}
+
+// ListExpiringLots retrieves a list of lots with remaining quantity that expire before the given time
+func (lr *LotRepository) ListExpiringLots(ctx context.Context, before time.Time, skip, limit uint64) ([]domain.Lot, error) {
+	query := lr.db.QueryBuilder.Select("*").
+		From("lots").
+		Where(sq.Gt{"quantity": 0}).
+		Where(sq.Lt{"expires_at": before}).
+		OrderBy("expires_at", "id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	return lr.listLots(ctx, query)
+}
+
+// listLots runs the given select query and scans the resulting lot records
+func (lr *LotRepository) listLots(ctx context.Context, query sq.SelectBuilder) ([]domain.Lot, error) {
+	var lot domain.Lot
+	var lots []domain.Lot
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := lr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&lot.ID,
+			&lot.ProductID,
+			&lot.LotNumber,
+			&lot.ExpiresAt,
+			&lot.Quantity,
+			&lot.CreatedAt,
+			&lot.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		lots = append(lots, lot)
+	}
+
+	return lots, nil
+}
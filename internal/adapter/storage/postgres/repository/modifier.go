@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * ModifierRepository implements port.ModifierRepository interface
+ * and provides an access to the postgres database
+ */
+type ModifierRepository struct {
+	db *postgres.DB
+}
+
+// NewModifierRepository creates a new modifier repository instance
+func NewModifierRepository(db *postgres.DB) *ModifierRepository {
+	return &ModifierRepository{
+		db,
+	}
+}
+
+// CreateModifierGroup creates a new modifier group record along with its modifiers in the database
+func (mr *ModifierRepository) CreateModifierGroup(ctx context.Context, group *domain.ModifierGroup) (*domain.ModifierGroup, error) {
+	query := mr.db.QueryBuilder.Insert("modifier_groups").
+		Columns("product_id", "name", "min_selections", "max_selections").
+		Values(group.ProductID, group.Name, group.MinSelections, group.MaxSelections).
+		Suffix("RETURNING id, created_at, updated_at")
+
+	err := pgx.BeginFunc(ctx, mr.db, func(tx pgx.Tx) error {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&group.ID,
+			&group.CreatedAt,
+			&group.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		for i, modifier := range group.Modifiers {
+			modifierQuery := mr.db.QueryBuilder.Insert("modifiers").
+				Columns("group_id", "name", "price", "ingredient_id", "ingredient_quantity").
+				Values(group.ID, modifier.Name, modifier.Price, nullUint64(modifier.IngredientID), modifier.IngredientQuantity).
+				Suffix("RETURNING id, group_id, created_at, updated_at")
+
+			sql, args, err := modifierQuery.ToSql()
+			if err != nil {
+				return err
+			}
+
+			err = tx.QueryRow(ctx, sql, args...).Scan(
+				&group.Modifiers[i].ID,
+				&group.Modifiers[i].GroupID,
+				&group.Modifiers[i].CreatedAt,
+				&group.Modifiers[i].UpdatedAt,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errCode := mr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		if errCode := mr.db.ErrorCode(err); errCode == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// GetModifierGroupByID retrieves a modifier group record along with its modifiers from the database by id
+func (mr *ModifierRepository) GetModifierGroupByID(ctx context.Context, id uint64) (*domain.ModifierGroup, error) {
+	query := mr.db.QueryBuilder.Select("*").
+		From("modifier_groups").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	groups, err := mr.listModifierGroups(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return &groups[0], nil
+}
+
+// ListModifierGroups retrieves the modifier groups of a product along with their modifiers from the database
+func (mr *ModifierRepository) ListModifierGroups(ctx context.Context, productID uint64) ([]domain.ModifierGroup, error) {
+	query := mr.db.QueryBuilder.Select("*").
+		From("modifier_groups").
+		Where(sq.Eq{"product_id": productID}).
+		OrderBy("id")
+
+	return mr.listModifierGroups(ctx, query)
+}
+
+// DeleteModifierGroup deletes a modifier group record along with its modifiers from the database by id
+func (mr *ModifierRepository) DeleteModifierGroup(ctx context.Context, id uint64) error {
+	query := mr.db.QueryBuilder.Delete("modifier_groups").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = mr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// listModifierGroups runs the given select query and scans the resulting modifier group records,
+// along with the modifiers of each group
+func (mr *ModifierRepository) listModifierGroups(ctx context.Context, query sq.SelectBuilder) ([]domain.ModifierGroup, error) {
+	var group domain.ModifierGroup
+	var groups []domain.ModifierGroup
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := mr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&group.ID,
+			&group.ProductID,
+			&group.Name,
+			&group.MinSelections,
+			&group.MaxSelections,
+			&group.CreatedAt,
+			&group.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, group)
+	}
+	rows.Close()
+
+	for i := range groups {
+		groups[i].Modifiers, err = mr.listModifiers(ctx, groups[i].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return groups, nil
+}
+
+// listModifiers retrieves the modifiers of a modifier group from the database
+func (mr *ModifierRepository) listModifiers(ctx context.Context, groupID uint64) ([]domain.Modifier, error) {
+	var modifier domain.Modifier
+	var modifiers []domain.Modifier
+
+	query := mr.db.QueryBuilder.Select(
+		"id",
+		"group_id",
+		"name",
+		"price",
+		"COALESCE(ingredient_id, 0)",
+		"ingredient_quantity",
+		"created_at",
+		"updated_at",
+	).
+		From("modifiers").
+		Where(sq.Eq{"group_id": groupID}).
+		OrderBy("id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := mr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&modifier.ID,
+			&modifier.GroupID,
+			&modifier.Name,
+			&modifier.Price,
+			&modifier.IngredientID,
+			&modifier.IngredientQuantity,
+			&modifier.CreatedAt,
+			&modifier.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		modifiers = append(modifiers, modifier)
+	}
+
+	return modifiers, nil
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"math"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -27,12 +29,11 @@ func NewOrderRepository(db *postgres.DB) *OrderRepository {
 
 // CreateOrder creates a new order in the database
 func (or *OrderRepository) CreateOrder(ctx context.Context, order *domain.Order) (*domain.Order, error) {
-	var product domain.Product
 	var products []domain.OrderProduct
 
 	orderQuery := or.db.QueryBuilder.Insert("orders").
-		Columns("user_id", "payment_id", "customer_name", "total_price", "total_paid", "total_return").
-		Values(order.UserID, order.PaymentID, order.CustomerName, order.TotalPrice, order.TotalPaid, order.TotalReturn).
+		Columns("user_id", "payment_id", "customer_name", "customer_group", "total_price", "total_paid", "total_return").
+		Values(order.UserID, order.PaymentID, order.CustomerName, order.CustomerGroup, order.TotalPrice, order.TotalPaid, order.TotalReturn).
 		Suffix("RETURNING *")
 
 	err := pgx.BeginFunc(ctx, or.db, func(tx pgx.Tx) error {
@@ -41,26 +42,24 @@ func (or *OrderRepository) CreateOrder(ctx context.Context, order *domain.Order)
 			return err
 		}
 
-		err = tx.QueryRow(ctx, sql, args...).Scan(
-			&order.ID,
-			&order.UserID,
-			&order.PaymentID,
-			&order.CustomerName,
-			&order.TotalPrice,
-			&order.TotalPaid,
-			&order.TotalReturn,
-			&order.ReceiptCode,
-			&order.CreatedAt,
-			&order.UpdatedAt,
-		)
+		err = scanOrder(tx.QueryRow(ctx, sql, args...), order)
 		if err != nil {
 			return err
 		}
 
 		for _, orderProduct := range order.Products {
 			orderProductQuery := or.db.QueryBuilder.Insert("order_products").
-				Columns("order_id", "product_id", "quantity", "total_price").
-				Values(order.ID, orderProduct.ProductID, orderProduct.Quantity, orderProduct.TotalPrice).
+				Columns("order_id", "product_id", "quantity", "unit_price", "total_price", "unit", "base_quantity", "price_list_id").
+				Values(
+					order.ID,
+					orderProduct.ProductID,
+					orderProduct.Quantity,
+					orderProduct.UnitPrice,
+					orderProduct.TotalPrice,
+					orderProduct.Unit,
+					orderProduct.BaseQuantity,
+					nullUint64(orderProduct.PriceListID),
+				).
 				Suffix("RETURNING *")
 
 			sql, args, err := orderProductQuery.ToSql()
@@ -68,42 +67,57 @@ func (or *OrderRepository) CreateOrder(ctx context.Context, order *domain.Order)
 				return err
 			}
 
-			err = tx.QueryRow(ctx, sql, args...).Scan(
-				&orderProduct.ID,
-				&orderProduct.OrderID,
-				&orderProduct.ProductID,
-				&orderProduct.Quantity,
-				&orderProduct.TotalPrice,
-				&orderProduct.CreatedAt,
-				&orderProduct.UpdatedAt,
-			)
+			err = scanOrderProduct(tx.QueryRow(ctx, sql, args...), &orderProduct)
 			if err != nil {
 				return err
 			}
 
-			products = append(products, orderProduct)
+			components, err := or.listBundleComponents(ctx, tx, orderProduct.ProductID)
+			if err != nil {
+				return err
+			}
+
+			if len(components) == 0 {
+				components = []domain.BundleComponent{
+					{ComponentID: orderProduct.ProductID, Quantity: 1},
+				}
+			}
+
+			var cost float64
+
+			for _, component := range components {
+				componentCost, err := or.decrementStock(ctx, tx, &orderProduct, component.ComponentID, component.Quantity*orderProduct.BaseQuantity)
+				if err != nil {
+					return err
+				}
 
-			productQuery := or.db.QueryBuilder.Update("products").
-				Set("stock", sq.Expr("stock - ?", orderProduct.Quantity)).
-				Set("updated_at", time.Now()).
-				Where(sq.Eq{"id": orderProduct.ProductID}).
-				Suffix("RETURNING stock")
+				cost += componentCost
+			}
 
-			sql, args, err = productQuery.ToSql()
+			modifiers, modifiersCost, err := or.insertModifiers(ctx, tx, &orderProduct)
 			if err != nil {
 				return err
 			}
 
-			err = tx.QueryRow(ctx, sql, args...).Scan(
-				&product.Stock,
-			)
+			orderProduct.Modifiers = modifiers
+			cost += modifiersCost
+
+			costQuery := or.db.QueryBuilder.Update("order_products").
+				Set("cost", math.Round(cost*100)/100).
+				Where(sq.Eq{"id": orderProduct.ID}).
+				Suffix("RETURNING cost")
+
+			sql, args, err = costQuery.ToSql()
 			if err != nil {
 				return err
 			}
 
-			if product.Stock < 0 {
-				return tx.Rollback(ctx)
+			err = tx.QueryRow(ctx, sql, args...).Scan(&orderProduct.Cost)
+			if err != nil {
+				return err
 			}
+
+			products = append(products, orderProduct)
 		}
 
 		order.Products = products
@@ -117,6 +131,315 @@ func (or *OrderRepository) CreateOrder(ctx context.Context, order *domain.Order)
 	return order, err
 }
 
+// insertModifiers inserts the modifiers chosen on an order product within the given transaction, decrementing
+// the stock of the ingredients they consume. It returns the inserted modifiers and the cost of the ingredients
+func (or *OrderRepository) insertModifiers(ctx context.Context, tx pgx.Tx, orderProduct *domain.OrderProduct) ([]domain.OrderModifier, float64, error) {
+	var cost float64
+	var modifiers []domain.OrderModifier
+
+	for _, modifier := range orderProduct.Modifiers {
+		modifierQuery := or.db.QueryBuilder.Insert("order_product_modifiers").
+			Columns("order_product_id", "modifier_id", "group_name", "name", "price").
+			Values(orderProduct.ID, modifier.ModifierID, modifier.GroupName, modifier.Name, modifier.Price).
+			Suffix("RETURNING id, order_product_id, created_at")
+
+		sql, args, err := modifierQuery.ToSql()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&modifier.ID,
+			&modifier.OrderProductID,
+			&modifier.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if modifier.IngredientID != 0 {
+			ingredientCost, err := or.decrementStock(ctx, tx, &domain.OrderProduct{ID: orderProduct.ID}, modifier.IngredientID, modifier.IngredientQuantity*orderProduct.BaseQuantity)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			cost += ingredientCost
+		}
+
+		modifiers = append(modifiers, modifier)
+	}
+
+	return modifiers, cost, nil
+}
+
+// listModifiers lists the modifiers chosen on an order product within the given transaction
+func (or *OrderRepository) listModifiers(ctx context.Context, tx pgx.Tx, orderProductID uint64) ([]domain.OrderModifier, error) {
+	var modifier domain.OrderModifier
+	var modifiers []domain.OrderModifier
+
+	modifiersQuery := or.db.QueryBuilder.Select("id", "order_product_id", "COALESCE(modifier_id, 0)", "group_name", "name", "price", "created_at").
+		From("order_product_modifiers").
+		Where(sq.Eq{"order_product_id": orderProductID}).
+		OrderBy("id")
+
+	sql, args, err := modifiersQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&modifier.ID,
+			&modifier.OrderProductID,
+			&modifier.ModifierID,
+			&modifier.GroupName,
+			&modifier.Name,
+			&modifier.Price,
+			&modifier.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		modifiers = append(modifiers, modifier)
+	}
+
+	return modifiers, nil
+}
+
+// listBundleComponents lists the components of a bundle product within the given transaction,
+// returning none if the product is not a bundle
+func (or *OrderRepository) listBundleComponents(ctx context.Context, tx pgx.Tx, bundleID uint64) ([]domain.BundleComponent, error) {
+	var component domain.BundleComponent
+	var components []domain.BundleComponent
+
+	componentsQuery := or.db.QueryBuilder.Select("component_id", "quantity").
+		From("bundle_components").
+		Where(sq.Eq{"bundle_id": bundleID}).
+		OrderBy("component_id")
+
+	sql, args, err := componentsQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&component.ComponentID,
+			&component.Quantity,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// decrementStock decrements the stock of a product sold on an order product within the given transaction,
+// consuming its lots and selling its serial numbers when the product is tracked by them.
+// It returns the cost of the stock sold, valued with the valuation method of the product
+func (or *OrderRepository) decrementStock(ctx context.Context, tx pgx.Tx, orderProduct *domain.OrderProduct, productID uint64, quantity float64) (float64, error) {
+	var product domain.Product
+
+	productQuery := or.db.QueryBuilder.Update("products").
+		Set("stock", sq.Expr("stock - ?", quantity)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": productID}).
+		Suffix("RETURNING stock, track_lots, track_serials, cost, valuation")
+
+	sql, args, err := productQuery.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(
+		&product.Stock,
+		&product.TrackLots,
+		&product.TrackSerials,
+		&product.Cost,
+		&product.Valuation,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if product.Stock < 0 {
+		return 0, domain.ErrInsufficientStock
+	}
+
+	if product.TrackLots {
+		err = or.consumeLots(ctx, tx, productID, quantity)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if product.TrackSerials {
+		err = or.sellSerials(ctx, tx, orderProduct)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	fifoCost, err := consumeCostLayers(ctx, or.db, tx, productID, quantity, product.Cost)
+	if err != nil {
+		return 0, err
+	}
+
+	if product.Valuation == domain.ValuationFIFO {
+		return fifoCost, nil
+	}
+
+	return quantity * product.Cost, nil
+}
+
+// consumeLots decrements the quantity of unexpired lots of a product
+// in first-expiry-first-out order within the given transaction
+func (or *OrderRepository) consumeLots(ctx context.Context, tx pgx.Tx, productID uint64, quantity float64) error {
+	var lot domain.Lot
+	var lots []domain.Lot
+
+	lotsQuery := or.db.QueryBuilder.Select("id", "quantity").
+		From("lots").
+		Where(sq.Eq{"product_id": productID}).
+		Where(sq.Gt{"quantity": 0}).
+		Where(sq.Gt{"expires_at": time.Now()}).
+		OrderBy("expires_at", "id").
+		Suffix("FOR UPDATE")
+
+	sql, args, err := lotsQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&lot.ID,
+			&lot.Quantity,
+		)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		lots = append(lots, lot)
+	}
+	rows.Close()
+
+	for _, lot := range lots {
+		if quantity == 0 {
+			break
+		}
+
+		consumed := min(lot.Quantity, quantity)
+
+		lotQuery := or.db.QueryBuilder.Update("lots").
+			Set("quantity", sq.Expr("quantity - ?", consumed)).
+			Set("updated_at", time.Now()).
+			Where(sq.Eq{"id": lot.ID})
+
+		sql, args, err := lotQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		quantity -= consumed
+	}
+
+	if quantity > 0 {
+		return domain.ErrExpiredStock
+	}
+
+	return nil
+}
+
+// sellSerials marks the serial numbers of an order product as sold within the given transaction
+func (or *OrderRepository) sellSerials(ctx context.Context, tx pgx.Tx, orderProduct *domain.OrderProduct) error {
+	serialsQuery := or.db.QueryBuilder.Update("serials").
+		Set("status", domain.SerialSold).
+		Set("order_product_id", orderProduct.ID).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"product_id":    orderProduct.ProductID,
+			"serial_number": orderProduct.SerialNumbers,
+			"status":        domain.SerialInStock,
+		})
+
+	sql, args, err := serialsQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() != int64(len(orderProduct.SerialNumbers)) {
+		return domain.ErrSerialNotInStock
+	}
+
+	return nil
+}
+
+// listSerialNumbers lists the serial numbers sold on an order product within the given transaction
+func (or *OrderRepository) listSerialNumbers(ctx context.Context, tx pgx.Tx, orderProductID uint64) ([]string, error) {
+	var serialNumber string
+	var serialNumbers []string
+
+	serialsQuery := or.db.QueryBuilder.Select("serial_number").
+		From("serials").
+		Where(sq.Eq{"order_product_id": orderProductID}).
+		OrderBy("serial_number")
+
+	sql, args, err := serialsQuery.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(&serialNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+
+	return serialNumbers, nil
+}
+
 // GetOrderByID gets an order by ID from the database
 func (or *OrderRepository) GetOrderByID(ctx context.Context, id uint64) (*domain.Order, error) {
 	var order domain.Order
@@ -138,18 +461,7 @@ func (or *OrderRepository) GetOrderByID(ctx context.Context, id uint64) (*domain
 			return err
 		}
 
-		err = tx.QueryRow(ctx, sql, args...).Scan(
-			&order.ID,
-			&order.UserID,
-			&order.PaymentID,
-			&order.CustomerName,
-			&order.TotalPrice,
-			&order.TotalPaid,
-			&order.TotalReturn,
-			&order.ReceiptCode,
-			&order.CreatedAt,
-			&order.UpdatedAt,
-		)
+		err = scanOrder(tx.QueryRow(ctx, sql, args...), &order)
 		if err != nil {
 			if err == pgx.ErrNoRows {
 				return domain.ErrDataNotFound
@@ -168,15 +480,7 @@ func (or *OrderRepository) GetOrderByID(ctx context.Context, id uint64) (*domain
 		}
 
 		for rows.Next() {
-			err = rows.Scan(
-				&orderProduct.ID,
-				&orderProduct.OrderID,
-				&orderProduct.ProductID,
-				&orderProduct.Quantity,
-				&orderProduct.TotalPrice,
-				&orderProduct.CreatedAt,
-				&orderProduct.UpdatedAt,
-			)
+			err = scanOrderProduct(rows, &orderProduct)
 			if err != nil {
 				return err
 			}
@@ -184,6 +488,22 @@ func (or *OrderRepository) GetOrderByID(ctx context.Context, id uint64) (*domain
 			order.Products = append(order.Products, orderProduct)
 		}
 
+		for i, orderProduct := range order.Products {
+			serialNumbers, err := or.listSerialNumbers(ctx, tx, orderProduct.ID)
+			if err != nil {
+				return err
+			}
+
+			order.Products[i].SerialNumbers = serialNumbers
+
+			modifiers, err := or.listModifiers(ctx, tx, orderProduct.ID)
+			if err != nil {
+				return err
+			}
+
+			order.Products[i].Modifiers = modifiers
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -217,18 +537,7 @@ func (or *OrderRepository) ListOrders(ctx context.Context, skip, limit uint64) (
 		}
 
 		for rows.Next() {
-			err := rows.Scan(
-				&order.ID,
-				&order.UserID,
-				&order.PaymentID,
-				&order.CustomerName,
-				&order.TotalPrice,
-				&order.TotalPaid,
-				&order.TotalReturn,
-				&order.ReceiptCode,
-				&order.CreatedAt,
-				&order.UpdatedAt,
-			)
+			err := scanOrder(rows, &order)
 			if err != nil {
 				return err
 			}
@@ -252,21 +561,29 @@ func (or *OrderRepository) ListOrders(ctx context.Context, skip, limit uint64) (
 			}
 
 			for rows.Next() {
-				err := rows.Scan(
-					&orderProduct.ID,
-					&orderProduct.OrderID,
-					&orderProduct.ProductID,
-					&orderProduct.Quantity,
-					&orderProduct.TotalPrice,
-					&orderProduct.CreatedAt,
-					&orderProduct.UpdatedAt,
-				)
+				err := scanOrderProduct(rows, &orderProduct)
 				if err != nil {
 					return err
 				}
 
 				orders[i].Products = append(orders[i].Products, orderProduct)
 			}
+
+			for j, orderProduct := range orders[i].Products {
+				serialNumbers, err := or.listSerialNumbers(ctx, tx, orderProduct.ID)
+				if err != nil {
+					return err
+				}
+
+				orders[i].Products[j].SerialNumbers = serialNumbers
+
+				modifiers, err := or.listModifiers(ctx, tx, orderProduct.ID)
+				if err != nil {
+					return err
+				}
+
+				orders[i].Products[j].Modifiers = modifiers
+			}
 		}
 
 		return nil
@@ -277,3 +594,47 @@ func (or *OrderRepository) ListOrders(ctx context.Context, skip, limit uint64) (
 
 	return orders, nil
 }
+
+// scanOrder scans an order record into an order
+func scanOrder(row pgx.Row, order *domain.Order) error {
+	return row.Scan(
+		&order.ID,
+		&order.UserID,
+		&order.PaymentID,
+		&order.CustomerName,
+		&order.TotalPrice,
+		&order.TotalPaid,
+		&order.TotalReturn,
+		&order.ReceiptCode,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+		&order.CustomerGroup,
+	)
+}
+
+// scanOrderProduct scans an order product record into an order product
+func scanOrderProduct(row pgx.Row, orderProduct *domain.OrderProduct) error {
+	var priceListId sql.NullInt64
+
+	err := row.Scan(
+		&orderProduct.ID,
+		&orderProduct.OrderID,
+		&orderProduct.ProductID,
+		&orderProduct.Quantity,
+		&orderProduct.TotalPrice,
+		&orderProduct.CreatedAt,
+		&orderProduct.UpdatedAt,
+		&orderProduct.Unit,
+		&orderProduct.BaseQuantity,
+		&orderProduct.Cost,
+		&orderProduct.UnitPrice,
+		&priceListId,
+	)
+	if err != nil {
+		return err
+	}
+
+	orderProduct.PriceListID = uint64(priceListId.Int64)
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * PasswordResetRepository implements port.PasswordResetRepository interface
+ * and provides an access to the postgres database
+ */
+type PasswordResetRepository struct {
+	db *postgres.DB
+}
+
+// NewPasswordResetRepository creates a new password reset repository instance
+func NewPasswordResetRepository(db *postgres.DB) *PasswordResetRepository {
+	return &PasswordResetRepository{
+		db,
+	}
+}
+
+// CreatePasswordResetToken creates a new password reset token record in the database
+func (prr *PasswordResetRepository) CreatePasswordResetToken(ctx context.Context, resetToken *domain.PasswordResetToken) (*domain.PasswordResetToken, error) {
+	query := prr.db.QueryBuilder.Insert("password_reset_tokens").
+		Columns("user_id", "token_hash", "expires_at").
+		Values(
+			resetToken.UserID,
+			resetToken.TokenHash,
+			resetToken.ExpiresAt,
+		).
+		Suffix("RETURNING id, created_at")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = prr.db.QueryRow(ctx, sql, args...).Scan(
+		&resetToken.ID,
+		&resetToken.CreatedAt,
+	)
+	if err != nil {
+		if errCode := prr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		if errCode := prr.db.ErrorCode(err); errCode == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return resetToken, nil
+}
+
+// GetPasswordResetTokenByHash retrieves a password reset token record from the database by the hash of the token
+func (prr *PasswordResetRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	var resetToken domain.PasswordResetToken
+
+	query := prr.db.QueryBuilder.Select(
+		"id",
+		"user_id",
+		"token_hash",
+		"expires_at",
+		"used_at",
+		"created_at",
+	).
+		From("password_reset_tokens").
+		Where(sq.Eq{"token_hash": tokenHash}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = prr.db.QueryRow(ctx, sql, args...).Scan(
+		&resetToken.ID,
+		&resetToken.UserID,
+		&resetToken.TokenHash,
+		&resetToken.ExpiresAt,
+		&resetToken.UsedAt,
+		&resetToken.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &resetToken, nil
+}
+
+// UsePasswordResetToken marks an unused password reset token record as used
+func (prr *PasswordResetRepository) UsePasswordResetToken(ctx context.Context, id uint64, now time.Time) error {
+	query := prr.db.QueryBuilder.Update("password_reset_tokens").
+		Set("used_at", now).
+		Where(sq.Eq{
+			"id":      id,
+			"used_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := prr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// InvalidateUserPasswordResetTokens marks the unused password reset token records of a user as used
+func (prr *PasswordResetRepository) InvalidateUserPasswordResetTokens(ctx context.Context, userID uint64, now time.Time) error {
+	query := prr.db.QueryBuilder.Update("password_reset_tokens").
+		Set("used_at", now).
+		Where(sq.Eq{
+			"user_id": userID,
+			"used_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = prr.db.Exec(ctx, sql, args...)
+	return err
+}
@@ -44,6 +44,7 @@ func (pr *PaymentRepository) CreatePayment(ctx context.Context, payment *domain.
 		&payment.Logo,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.LogoThumbnail,
 	)
 	if err != nil {
 		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
@@ -76,6 +77,7 @@ func (pr *PaymentRepository) GetPaymentByID(ctx context.Context, id uint64) (*do
 		&payment.Logo,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.LogoThumbnail,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -116,6 +118,7 @@ func (pr *PaymentRepository) ListPayments(ctx context.Context, skip, limit uint6
 			&payment.Logo,
 			&payment.CreatedAt,
 			&payment.UpdatedAt,
+			&payment.LogoThumbnail,
 		)
 		if err != nil {
 			return nil, err
@@ -137,6 +140,7 @@ func (pr *PaymentRepository) UpdatePayment(ctx context.Context, payment *domain.
 		Set("name", sq.Expr("COALESCE(?, name)", name)).
 		Set("type", sq.Expr("COALESCE(?, type)", paymentType)).
 		Set("logo", sq.Expr("COALESCE(?, logo)", logo)).
+		Set("logo_thumbnail", sq.Expr("CASE WHEN ?::varchar IS NULL THEN logo_thumbnail ELSE '' END", logo)).
 		Set("updated_at", time.Now()).
 		Where(sq.Eq{"id": payment.ID}).
 		Suffix("RETURNING *")
@@ -153,6 +157,7 @@ func (pr *PaymentRepository) UpdatePayment(ctx context.Context, payment *domain.
 		&payment.Logo,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.LogoThumbnail,
 	)
 	if err != nil {
 		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
@@ -164,6 +169,41 @@ func (pr *PaymentRepository) UpdatePayment(ctx context.Context, payment *domain.
 	return payment, nil
 }
 
+// UpdatePaymentLogo replaces the logo and logo thumbnail URLs of a payment record in the database
+func (pr *PaymentRepository) UpdatePaymentLogo(ctx context.Context, id uint64, logo, thumbnail string) (*domain.Payment, error) {
+	var payment domain.Payment
+
+	query := pr.db.QueryBuilder.Update("payments").
+		Set("logo", logo).
+		Set("logo_thumbnail", thumbnail).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = pr.db.QueryRow(ctx, sql, args...).Scan(
+		&payment.ID,
+		&payment.Name,
+		&payment.Type,
+		&payment.Logo,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+		&payment.LogoThumbnail,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
 // DeletePayment deletes a payment record from the database by id
 func (pr *PaymentRepository) DeletePayment(ctx context.Context, id uint64) error {
 	query := pr.db.QueryBuilder.Delete("payments").
@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * PriceRepository implements port.PriceRepository interface
+ * and provides an access to the postgres database
+ */
+type PriceRepository struct {
+	db *postgres.DB
+}
+
+// NewPriceRepository creates a new price repository instance
+func NewPriceRepository(db *postgres.DB) *PriceRepository {
+	return &PriceRepository{
+		db,
+	}
+}
+
+// ListPriceChanges retrieves the price history of a product from the database with pagination, newest first
+func (pr *PriceRepository) ListPriceChanges(ctx context.Context, productID, skip, limit uint64) ([]domain.PriceChange, error) {
+	var priceChange domain.PriceChange
+	var priceChanges []domain.PriceChange
+
+	query := pr.db.QueryBuilder.Select(
+		"id",
+		"product_id",
+		"COALESCE(user_id, 0)",
+		"old_price",
+		"new_price",
+		"created_at",
+	).
+		From("price_changes").
+		Where(sq.Eq{"product_id": productID}).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := pr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&priceChange.ID,
+			&priceChange.ProductID,
+			&priceChange.UserID,
+			&priceChange.OldPrice,
+			&priceChange.NewPrice,
+			&priceChange.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		priceChanges = append(priceChanges, priceChange)
+	}
+
+	return priceChanges, nil
+}
+
+// CreateScheduledPrice creates a new scheduled price record in the database
+func (pr *PriceRepository) CreateScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) (*domain.ScheduledPrice, error) {
+	query := pr.db.QueryBuilder.Insert("scheduled_prices").
+		Columns("product_id", "user_id", "price", "effective_at").
+		Values(scheduledPrice.ProductID, nullUint64(scheduledPrice.UserID), scheduledPrice.Price, scheduledPrice.EffectiveAt).
+		Suffix("RETURNING id, created_at, updated_at")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = pr.db.QueryRow(ctx, sql, args...).Scan(
+		&scheduledPrice.ID,
+		&scheduledPrice.CreatedAt,
+		&scheduledPrice.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledPrice, nil
+}
+
+// GetScheduledPriceByID retrieves a scheduled price record from the database by id
+func (pr *PriceRepository) GetScheduledPriceByID(ctx context.Context, id uint64) (*domain.ScheduledPrice, error) {
+	query := pr.scheduledPricesQuery().
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	scheduledPrices, err := pr.listScheduledPrices(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(scheduledPrices) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	return &scheduledPrices[0], nil
+}
+
+// ListScheduledPrices retrieves the scheduled prices of a product from the database, optionally only the ones not applied yet
+func (pr *PriceRepository) ListScheduledPrices(ctx context.Context, productID uint64, pending bool) ([]domain.ScheduledPrice, error) {
+	query := pr.scheduledPricesQuery().
+		Where(sq.Eq{"product_id": productID}).
+		OrderBy("effective_at", "id")
+
+	if pending {
+		query = query.Where(sq.Eq{"applied_at": nil})
+	}
+
+	return pr.listScheduledPrices(ctx, query)
+}
+
+// ListDueScheduledPrices retrieves the scheduled prices not applied yet whose effective time has been reached from the database
+func (pr *PriceRepository) ListDueScheduledPrices(ctx context.Context, now time.Time) ([]domain.ScheduledPrice, error) {
+	query := pr.scheduledPricesQuery().
+		Where(sq.Eq{"applied_at": nil}).
+		Where(sq.LtOrEq{"effective_at": now}).
+		OrderBy("effective_at", "id")
+
+	return pr.listScheduledPrices(ctx, query)
+}
+
+// ApplyScheduledPrice sets the price of a product record to a scheduled price not applied yet, marks it as applied
+// and records the price change on behalf of the user who scheduled it, within a single transaction
+func (pr *PriceRepository) ApplyScheduledPrice(ctx context.Context, id uint64, now time.Time) (*domain.Product, error) {
+	var product domain.Product
+
+	err := pgx.BeginFunc(ctx, pr.db, func(tx pgx.Tx) error {
+		var productID, userID uint64
+		var price float64
+
+		scheduleQuery := pr.db.QueryBuilder.Update("scheduled_prices").
+			Set("applied_at", now).
+			Set("updated_at", time.Now()).
+			Where(sq.Eq{"id": id}).
+			Where(sq.Eq{"applied_at": nil}).
+			Suffix("RETURNING product_id, COALESCE(user_id, 0), price")
+
+		sql, args, err := scheduleQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(&productID, &userID, &price)
+		if err != nil {
+			return err
+		}
+
+		oldPrice, err := lockProductPrice(ctx, pr.db, tx, productID)
+		if err != nil {
+			return err
+		}
+
+		productQuery := pr.db.QueryBuilder.Update("products").
+			Set("price", price).
+			Set("updated_at", time.Now()).
+			Where(sq.Eq{"id": productID}).
+			Suffix("RETURNING *")
+
+		sql, args, err = productQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = scanProduct(tx.QueryRow(ctx, sql, args...), &product)
+		if err != nil {
+			return err
+		}
+
+		if price == oldPrice {
+			return nil
+		}
+
+		return recordPriceChange(ctx, pr.db, tx, productID, userID, oldPrice, price)
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// DeleteScheduledPrice deletes a scheduled price record from the database by id
+func (pr *PriceRepository) DeleteScheduledPrice(ctx context.Context, id uint64) error {
+	query := pr.db.QueryBuilder.Delete("scheduled_prices").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = pr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// scheduledPricesQuery builds a select query for scheduled prices
+func (pr *PriceRepository) scheduledPricesQuery() sq.SelectBuilder {
+	return pr.db.QueryBuilder.Select(
+		"id",
+		"product_id",
+		"COALESCE(user_id, 0)",
+		"price",
+		"effective_at",
+		"applied_at",
+		"created_at",
+		"updated_at",
+	).
+		From("scheduled_prices")
+}
+
+// listScheduledPrices runs the given select query and scans the resulting scheduled price records
+func (pr *PriceRepository) listScheduledPrices(ctx context.Context, query sq.SelectBuilder) ([]domain.ScheduledPrice, error) {
+	var scheduledPrices []domain.ScheduledPrice
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := pr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var scheduledPrice domain.ScheduledPrice
+
+		err := rows.Scan(
+			&scheduledPrice.ID,
+			&scheduledPrice.ProductID,
+			&scheduledPrice.UserID,
+			&scheduledPrice.Price,
+			&scheduledPrice.EffectiveAt,
+			&scheduledPrice.AppliedAt,
+			&scheduledPrice.CreatedAt,
+			&scheduledPrice.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduledPrices = append(scheduledPrices, scheduledPrice)
+	}
+
+	return scheduledPrices, nil
+}
+
+// lockProductPrice selects the current price of a product within the given transaction,
+// locking the product record until the transaction ends
+func lockProductPrice(ctx context.Context, db *postgres.DB, tx pgx.Tx, productID uint64) (float64, error) {
+	var price float64
+
+	query := db.QueryBuilder.Select("price").
+		From("products").
+		Where(sq.Eq{"id": productID}).
+		Suffix("FOR UPDATE")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&price)
+	if err != nil {
+		return 0, err
+	}
+
+	return price, nil
+}
+
+// recordPriceChange inserts a price change of a product made by the given user within the given transaction.
+// A zero user id records a price change whose user is unknown
+func recordPriceChange(ctx context.Context, db *postgres.DB, tx pgx.Tx, productID, userID uint64, oldPrice, newPrice float64) error {
+	query := db.QueryBuilder.Insert("price_changes").
+		Columns("product_id", "user_id", "old_price", "new_price").
+		Values(productID, nullUint64(userID), oldPrice, newPrice)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
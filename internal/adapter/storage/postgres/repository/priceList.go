@@ -0,0 +1,335 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * PriceListRepository implements port.PriceListRepository interface
+ * and provides an access to the postgres database
+ */
+type PriceListRepository struct {
+	db *postgres.DB
+}
+
+// NewPriceListRepository creates a new price list repository instance
+func NewPriceListRepository(db *postgres.DB) *PriceListRepository {
+	return &PriceListRepository{
+		db,
+	}
+}
+
+// CreatePriceList creates a new price list record along with its items in the database
+func (plr *PriceListRepository) CreatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	query := plr.db.QueryBuilder.Insert("price_lists").
+		Columns("name", "customer_group", "priority", "starts_at", "ends_at", "start_time", "end_time").
+		Values(
+			priceList.Name,
+			nullString(string(priceList.CustomerGroup)),
+			priceList.Priority,
+			priceList.StartsAt,
+			priceList.EndsAt,
+			nullString(priceList.StartTime),
+			nullString(priceList.EndTime),
+		).
+		Suffix("RETURNING id, created_at, updated_at")
+
+	err := pgx.BeginFunc(ctx, plr.db, func(tx pgx.Tx) error {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&priceList.ID,
+			&priceList.CreatedAt,
+			&priceList.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		return plr.insertItems(ctx, tx, priceList)
+	})
+	if err != nil {
+		if errCode := plr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+// GetPriceListByID retrieves a price list record along with its items from the database by id
+func (plr *PriceListRepository) GetPriceListByID(ctx context.Context, id uint64) (*domain.PriceList, error) {
+	query := plr.priceListsQuery().
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	priceLists, err := plr.listPriceLists(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(priceLists) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	priceList := &priceLists[0]
+
+	itemsQuery := plr.db.QueryBuilder.Select("*").
+		From("price_list_items").
+		Where(sq.Eq{"price_list_id": id}).
+		OrderBy("product_id")
+
+	priceList.Items, err = plr.listItems(ctx, itemsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+// ListPriceLists retrieves a list of price lists from the database with pagination
+func (plr *PriceListRepository) ListPriceLists(ctx context.Context, skip, limit uint64) ([]domain.PriceList, error) {
+	query := plr.priceListsQuery().
+		OrderBy("id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	return plr.listPriceLists(ctx, query)
+}
+
+// ListPriceListsByProduct retrieves the price lists containing a product from the database,
+// along with the item of that product only
+func (plr *PriceListRepository) ListPriceListsByProduct(ctx context.Context, productID uint64) ([]domain.PriceList, error) {
+	query := plr.priceListsQuery().
+		Where(sq.Expr("id IN (SELECT price_list_id FROM price_list_items WHERE product_id = ?)", productID)).
+		OrderBy("id")
+
+	priceLists, err := plr.listPriceLists(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	itemsQuery := plr.db.QueryBuilder.Select("*").
+		From("price_list_items").
+		Where(sq.Eq{"product_id": productID})
+
+	items, err := plr.listItems(ctx, itemsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range priceLists {
+		for _, item := range items {
+			if item.PriceListID == priceLists[i].ID {
+				priceLists[i].Items = append(priceLists[i].Items, item)
+			}
+		}
+	}
+
+	return priceLists, nil
+}
+
+// UpdatePriceList updates a price list record and replaces its items in the database
+func (plr *PriceListRepository) UpdatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	query := plr.db.QueryBuilder.Update("price_lists").
+		Set("name", priceList.Name).
+		Set("customer_group", nullString(string(priceList.CustomerGroup))).
+		Set("priority", priceList.Priority).
+		Set("starts_at", priceList.StartsAt).
+		Set("ends_at", priceList.EndsAt).
+		Set("start_time", nullString(priceList.StartTime)).
+		Set("end_time", nullString(priceList.EndTime)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": priceList.ID}).
+		Suffix("RETURNING created_at, updated_at")
+
+	err := pgx.BeginFunc(ctx, plr.db, func(tx pgx.Tx) error {
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&priceList.CreatedAt,
+			&priceList.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		deleteQuery := plr.db.QueryBuilder.Delete("price_list_items").
+			Where(sq.Eq{"price_list_id": priceList.ID})
+
+		sql, args, err = deleteQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		return plr.insertItems(ctx, tx, priceList)
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		if errCode := plr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+// DeletePriceList deletes a price list record from the database by id
+func (plr *PriceListRepository) DeletePriceList(ctx context.Context, id uint64) error {
+	query := plr.db.QueryBuilder.Delete("price_lists").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = plr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// insertItems inserts the items of a price list within the given transaction
+func (plr *PriceListRepository) insertItems(ctx context.Context, tx pgx.Tx, priceList *domain.PriceList) error {
+	for i, item := range priceList.Items {
+		query := plr.db.QueryBuilder.Insert("price_list_items").
+			Columns("price_list_id", "product_id", "price").
+			Values(priceList.ID, item.ProductID, item.Price).
+			Suffix("RETURNING *")
+
+		sql, args, err := query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&priceList.Items[i].ID,
+			&priceList.Items[i].PriceListID,
+			&priceList.Items[i].ProductID,
+			&priceList.Items[i].Price,
+			&priceList.Items[i].CreatedAt,
+			&priceList.Items[i].UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// priceListsQuery builds a select query for price lists
+func (plr *PriceListRepository) priceListsQuery() sq.SelectBuilder {
+	return plr.db.QueryBuilder.Select(
+		"id",
+		"name",
+		"COALESCE(customer_group::text, '')",
+		"priority",
+		"starts_at",
+		"ends_at",
+		"COALESCE(start_time, '')",
+		"COALESCE(end_time, '')",
+		"created_at",
+		"updated_at",
+	).
+		From("price_lists")
+}
+
+// listPriceLists runs the given select query and scans the resulting price list records
+func (plr *PriceListRepository) listPriceLists(ctx context.Context, query sq.SelectBuilder) ([]domain.PriceList, error) {
+	var priceLists []domain.PriceList
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := plr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var priceList domain.PriceList
+
+		err := rows.Scan(
+			&priceList.ID,
+			&priceList.Name,
+			&priceList.CustomerGroup,
+			&priceList.Priority,
+			&priceList.StartsAt,
+			&priceList.EndsAt,
+			&priceList.StartTime,
+			&priceList.EndTime,
+			&priceList.CreatedAt,
+			&priceList.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		priceLists = append(priceLists, priceList)
+	}
+
+	return priceLists, nil
+}
+
+// listItems runs the given select query and scans the resulting price list item records
+func (plr *PriceListRepository) listItems(ctx context.Context, query sq.SelectBuilder) ([]domain.PriceListItem, error) {
+	var item domain.PriceListItem
+	var items []domain.PriceListItem
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := plr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&item.ID,
+			&item.PriceListID,
+			&item.ProductID,
+			&item.Price,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
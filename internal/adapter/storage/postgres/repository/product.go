@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
 	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -27,35 +29,145 @@ func NewProductRepository(db *postgres.DB) *ProductRepository {
 
 // CreateProduct creates a new product record in the database
 func (pr *ProductRepository) CreateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	err := pgx.BeginFunc(ctx, pr.db, func(tx pgx.Tx) error {
+		return pr.insertProduct(ctx, tx, product)
+	})
+	if err != nil {
+		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// insertProduct inserts a product record along with its opening cost layer, bundle components and barcodes
+// within the given transaction, generating its SKU unless it has one
+func (pr *ProductRepository) insertProduct(ctx context.Context, tx pgx.Tx, product *domain.Product) error {
+	options := product.Options
+	if options == nil {
+		options = []domain.ProductOption{}
+	}
+
+	optionValues := product.OptionValues
+	if optionValues == nil {
+		optionValues = map[string]string{}
+	}
+
+	units := product.Units
+	if units == nil {
+		units = []domain.ProductUnit{}
+	}
+
+	valuation := product.Valuation
+	if valuation == "" {
+		valuation = domain.ValuationAverage
+	}
+
+	components := product.Components
+	barcodes := product.Barcodes
+
+	sku := ""
+	if product.SKU != uuid.Nil {
+		sku = product.SKU.String()
+	}
+
 	query := pr.db.QueryBuilder.Insert("products").
-		Columns("category_id", "name", "image", "price", "stock").
-		Values(product.CategoryID, product.Name, product.Image, product.Price, product.Stock).
+		Columns("sku", "category_id", "name", "image", "price", "stock", "track_lots", "track_serials", "parent_id", "options", "option_values", "is_bundle", "unit", "fractional", "units", "cost", "valuation").
+		Values(sq.Expr("COALESCE(?::uuid, gen_random_uuid())", nullString(sku)), product.CategoryID, product.Name, product.Image, product.Price, product.Stock, product.TrackLots, product.TrackSerials, nullUint64(product.ParentID), options, optionValues, product.IsBundle, product.Unit, product.Fractional, units, product.Cost, valuation).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	err = pr.db.QueryRow(ctx, sql, args...).Scan(
-		&product.ID,
-		&product.CategoryID,
-		&product.SKU,
-		&product.Name,
-		&product.Stock,
-		&product.Price,
-		&product.Image,
-		&product.CreatedAt,
-		&product.UpdatedAt,
-	)
+	err = scanProduct(tx.QueryRow(ctx, sql, args...), product)
 	if err != nil {
-		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
-			return nil, domain.ErrConflictingData
+		return err
+	}
+
+	if product.Stock > 0 {
+		layerQuery := pr.db.QueryBuilder.Insert("cost_layers").
+			Columns("product_id", "quantity", "remaining", "unit_cost").
+			Values(product.ID, product.Stock, product.Stock, product.Cost)
+
+		sql, args, err := layerQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
 		}
-		return nil, err
 	}
 
-	return product, nil
+	product.Components = nil
+
+	for _, component := range components {
+		componentQuery := pr.db.QueryBuilder.Insert("bundle_components").
+			Columns("bundle_id", "component_id", "quantity").
+			Values(product.ID, component.ComponentID, component.Quantity).
+			Suffix("RETURNING id, bundle_id, component_id, quantity, created_at, updated_at")
+
+		sql, args, err := componentQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&component.ID,
+			&component.BundleID,
+			&component.ComponentID,
+			&component.Quantity,
+			&component.CreatedAt,
+			&component.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		product.Components = append(product.Components, component)
+	}
+
+	product.Barcodes, err = pr.insertBarcodes(ctx, tx, product.ID, barcodes)
+
+	return err
+}
+
+// insertBarcodes inserts the barcodes of a product within the given transaction
+func (pr *ProductRepository) insertBarcodes(ctx context.Context, tx pgx.Tx, productID uint64, barcodes []domain.Barcode) ([]domain.Barcode, error) {
+	var inserted []domain.Barcode
+
+	for _, barcode := range barcodes {
+		barcodeQuery := pr.db.QueryBuilder.Insert("product_barcodes").
+			Columns("product_id", "code", "type").
+			Values(productID, barcode.Code, barcode.Type).
+			Suffix("RETURNING *")
+
+		sql, args, err := barcodeQuery.ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(
+			&barcode.ID,
+			&barcode.ProductID,
+			&barcode.Code,
+			&barcode.Type,
+			&barcode.CreatedAt,
+			&barcode.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		inserted = append(inserted, barcode)
+	}
+
+	return inserted, nil
 }
 
 // GetProductByID retrieves a product record from the database by id
@@ -72,17 +184,7 @@ func (pr *ProductRepository) GetProductByID(ctx context.Context, id uint64) (*do
 		return nil, err
 	}
 
-	err = pr.db.QueryRow(ctx, sql, args...).Scan(
-		&product.ID,
-		&product.CategoryID,
-		&product.SKU,
-		&product.Name,
-		&product.Stock,
-		&product.Price,
-		&product.Image,
-		&product.CreatedAt,
-		&product.UpdatedAt,
-	)
+	err = scanProduct(pr.db.QueryRow(ctx, sql, args...), &product)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, domain.ErrDataNotFound
@@ -93,11 +195,33 @@ func (pr *ProductRepository) GetProductByID(ctx context.Context, id uint64) (*do
 	return &product, nil
 }
 
-// ListProducts retrieves a list of products from the database
-func (pr *ProductRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domain.Product, error) {
+// GetProductBySKU retrieves a product record from the database by SKU
+func (pr *ProductRepository) GetProductBySKU(ctx context.Context, sku uuid.UUID) (*domain.Product, error) {
 	var product domain.Product
-	var products []domain.Product
 
+	query := pr.db.QueryBuilder.Select("*").
+		From("products").
+		Where(sq.Eq{"sku": sku}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanProduct(pr.db.QueryRow(ctx, sql, args...), &product)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// ListProducts retrieves a list of products from the database
+func (pr *ProductRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, topLevel bool) ([]domain.Product, error) {
 	query := pr.db.QueryBuilder.Select("*").
 		From("products").
 		OrderBy("id").
@@ -105,13 +229,47 @@ func (pr *ProductRepository) ListProducts(ctx context.Context, search string, ca
 		Offset((skip - 1) * limit)
 
 	if categoryId != 0 {
-		query = query.Where(sq.Eq{"category_id": categoryId})
+		query = query.Where(sq.Expr(`category_id IN (
+			WITH RECURSIVE descendants AS (
+				SELECT id FROM categories WHERE id = ?
+				UNION
+				SELECT c.id FROM categories c JOIN descendants d ON c.parent_id = d.id
+			)
+			SELECT id FROM descendants
+		)`, categoryId))
 	}
 
 	if search != "" {
 		query = query.Where(sq.ILike{"name": "%" + search + "%"})
 	}
 
+	if topLevel {
+		query = query.Where(sq.Eq{"parent_id": nil})
+	}
+
+	return pr.listProducts(ctx, query)
+}
+
+// ListVariants retrieves a list of variants of a parent product from the database
+func (pr *ProductRepository) ListVariants(ctx context.Context, parentId uint64) ([]domain.Product, error) {
+	query := pr.db.QueryBuilder.Select("*").
+		From("products").
+		Where(sq.Eq{"parent_id": parentId}).
+		OrderBy("id")
+
+	return pr.listProducts(ctx, query)
+}
+
+// ListBundleComponents retrieves the components of a bundle product from the database
+func (pr *ProductRepository) ListBundleComponents(ctx context.Context, bundleId uint64) ([]domain.BundleComponent, error) {
+	var component domain.BundleComponent
+	var components []domain.BundleComponent
+
+	query := pr.db.QueryBuilder.Select("id", "bundle_id", "component_id", "quantity", "created_at", "updated_at").
+		From("bundle_components").
+		Where(sq.Eq{"bundle_id": bundleId}).
+		OrderBy("id")
+
 	sql, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
@@ -121,45 +279,162 @@ func (pr *ProductRepository) ListProducts(ctx context.Context, search string, ca
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		err := rows.Scan(
-			&product.ID,
-			&product.CategoryID,
-			&product.SKU,
-			&product.Name,
-			&product.Stock,
-			&product.Price,
-			&product.Image,
-			&product.CreatedAt,
-			&product.UpdatedAt,
+			&component.ID,
+			&component.BundleID,
+			&component.ComponentID,
+			&component.Quantity,
+			&component.CreatedAt,
+			&component.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// listProducts runs the given select query and scans the resulting product records
+func (pr *ProductRepository) listProducts(ctx context.Context, query sq.SelectBuilder) ([]domain.Product, error) {
+	var products []domain.Product
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := pr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var product domain.Product
+
+		err := scanProduct(rows, &product)
+		if err != nil {
+			return nil, err
+		}
+
 		products = append(products, product)
 	}
 
 	return products, nil
 }
 
-// UpdateProduct updates a product record in the database
-func (pr *ProductRepository) UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+// UpdateProduct updates a product record in the database, recording a change of its price made by the given user.
+// A change of its stock is received or consumed through its cost layers, like a stock import
+func (pr *ProductRepository) UpdateProduct(ctx context.Context, product *domain.Product, userID uint64) (*domain.Product, error) {
 	categoryId := nullUint64(product.CategoryID)
 	name := nullString(product.Name)
 	image := nullString(product.Image)
 	price := nullFloat64(product.Price)
-	stock := nullInt64(product.Stock)
+	stock := nullFloat64(product.Stock)
+	cost := nullFloat64(product.Cost)
+	valuation := nullString(string(product.Valuation))
+
+	err := pgx.BeginFunc(ctx, pr.db, func(tx pgx.Tx) error {
+		var oldPrice, oldStock, oldCost, received float64
+
+		lockQuery := pr.db.QueryBuilder.Select("price", "stock", "cost").
+			From("products").
+			Where(sq.Eq{"id": product.ID}).
+			Suffix("FOR UPDATE")
+
+		sql, args, err := lockQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(ctx, sql, args...).Scan(&oldPrice, &oldStock, &oldCost)
+		if err != nil {
+			return err
+		}
+
+		if product.Stock != 0 {
+			received = product.Stock - oldStock
+		}
+		unitCost := product.Cost
+
+		query := pr.db.QueryBuilder.Update("products").
+			Set("name", sq.Expr("COALESCE(?, name)", name)).
+			Set("category_id", sq.Expr("COALESCE(?, category_id)", categoryId)).
+			Set("image", sq.Expr("COALESCE(?, image)", image)).
+			Set("thumbnail", sq.Expr("CASE WHEN ?::varchar IS NULL THEN thumbnail ELSE '' END", image)).
+			Set("price", sq.Expr("COALESCE(?, price)", price)).
+			Set("valuation", sq.Expr("COALESCE(?::valuation_method_enum, valuation)", valuation)).
+			Set("updated_at", time.Now()).
+			Where(sq.Eq{"id": product.ID}).
+			Suffix("RETURNING *")
+
+		if received <= 0 {
+			query = query.
+				Set("stock", sq.Expr("COALESCE(?, stock)", stock)).
+				Set("cost", sq.Expr("COALESCE(?, cost)", cost))
+		}
+
+		sql, args, err = query.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = scanProduct(tx.QueryRow(ctx, sql, args...), product)
+		if err != nil {
+			return err
+		}
+
+		if received > 0 {
+			receivedProduct, err := receiveStock(ctx, pr.db, tx, product.ID, received, unitCost)
+			if err != nil {
+				return err
+			}
+
+			product.Stock = receivedProduct.Stock
+			product.Cost = receivedProduct.Cost
+			product.UpdatedAt = receivedProduct.UpdatedAt
+		}
+
+		if received < 0 {
+			_, err = consumeCostLayers(ctx, pr.db, tx, product.ID, -received, oldCost)
+			if err != nil {
+				return err
+			}
+		}
+
+		if product.Price == oldPrice {
+			return nil
+		}
+
+		return recordPriceChange(ctx, pr.db, tx, product.ID, userID, oldPrice, product.Price)
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// UpdateProductImage replaces the image and thumbnail URLs of a product record in the database
+func (pr *ProductRepository) UpdateProductImage(ctx context.Context, id uint64, image, thumbnail string) (*domain.Product, error) {
+	var product domain.Product
 
 	query := pr.db.QueryBuilder.Update("products").
-		Set("name", sq.Expr("COALESCE(?, name)", name)).
-		Set("category_id", sq.Expr("COALESCE(?, category_id)", categoryId)).
-		Set("image", sq.Expr("COALESCE(?, image)", image)).
-		Set("price", sq.Expr("COALESCE(?, price)", price)).
-		Set("stock", sq.Expr("COALESCE(?, stock)", stock)).
+		Set("image", image).
+		Set("thumbnail", thumbnail).
 		Set("updated_at", time.Now()).
-		Where(sq.Eq{"id": product.ID}).
+		Where(sq.Eq{"id": id}).
 		Suffix("RETURNING *")
 
 	sql, args, err := query.ToSql()
@@ -167,20 +442,30 @@ func (pr *ProductRepository) UpdateProduct(ctx context.Context, product *domain.
 		return nil, err
 	}
 
-	err = pr.db.QueryRow(ctx, sql, args...).Scan(
-		&product.ID,
-		&product.CategoryID,
-		&product.SKU,
-		&product.Name,
-		&product.Stock,
-		&product.Price,
-		&product.Image,
-		&product.CreatedAt,
-		&product.UpdatedAt,
-	)
+	err = scanProduct(pr.db.QueryRow(ctx, sql, args...), &product)
 	if err != nil {
-		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
-			return nil, domain.ErrConflictingData
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// IncrementStock adds a received quantity at the given unit cost to the stock of a product record in the database
+func (pr *ProductRepository) IncrementStock(ctx context.Context, id uint64, quantity, unitCost float64) (*domain.Product, error) {
+	var product *domain.Product
+
+	err := pgx.BeginFunc(ctx, pr.db, func(tx pgx.Tx) error {
+		var err error
+
+		product, err = receiveStock(ctx, pr.db, tx, id, quantity, unitCost)
+		return err
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
 		}
 		return nil, err
 	}
@@ -205,3 +490,157 @@ func (pr *ProductRepository) DeleteProduct(ctx context.Context, id uint64) error
 
 	return nil
 }
+
+// ImportProducts creates the product records without an id and updates the others in the database within
+// a single transaction, so that either every product of an import is saved or none is
+func (pr *ProductRepository) ImportProducts(ctx context.Context, products []domain.Product, userID uint64) ([]domain.Product, error) {
+	err := pgx.BeginFunc(ctx, pr.db, func(tx pgx.Tx) error {
+		for i := range products {
+			var err error
+
+			if products[i].ID == 0 {
+				err = pr.insertProduct(ctx, tx, &products[i])
+			} else {
+				err = pr.importProduct(ctx, tx, &products[i], userID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		if errCode := pr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// importProduct overwrites the catalog columns of a product record within the given transaction, adding its barcodes
+// without an id and recording a change of its price made by the given user. Unlike UpdateProduct, zero values are written.
+// A stock increase is received at the cost of the product with a new cost layer, like a receipt of stock,
+// while a stock decrease consumes the oldest cost layers and a cost without a stock increase overwrites the average cost
+func (pr *ProductRepository) importProduct(ctx context.Context, tx pgx.Tx, product *domain.Product, userID uint64) error {
+	var barcodes []domain.Barcode
+	var oldPrice, oldStock, oldCost float64
+
+	for _, barcode := range product.Barcodes {
+		if barcode.ID == 0 {
+			barcodes = append(barcodes, barcode)
+		}
+	}
+
+	lockQuery := pr.db.QueryBuilder.Select("price", "stock", "cost").
+		From("products").
+		Where(sq.Eq{"id": product.ID}).
+		Suffix("FOR UPDATE")
+
+	sql, args, err := lockQuery.ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&oldPrice, &oldStock, &oldCost)
+	if err != nil {
+		return err
+	}
+
+	received := product.Stock - oldStock
+	unitCost := product.Cost
+
+	query := pr.db.QueryBuilder.Update("products").
+		Set("name", product.Name).
+		Set("category_id", product.CategoryID).
+		Set("price", product.Price).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": product.ID}).
+		Suffix("RETURNING *")
+
+	if received <= 0 {
+		query = query.
+			Set("stock", product.Stock).
+			Set("cost", product.Cost)
+	}
+
+	sql, args, err = query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = scanProduct(tx.QueryRow(ctx, sql, args...), product)
+	if err != nil {
+		return err
+	}
+
+	if received > 0 {
+		receivedProduct, err := receiveStock(ctx, pr.db, tx, product.ID, received, unitCost)
+		if err != nil {
+			return err
+		}
+
+		product.Stock = receivedProduct.Stock
+		product.Cost = receivedProduct.Cost
+		product.UpdatedAt = receivedProduct.UpdatedAt
+	}
+
+	if received < 0 {
+		_, err = consumeCostLayers(ctx, pr.db, tx, product.ID, -received, oldCost)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = pr.insertBarcodes(ctx, tx, product.ID, barcodes)
+	if err != nil {
+		return err
+	}
+
+	if product.Price == oldPrice {
+		return nil
+	}
+
+	return recordPriceChange(ctx, pr.db, tx, product.ID, userID, oldPrice, product.Price)
+}
+
+// scanProduct scans a product record, including its nullable columns, into the given product
+func scanProduct(row pgx.Row, product *domain.Product) error {
+	var parentId sql.NullInt64
+
+	err := row.Scan(
+		&product.ID,
+		&product.CategoryID,
+		&product.SKU,
+		&product.Name,
+		&product.Stock,
+		&product.Price,
+		&product.Image,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+		&product.TrackLots,
+		&product.TrackSerials,
+		&parentId,
+		&product.Options,
+		&product.OptionValues,
+		&product.IsBundle,
+		&product.Unit,
+		&product.Fractional,
+		&product.Units,
+		&product.Cost,
+		&product.Valuation,
+		&product.Thumbnail,
+	)
+	if err != nil {
+		return err
+	}
+
+	product.ParentID = uint64(parentId.Int64)
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * RefreshTokenRepository implements port.RefreshTokenRepository interface
+ * and provides an access to the postgres database
+ */
+type RefreshTokenRepository struct {
+	db *postgres.DB
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository instance
+func NewRefreshTokenRepository(db *postgres.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		db,
+	}
+}
+
+// CreateRefreshToken creates a new refresh token record in the database
+func (rtr *RefreshTokenRepository) CreateRefreshToken(ctx context.Context, refreshToken *domain.RefreshToken) (*domain.RefreshToken, error) {
+	query := rtr.db.QueryBuilder.Insert("refresh_tokens").
+		Columns("user_id", "family_id", "token_hash", "device_name", "user_agent", "ip_address", "expires_at").
+		Values(
+			refreshToken.UserID,
+			refreshToken.FamilyID,
+			refreshToken.TokenHash,
+			refreshToken.Device.Name,
+			refreshToken.Device.UserAgent,
+			refreshToken.Device.IPAddress,
+			refreshToken.ExpiresAt,
+		).
+		Suffix("RETURNING id, created_at")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = rtr.db.QueryRow(ctx, sql, args...).Scan(
+		&refreshToken.ID,
+		&refreshToken.CreatedAt,
+	)
+	if err != nil {
+		if errCode := rtr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		if errCode := rtr.db.ErrorCode(err); errCode == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return refreshToken, nil
+}
+
+// GetRefreshTokenByHash retrieves a refresh token record from the database by the hash of the token
+func (rtr *RefreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var refreshToken domain.RefreshToken
+
+	query := rtr.db.QueryBuilder.Select(
+		"id",
+		"user_id",
+		"family_id",
+		"token_hash",
+		"device_name",
+		"user_agent",
+		"ip_address",
+		"expires_at",
+		"used_at",
+		"revoked_at",
+		"created_at",
+	).
+		From("refresh_tokens").
+		Where(sq.Eq{"token_hash": tokenHash}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = rtr.db.QueryRow(ctx, sql, args...).Scan(
+		&refreshToken.ID,
+		&refreshToken.UserID,
+		&refreshToken.FamilyID,
+		&refreshToken.TokenHash,
+		&refreshToken.Device.Name,
+		&refreshToken.Device.UserAgent,
+		&refreshToken.Device.IPAddress,
+		&refreshToken.ExpiresAt,
+		&refreshToken.UsedAt,
+		&refreshToken.RevokedAt,
+		&refreshToken.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+// UseRefreshToken marks a refresh token record neither used nor revoked as used
+func (rtr *RefreshTokenRepository) UseRefreshToken(ctx context.Context, id uint64, now time.Time) error {
+	query := rtr.db.QueryBuilder.Update("refresh_tokens").
+		Set("used_at", now).
+		Where(sq.Eq{
+			"id":         id,
+			"used_at":    nil,
+			"revoked_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := rtr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily marks the refresh token records of a family not revoked yet as revoked
+func (rtr *RefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error {
+	query := rtr.db.QueryBuilder.Update("refresh_tokens").
+		Set("revoked_at", now).
+		Where(sq.Eq{
+			"family_id":  familyID,
+			"revoked_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = rtr.db.Exec(ctx, sql, args...)
+	return err
+}
+
+// RevokeUserRefreshTokens marks the refresh token records of a user not revoked yet as revoked
+func (rtr *RefreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID uint64, now time.Time) error {
+	query := rtr.db.QueryBuilder.Update("refresh_tokens").
+		Set("revoked_at", now).
+		Where(sq.Eq{
+			"user_id":    userID,
+			"revoked_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = rtr.db.Exec(ctx, sql, args...)
+	return err
+}
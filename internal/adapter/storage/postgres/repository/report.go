@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+/**
+ * ReportRepository implements port.ReportRepository interface
+ * and provides an access to the postgres database
+ */
+type ReportRepository struct {
+	db *postgres.DB
+}
+
+// NewReportRepository creates a new report repository instance
+func NewReportRepository(db *postgres.DB) *ReportRepository {
+	return &ReportRepository{
+		db,
+	}
+}
+
+// ListProductMargins retrieves the quantity sold, revenue and cost of the products sold
+// on orders created within the given period from the database
+func (rr *ReportRepository) ListProductMargins(ctx context.Context, from, to time.Time) ([]domain.ProductMargin, error) {
+	var margin domain.ProductMargin
+	var margins []domain.ProductMargin
+
+	query := rr.db.QueryBuilder.Select(
+		"order_products.product_id",
+		"products.name",
+		"SUM(order_products.base_quantity)",
+		"SUM(order_products.total_price)",
+		"SUM(order_products.cost)",
+	).
+		From("order_products").
+		Join("orders ON orders.id = order_products.order_id").
+		Join("products ON products.id = order_products.product_id").
+		Where(sq.GtOrEq{"orders.created_at": from}).
+		Where(sq.Lt{"orders.created_at": to}).
+		GroupBy("order_products.product_id", "products.name").
+		OrderBy("order_products.product_id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&margin.ProductID,
+			&margin.Name,
+			&margin.Quantity,
+			&margin.Revenue,
+			&margin.Cost,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		margins = append(margins, margin)
+	}
+
+	return margins, nil
+}
+
+// ListStockValuations retrieves the stock on hand of the products that hold stock from the database,
+// along with the quantity and value of the most recently received cost layers that make up that stock
+func (rr *ReportRepository) ListStockValuations(ctx context.Context) ([]domain.StockValuation, error) {
+	var valuation domain.StockValuation
+	var valuations []domain.StockValuation
+
+	query := rr.db.QueryBuilder.Select(
+		"products.id",
+		"products.name",
+		"products.stock",
+		"products.unit",
+		"products.valuation",
+		"products.cost",
+		"COALESCE(layers.quantity, 0)",
+		"COALESCE(layers.value, 0)",
+	).
+		From("products").
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT SUM(newest.taken) AS quantity, SUM(newest.taken * newest.unit_cost) AS value
+			FROM (
+				SELECT unit_cost, LEAST(remaining, GREATEST(products.stock - (SUM(remaining) OVER (ORDER BY id DESC) - remaining), 0)) AS taken
+				FROM cost_layers
+				WHERE product_id = products.id AND remaining > 0
+			) newest
+		) layers ON true`).
+		Where(sq.Eq{"products.is_bundle": false}).
+		Where(sq.Expr("products.options = '[]'::jsonb")).
+		OrderBy("products.id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&valuation.ProductID,
+			&valuation.Name,
+			&valuation.Stock,
+			&valuation.Unit,
+			&valuation.Method,
+			&valuation.AverageCost,
+			&valuation.LayeredQuantity,
+			&valuation.LayeredValue,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		valuations = append(valuations, valuation)
+	}
+
+	return valuations, nil
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * RoleRepository implements port.RoleRepository interface
+ * and provides an access to the postgres database
+ */
+type RoleRepository struct {
+	db *postgres.DB
+}
+
+// NewRoleRepository creates a new role repository instance
+func NewRoleRepository(db *postgres.DB) *RoleRepository {
+	return &RoleRepository{
+		db,
+	}
+}
+
+// CreateRole creates a new role record in the database
+func (rr *RoleRepository) CreateRole(ctx context.Context, role *domain.Role) (*domain.Role, error) {
+	query := rr.db.QueryBuilder.Insert("roles").
+		Columns("name", "permissions").
+		Values(role.Name, permissionsToStrings(role.Permissions)).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanRole(rr.db.QueryRow(ctx, sql, args...), role)
+	if err != nil {
+		if errCode := rr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// GetRoleByID retrieves a role record from the database by id
+func (rr *RoleRepository) GetRoleByID(ctx context.Context, id uint64) (*domain.Role, error) {
+	var role domain.Role
+
+	query := rr.db.QueryBuilder.Select("*").
+		From("roles").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanRole(rr.db.QueryRow(ctx, sql, args...), &role)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// GetRoleByName retrieves a role record from the database by name
+func (rr *RoleRepository) GetRoleByName(ctx context.Context, name domain.UserRole) (*domain.Role, error) {
+	var role domain.Role
+
+	query := rr.db.QueryBuilder.Select("*").
+		From("roles").
+		Where(sq.Eq{"name": name}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanRole(rr.db.QueryRow(ctx, sql, args...), &role)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// ListRoles retrieves all role records from the database
+func (rr *RoleRepository) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	var roles []domain.Role
+
+	query := rr.db.QueryBuilder.Select("*").
+		From("roles").
+		OrderBy("name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := rr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var role domain.Role
+
+		err := scanRole(rows, &role)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// UpdateRole updates the name and permissions of a role record in the database.
+// Users given the role follow a rename, as the name is referenced with ON UPDATE CASCADE
+func (rr *RoleRepository) UpdateRole(ctx context.Context, role *domain.Role) (*domain.Role, error) {
+	query := rr.db.QueryBuilder.Update("roles").
+		Set("name", role.Name).
+		Set("permissions", permissionsToStrings(role.Permissions)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": role.ID}).
+		Suffix("RETURNING *")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanRole(rr.db.QueryRow(ctx, sql, args...), role)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		if errCode := rr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// DeleteRole deletes a role record from the database by id
+func (rr *RoleRepository) DeleteRole(ctx context.Context, id uint64) error {
+	query := rr.db.QueryBuilder.Delete("roles").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = rr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		if errCode := rr.db.ErrorCode(err); errCode == "23503" {
+			return domain.ErrConflictingData
+		}
+		return err
+	}
+
+	return nil
+}
+
+// scanRole scans a role record, including its array of permissions, into the given role
+func scanRole(row pgx.Row, role *domain.Role) error {
+	var permissions []string
+
+	err := row.Scan(
+		&role.ID,
+		&role.Name,
+		&permissions,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	role.Permissions = make([]domain.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		role.Permissions = append(role.Permissions, domain.Permission(permission))
+	}
+
+	return nil
+}
+
+// permissionsToStrings converts permissions to strings, so they are sent as a postgres array
+func permissionsToStrings(permissions []domain.Permission) []string {
+	strs := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		strs = append(strs, string(permission))
+	}
+
+	return strs
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * SerialRepository implements port.SerialRepository interface
+ * and provides an access to the postgres database
+ */
+type SerialRepository struct {
+	db *postgres.DB
+}
+
+// NewSerialRepository creates a new serial repository instance
+func NewSerialRepository(db *postgres.DB) *SerialRepository {
+	return &SerialRepository{
+		db,
+	}
+}
+
+// CreateSerials creates new serial records of a product in the database and increments the product stock at the given unit cost
+func (sr *SerialRepository) CreateSerials(ctx context.Context, productID uint64, serialNumbers []string, unitCost float64) ([]domain.Serial, error) {
+	var serial domain.Serial
+	var serials []domain.Serial
+
+	serialsQuery := sr.db.QueryBuilder.Insert("serials").
+		Columns("product_id", "serial_number").
+		Suffix("RETURNING id, product_id, serial_number, status, created_at, updated_at")
+
+	for _, serialNumber := range serialNumbers {
+		serialsQuery = serialsQuery.Values(productID, serialNumber)
+	}
+
+	err := pgx.BeginFunc(ctx, sr.db, func(tx pgx.Tx) error {
+		sql, args, err := serialsQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		for rows.Next() {
+			err := rows.Scan(
+				&serial.ID,
+				&serial.ProductID,
+				&serial.SerialNumber,
+				&serial.Status,
+				&serial.CreatedAt,
+				&serial.UpdatedAt,
+			)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+
+			serials = append(serials, serial)
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			if errCode := sr.db.ErrorCode(err); errCode == "23505" {
+				return domain.ErrConflictingData
+			}
+			return err
+		}
+
+		_, err = receiveStock(ctx, sr.db, tx, productID, float64(len(serialNumbers)), unitCost)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serials, nil
+}
+
+// ListSerials retrieves a list of serials of a product from the database
+func (sr *SerialRepository) ListSerials(ctx context.Context, productID uint64, status domain.SerialStatus, skip, limit uint64) ([]domain.Serial, error) {
+	query := sr.serialsQuery().
+		Where(sq.Eq{"s.product_id": productID}).
+		OrderBy("s.id").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	if status != "" {
+		query = query.Where(sq.Eq{"s.status": status})
+	}
+
+	return sr.listSerials(ctx, query)
+}
+
+// ListSerialsBySerialNumber retrieves a list of serials with the given serial number from the database
+func (sr *SerialRepository) ListSerialsBySerialNumber(ctx context.Context, serialNumber string) ([]domain.Serial, error) {
+	query := sr.serialsQuery().
+		Where(sq.Eq{"s.serial_number": serialNumber}).
+		OrderBy("s.id")
+
+	return sr.listSerials(ctx, query)
+}
+
+// serialsQuery builds a select query for serials along with the order id of the order product they were sold on
+func (sr *SerialRepository) serialsQuery() sq.SelectBuilder {
+	return sr.db.QueryBuilder.Select(
+		"s.id",
+		"s.product_id",
+		"s.serial_number",
+		"s.status",
+		"COALESCE(s.order_product_id, 0)",
+		"COALESCE(op.order_id, 0)",
+		"s.created_at",
+		"s.updated_at",
+	).
+		From("serials s").
+		LeftJoin("order_products op ON op.id = s.order_product_id")
+}
+
+// listSerials runs the given select query and scans the resulting serial records
+func (sr *SerialRepository) listSerials(ctx context.Context, query sq.SelectBuilder) ([]domain.Serial, error) {
+	var serial domain.Serial
+	var serials []domain.Serial
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := sr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&serial.ID,
+			&serial.ProductID,
+			&serial.SerialNumber,
+			&serial.Status,
+			&serial.OrderProductID,
+			&serial.OrderID,
+			&serial.CreatedAt,
+			&serial.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		serials = append(serials, serial)
+	}
+
+	return serials, nil
+}
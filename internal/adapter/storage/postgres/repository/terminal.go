@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * TerminalRepository implements port.TerminalRepository interface
+ * and provides an access to the postgres database
+ */
+type TerminalRepository struct {
+	db *postgres.DB
+}
+
+// NewTerminalRepository creates a new terminal repository instance
+func NewTerminalRepository(db *postgres.DB) *TerminalRepository {
+	return &TerminalRepository{
+		db,
+	}
+}
+
+// CreateTerminal creates a new terminal record in the database
+func (tr *TerminalRepository) CreateTerminal(ctx context.Context, terminal *domain.Terminal) (*domain.Terminal, error) {
+	query := tr.db.QueryBuilder.Insert("terminals").
+		Columns("name", "credential_hash").
+		Values(terminal.Name, terminal.CredentialHash).
+		Suffix("RETURNING id, created_at, updated_at")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tr.db.QueryRow(ctx, sql, args...).Scan(
+		&terminal.ID,
+		&terminal.CreatedAt,
+		&terminal.UpdatedAt,
+	)
+	if err != nil {
+		if errCode := tr.db.ErrorCode(err); errCode == "23505" {
+			return nil, domain.ErrConflictingData
+		}
+		return nil, err
+	}
+
+	return terminal, nil
+}
+
+// GetTerminalByCredentialHash retrieves a terminal record from the database by the hash of its credential
+func (tr *TerminalRepository) GetTerminalByCredentialHash(ctx context.Context, credentialHash string) (*domain.Terminal, error) {
+	var terminal domain.Terminal
+
+	query := tr.db.QueryBuilder.Select("id", "name", "credential_hash", "created_at", "updated_at").
+		From("terminals").
+		Where(sq.Eq{"credential_hash": credentialHash}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tr.db.QueryRow(ctx, sql, args...).Scan(
+		&terminal.ID,
+		&terminal.Name,
+		&terminal.CredentialHash,
+		&terminal.CreatedAt,
+		&terminal.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &terminal, nil
+}
+
+// ListTerminals retrieves all terminal records from the database
+func (tr *TerminalRepository) ListTerminals(ctx context.Context) ([]domain.Terminal, error) {
+	var terminal domain.Terminal
+	var terminals []domain.Terminal
+
+	query := tr.db.QueryBuilder.Select("id", "name", "credential_hash", "created_at", "updated_at").
+		From("terminals").
+		OrderBy("name")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := rows.Scan(
+			&terminal.ID,
+			&terminal.Name,
+			&terminal.CredentialHash,
+			&terminal.CreatedAt,
+			&terminal.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		terminals = append(terminals, terminal)
+	}
+
+	return terminals, nil
+}
+
+// DeleteTerminal deletes a terminal record from the database by id
+func (tr *TerminalRepository) DeleteTerminal(ctx context.Context, id uint64) error {
+	query := tr.db.QueryBuilder.Delete("terminals").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,613 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * TransferRepository implements port.TransferRepository interface
+ * and provides an access to the postgres database
+ */
+type TransferRepository struct {
+	db *postgres.DB
+}
+
+// NewTransferRepository creates a new transfer repository instance
+func NewTransferRepository(db *postgres.DB) *TransferRepository {
+	return &TransferRepository{
+		db,
+	}
+}
+
+// CreateTransfer creates a new requested transfer and its items in the database
+func (tr *TransferRepository) CreateTransfer(ctx context.Context, transfer *domain.Transfer) (*domain.Transfer, error) {
+	var items []domain.TransferItem
+
+	transferQuery := tr.db.QueryBuilder.Insert("transfers").
+		Columns("from_location_id", "to_location_id", "user_id", "note").
+		Values(transfer.FromLocationID, transfer.ToLocationID, nullUint64(transfer.UserID), transfer.Note).
+		Suffix("RETURNING *")
+
+	err := pgx.BeginFunc(ctx, tr.db, func(tx pgx.Tx) error {
+		sql, args, err := transferQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		err = scanTransfer(tx.QueryRow(ctx, sql, args...), transfer)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range transfer.Items {
+			itemQuery := tr.db.QueryBuilder.Insert("transfer_items").
+				Columns("transfer_id", "product_id", "quantity").
+				Values(transfer.ID, item.ProductID, item.Quantity).
+				Suffix("RETURNING *")
+
+			sql, args, err := itemQuery.ToSql()
+			if err != nil {
+				return err
+			}
+
+			err = scanTransferItem(tx.QueryRow(ctx, sql, args...), &item)
+			if err != nil {
+				return err
+			}
+
+			items = append(items, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfer.Items = items
+
+	return transfer, nil
+}
+
+// GetTransferByID retrieves a transfer record, its items and its stock movements from the database by id
+func (tr *TransferRepository) GetTransferByID(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	var transfer domain.Transfer
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("transfers").
+		Where(sq.Eq{"id": id}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanTransfer(tr.db.QueryRow(ctx, sql, args...), &transfer)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	err = pgx.BeginFunc(ctx, tr.db, func(tx pgx.Tx) error {
+		transfer.Items, err = tr.listTransferItems(ctx, tx, transfer.ID)
+		if err != nil {
+			return err
+		}
+
+		transfer.Movements, err = tr.listStockMovements(ctx, tx, transfer.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// ListTransfers retrieves a list of transfers, of any status if it is empty, from the database, the latest first
+func (tr *TransferRepository) ListTransfers(ctx context.Context, status domain.TransferStatus, skip, limit uint64) ([]domain.Transfer, error) {
+	var transfer domain.Transfer
+	var transfers []domain.Transfer
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("transfers").
+		OrderBy("id DESC").
+		Limit(limit).
+		Offset((skip - 1) * limit)
+
+	if status != "" {
+		query = query.Where(sq.Eq{"status": status})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tr.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		err := scanTransfer(rows, &transfer)
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
+
+// DispatchTransfer takes the items of a requested transfer out of the stock of its source location in a transaction,
+// recording a stock movement for each, and puts the transfer in transit.
+// Stock leaving the default location consumes its cost layers, and its unit cost is kept on the item
+// so that it is received at the same cost
+func (tr *TransferRepository) DispatchTransfer(ctx context.Context, id, userID uint64) (*domain.Transfer, error) {
+	err := pgx.BeginFunc(ctx, tr.db, func(tx pgx.Tx) error {
+		transfer, err := tr.lockTransfer(ctx, tx, id, domain.TransferRequested)
+		if err != nil {
+			return err
+		}
+
+		fromDefault, err := tr.isDefaultLocation(ctx, tx, transfer.FromLocationID)
+		if err != nil {
+			return err
+		}
+
+		items, err := tr.listTransferItems(ctx, tx, transfer.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			var unitCost float64
+
+			if fromDefault {
+				unitCost, err = tr.decrementProductStock(ctx, tx, item.ProductID, item.Quantity)
+			} else {
+				err = tr.decrementLocationStock(ctx, tx, transfer.FromLocationID, item.ProductID, item.Quantity)
+			}
+			if err != nil {
+				return err
+			}
+
+			itemQuery := tr.db.QueryBuilder.Update("transfer_items").
+				Set("unit_cost", unitCost).
+				Set("updated_at", time.Now()).
+				Where(sq.Eq{"id": item.ID})
+
+			sql, args, err := itemQuery.ToSql()
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return err
+			}
+
+			err = tr.insertStockMovement(ctx, tx, &domain.StockMovement{
+				LocationID: transfer.FromLocationID,
+				ProductID:  item.ProductID,
+				Type:       domain.StockTransferOut,
+				Quantity:   -item.Quantity,
+				TransferID: transfer.ID,
+				UserID:     userID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return tr.updateTransferStatus(ctx, tx, transfer.ID, domain.TransferInTransit, "dispatched_at")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.GetTransferByID(ctx, id)
+}
+
+// ReceiveTransfer adds the received quantities of the items of a transfer in transit to the stock of its destination location
+// in a transaction, recording them and a stock movement for each, and marks the transfer as received.
+// Stock arriving at the default location is received at the unit cost it was dispatched at
+func (tr *TransferRepository) ReceiveTransfer(ctx context.Context, transfer *domain.Transfer, userID uint64) (*domain.Transfer, error) {
+	err := pgx.BeginFunc(ctx, tr.db, func(tx pgx.Tx) error {
+		locked, err := tr.lockTransfer(ctx, tx, transfer.ID, domain.TransferInTransit)
+		if err != nil {
+			return err
+		}
+
+		toDefault, err := tr.isDefaultLocation(ctx, tx, locked.ToLocationID)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range transfer.Items {
+			var unitCost float64
+
+			itemQuery := tr.db.QueryBuilder.Update("transfer_items").
+				Set("received_quantity", *item.ReceivedQuantity).
+				Set("updated_at", time.Now()).
+				Where(sq.Eq{"id": item.ID, "transfer_id": locked.ID}).
+				Suffix("RETURNING unit_cost")
+
+			sql, args, err := itemQuery.ToSql()
+			if err != nil {
+				return err
+			}
+
+			err = tx.QueryRow(ctx, sql, args...).Scan(&unitCost)
+			if err != nil {
+				if err == pgx.ErrNoRows {
+					return domain.ErrInvalidTransferReceipt
+				}
+				return err
+			}
+
+			quantity := *item.ReceivedQuantity
+			if quantity == 0 {
+				continue
+			}
+
+			if toDefault {
+				_, err = receiveStock(ctx, tr.db, tx, item.ProductID, quantity, unitCost)
+			} else {
+				err = tr.incrementLocationStock(ctx, tx, locked.ToLocationID, item.ProductID, quantity)
+			}
+			if err != nil {
+				return err
+			}
+
+			err = tr.insertStockMovement(ctx, tx, &domain.StockMovement{
+				LocationID: locked.ToLocationID,
+				ProductID:  item.ProductID,
+				Type:       domain.StockTransferIn,
+				Quantity:   quantity,
+				TransferID: locked.ID,
+				UserID:     userID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return tr.updateTransferStatus(ctx, tx, locked.ID, domain.TransferReceived, "received_at")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.GetTransferByID(ctx, transfer.ID)
+}
+
+// CancelTransfer cancels a requested transfer in the database
+func (tr *TransferRepository) CancelTransfer(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	err := pgx.BeginFunc(ctx, tr.db, func(tx pgx.Tx) error {
+		_, err := tr.lockTransfer(ctx, tx, id, domain.TransferRequested)
+		if err != nil {
+			return err
+		}
+
+		return tr.updateTransferStatus(ctx, tx, id, domain.TransferCanceled, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.GetTransferByID(ctx, id)
+}
+
+// lockTransfer selects a transfer for update within the given transaction,
+// failing unless it has the status the next step is taken from
+func (tr *TransferRepository) lockTransfer(ctx context.Context, tx pgx.Tx, id uint64, status domain.TransferStatus) (*domain.Transfer, error) {
+	var transfer domain.Transfer
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("transfers").
+		Where(sq.Eq{"id": id}).
+		Suffix("FOR UPDATE")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scanTransfer(tx.QueryRow(ctx, sql, args...), &transfer)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	if transfer.Status != status {
+		return nil, domain.ErrTransferStatus
+	}
+
+	return &transfer, nil
+}
+
+// updateTransferStatus sets the status of a transfer within the given transaction,
+// along with the time of the step in the given column, if any
+func (tr *TransferRepository) updateTransferStatus(ctx context.Context, tx pgx.Tx, id uint64, status domain.TransferStatus, timeColumn string) error {
+	now := time.Now()
+
+	query := tr.db.QueryBuilder.Update("transfers").
+		Set("status", status).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": id})
+
+	if timeColumn != "" {
+		query = query.Set(timeColumn, now)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	return err
+}
+
+// isDefaultLocation checks whether a location is the default location within the given transaction
+func (tr *TransferRepository) isDefaultLocation(ctx context.Context, tx pgx.Tx, id uint64) (bool, error) {
+	var isDefault bool
+
+	query := tr.db.QueryBuilder.Select("is_default").
+		From("locations").
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&isDefault)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, domain.ErrDataNotFound
+		}
+		return false, err
+	}
+
+	return isDefault, nil
+}
+
+// decrementProductStock takes a quantity out of the stock of a product, the stock of the default location,
+// within the given transaction, and returns the unit cost of the quantity taken, valued with the valuation method of the product
+func (tr *TransferRepository) decrementProductStock(ctx context.Context, tx pgx.Tx, productID uint64, quantity float64) (float64, error) {
+	var product domain.Product
+
+	productQuery := tr.db.QueryBuilder.Update("products").
+		Set("stock", sq.Expr("stock - ?", quantity)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": productID}).
+		Suffix("RETURNING stock, cost, valuation")
+
+	sql, args, err := productQuery.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(
+		&product.Stock,
+		&product.Cost,
+		&product.Valuation,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if product.Stock < 0 {
+		return 0, domain.ErrInsufficientStock
+	}
+
+	fifoCost, err := consumeCostLayers(ctx, tr.db, tx, productID, quantity, product.Cost)
+	if err != nil {
+		return 0, err
+	}
+
+	if product.Valuation == domain.ValuationFIFO {
+		return math.Round(fifoCost/quantity*10000) / 10000, nil
+	}
+
+	return product.Cost, nil
+}
+
+// decrementLocationStock takes a quantity out of the stock of a product at a location other than the default location
+// within the given transaction
+func (tr *TransferRepository) decrementLocationStock(ctx context.Context, tx pgx.Tx, locationID, productID uint64, quantity float64) error {
+	query := tr.db.QueryBuilder.Update("location_stocks").
+		Set("quantity", sq.Expr("quantity - ?", quantity)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"location_id": locationID, "product_id": productID}).
+		Where(sq.GtOrEq{"quantity": quantity})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrInsufficientStock
+	}
+
+	return nil
+}
+
+// incrementLocationStock adds a quantity to the stock of a product at a location other than the default location
+// within the given transaction
+func (tr *TransferRepository) incrementLocationStock(ctx context.Context, tx pgx.Tx, locationID, productID uint64, quantity float64) error {
+	query := tr.db.QueryBuilder.Insert("location_stocks").
+		Columns("location_id", "product_id", "quantity").
+		Values(locationID, productID, quantity).
+		Suffix("ON CONFLICT (location_id, product_id) DO UPDATE SET quantity = location_stocks.quantity + EXCLUDED.quantity, updated_at = now()")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	return err
+}
+
+// insertStockMovement records a stock movement within the given transaction
+func (tr *TransferRepository) insertStockMovement(ctx context.Context, tx pgx.Tx, movement *domain.StockMovement) error {
+	query := tr.db.QueryBuilder.Insert("stock_movements").
+		Columns("location_id", "product_id", "type", "quantity", "transfer_id", "user_id").
+		Values(
+			movement.LocationID,
+			movement.ProductID,
+			movement.Type,
+			movement.Quantity,
+			nullUint64(movement.TransferID),
+			nullUint64(movement.UserID),
+		)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	return err
+}
+
+// listTransferItems selects the items of a transfer within the given transaction
+func (tr *TransferRepository) listTransferItems(ctx context.Context, tx pgx.Tx, transferID uint64) ([]domain.TransferItem, error) {
+	var item domain.TransferItem
+	var items []domain.TransferItem
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("transfer_items").
+		Where(sq.Eq{"transfer_id": transferID}).
+		OrderBy("id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := scanTransferItem(rows, &item)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// listStockMovements selects the stock movements recorded by a transfer within the given transaction
+func (tr *TransferRepository) listStockMovements(ctx context.Context, tx pgx.Tx, transferID uint64) ([]domain.StockMovement, error) {
+	var movement domain.StockMovement
+	var movements []domain.StockMovement
+	var transferId, userId sql.NullInt64
+
+	query := tr.db.QueryBuilder.Select("*").
+		From("stock_movements").
+		Where(sq.Eq{"transfer_id": transferID}).
+		OrderBy("id")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(
+			&movement.ID,
+			&movement.LocationID,
+			&movement.ProductID,
+			&movement.Type,
+			&movement.Quantity,
+			&transferId,
+			&userId,
+			&movement.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		movement.TransferID = uint64(transferId.Int64)
+		movement.UserID = uint64(userId.Int64)
+		movements = append(movements, movement)
+	}
+
+	return movements, nil
+}
+
+// scanTransfer scans a transfer record into a transfer
+func scanTransfer(row pgx.Row, transfer *domain.Transfer) error {
+	var userId sql.NullInt64
+
+	err := row.Scan(
+		&transfer.ID,
+		&transfer.FromLocationID,
+		&transfer.ToLocationID,
+		&userId,
+		&transfer.Status,
+		&transfer.Note,
+		&transfer.DispatchedAt,
+		&transfer.ReceivedAt,
+		&transfer.CreatedAt,
+		&transfer.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	transfer.UserID = uint64(userId.Int64)
+
+	return nil
+}
+
+// scanTransferItem scans a transfer item record into a transfer item
+func scanTransferItem(row pgx.Row, item *domain.TransferItem) error {
+	return row.Scan(
+		&item.ID,
+		&item.TransferID,
+		&item.ProductID,
+		&item.Quantity,
+		&item.ReceivedQuantity,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.UnitCost,
+	)
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/bagashiz/go-pos/internal/adapter/storage/postgres"
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+/**
+ * TwoFactorRepository implements port.TwoFactorRepository interface
+ * and provides an access to the postgres database
+ */
+type TwoFactorRepository struct {
+	db *postgres.DB
+}
+
+// NewTwoFactorRepository creates a new two-factor repository instance
+func NewTwoFactorRepository(db *postgres.DB) *TwoFactorRepository {
+	return &TwoFactorRepository{
+		db,
+	}
+}
+
+// SaveTwoFactor creates a two-factor authentication record in the database,
+// replacing the record of the user if it has not been confirmed yet
+func (tfr *TwoFactorRepository) SaveTwoFactor(ctx context.Context, twoFactor *domain.TwoFactor) (*domain.TwoFactor, error) {
+	query := tfr.db.QueryBuilder.Insert("two_factors").
+		Columns("user_id", "secret").
+		Values(twoFactor.UserID, twoFactor.Secret).
+		Suffix(`ON CONFLICT ("user_id") DO UPDATE SET "secret" = EXCLUDED."secret", "created_at" = now() ` +
+			`WHERE "two_factors"."confirmed_at" IS NULL ` +
+			"RETURNING confirmed_at, created_at")
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tfr.db.QueryRow(ctx, sql, args...).Scan(
+		&twoFactor.ConfirmedAt,
+		&twoFactor.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// The confirmed record of the user is kept
+			return nil, domain.ErrConflictingData
+		}
+		if errCode := tfr.db.ErrorCode(err); errCode == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return twoFactor, nil
+}
+
+// GetTwoFactorByUserID retrieves the two-factor authentication record of a user from the database
+func (tfr *TwoFactorRepository) GetTwoFactorByUserID(ctx context.Context, userID uint64) (*domain.TwoFactor, error) {
+	var twoFactor domain.TwoFactor
+
+	query := tfr.db.QueryBuilder.Select(
+		"user_id",
+		"secret",
+		"confirmed_at",
+		"created_at",
+	).
+		From("two_factors").
+		Where(sq.Eq{"user_id": userID}).
+		Limit(1)
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tfr.db.QueryRow(ctx, sql, args...).Scan(
+		&twoFactor.UserID,
+		&twoFactor.Secret,
+		&twoFactor.ConfirmedAt,
+		&twoFactor.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrDataNotFound
+		}
+		return nil, err
+	}
+
+	return &twoFactor, nil
+}
+
+// ConfirmTwoFactor marks the unconfirmed two-factor authentication record of a user as confirmed
+func (tfr *TwoFactorRepository) ConfirmTwoFactor(ctx context.Context, userID uint64, now time.Time) error {
+	query := tfr.db.QueryBuilder.Update("two_factors").
+		Set("confirmed_at", now).
+		Where(sq.Eq{
+			"user_id":      userID,
+			"confirmed_at": nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tfr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// DeleteTwoFactor deletes the two-factor authentication record of a user and the recovery code records of the user
+func (tfr *TwoFactorRepository) DeleteTwoFactor(ctx context.Context, userID uint64) error {
+	return pgx.BeginFunc(ctx, tfr.db, func(tx pgx.Tx) error {
+		for _, table := range []string{"recovery_codes", "two_factors"} {
+			query := tfr.db.QueryBuilder.Delete(table).
+				Where(sq.Eq{"user_id": userID})
+
+			sql, args, err := query.ToSql()
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(ctx, sql, args...)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReplaceRecoveryCodes deletes the recovery code records of a user and creates new ones from their hashes
+func (tfr *TwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint64, codeHashes []string) error {
+	err := pgx.BeginFunc(ctx, tfr.db, func(tx pgx.Tx) error {
+		deleteQuery := tfr.db.QueryBuilder.Delete("recovery_codes").
+			Where(sq.Eq{"user_id": userID})
+
+		sql, args, err := deleteQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		insertQuery := tfr.db.QueryBuilder.Insert("recovery_codes").
+			Columns("user_id", "code_hash")
+		for _, codeHash := range codeHashes {
+			insertQuery = insertQuery.Values(userID, codeHash)
+		}
+
+		sql, args, err = insertQuery.ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, sql, args...)
+		return err
+	})
+	if err != nil {
+		if errCode := tfr.db.ErrorCode(err); errCode == "23505" {
+			return domain.ErrConflictingData
+		}
+		if errCode := tfr.db.ErrorCode(err); errCode == "23503" {
+			return domain.ErrDataNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UseRecoveryCode marks an unused recovery code record of a user with the hash as used
+func (tfr *TwoFactorRepository) UseRecoveryCode(ctx context.Context, userID uint64, codeHash string, now time.Time) error {
+	query := tfr.db.QueryBuilder.Update("recovery_codes").
+		Set("used_at", now).
+		Where(sq.Eq{
+			"user_id":   userID,
+			"code_hash": codeHash,
+			"used_at":   nil,
+		})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := tfr.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -37,15 +38,7 @@ func (ur *UserRepository) CreateUser(ctx context.Context, user *domain.User) (*d
 		return nil, err
 	}
 
-	err = ur.db.QueryRow(ctx, sql, args...).Scan(
-		&user.ID,
-		&user.Name,
-		&user.Email,
-		&user.Password,
-		&user.Role,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	err = scanUser(ur.db.QueryRow(ctx, sql, args...), user)
 	if err != nil {
 		if errCode := ur.db.ErrorCode(err); errCode == "23505" {
 			return nil, domain.ErrConflictingData
@@ -70,15 +63,7 @@ func (ur *UserRepository) GetUserByID(ctx context.Context, id uint64) (*domain.U
 		return nil, err
 	}
 
-	err = ur.db.QueryRow(ctx, sql, args...).Scan(
-		&user.ID,
-		&user.Name,
-		&user.Email,
-		&user.Password,
-		&user.Role,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	err = scanUser(ur.db.QueryRow(ctx, sql, args...), &user)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, domain.ErrDataNotFound
@@ -103,15 +88,7 @@ func (ur *UserRepository) GetUserByEmail(ctx context.Context, email string) (*do
 		return nil, err
 	}
 
-	err = ur.db.QueryRow(ctx, sql, args...).Scan(
-		&user.ID,
-		&user.Name,
-		&user.Email,
-		&user.Password,
-		&user.Role,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	err = scanUser(ur.db.QueryRow(ctx, sql, args...), &user)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, domain.ErrDataNotFound
@@ -145,15 +122,7 @@ func (ur *UserRepository) ListUsers(ctx context.Context, skip, limit uint64) ([]
 	defer rows.Close()
 
 	for rows.Next() {
-		err := rows.Scan(
-			&user.ID,
-			&user.Name,
-			&user.Email,
-			&user.Password,
-			&user.Role,
-			&user.CreatedAt,
-			&user.UpdatedAt,
-		)
+		err := scanUser(rows, &user)
 		if err != nil {
 			return nil, err
 		}
@@ -185,19 +154,14 @@ func (ur *UserRepository) UpdateUser(ctx context.Context, user *domain.User) (*d
 		return nil, err
 	}
 
-	err = ur.db.QueryRow(ctx, sql, args...).Scan(
-		&user.ID,
-		&user.Name,
-		&user.Email,
-		&user.Password,
-		&user.Role,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	err = scanUser(ur.db.QueryRow(ctx, sql, args...), user)
 	if err != nil {
 		if errCode := ur.db.ErrorCode(err); errCode == "23505" {
 			return nil, domain.ErrConflictingData
 		}
+		if errCode := ur.db.ErrorCode(err); errCode == "23503" {
+			return nil, domain.ErrDataNotFound
+		}
 		return nil, err
 	}
 
@@ -221,3 +185,50 @@ func (ur *UserRepository) DeleteUser(ctx context.Context, id uint64) error {
 
 	return nil
 }
+
+// UpdateUserPIN sets the hashed PIN of a user by ID in the database
+func (ur *UserRepository) UpdateUserPIN(ctx context.Context, id uint64, pin string) error {
+	query := ur.db.QueryBuilder.Update("users").
+		Set("pin", nullString(pin)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": id})
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := ur.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+// scanUser scans a users row selected with all its columns into a user
+func scanUser(row pgx.Row, user *domain.User) error {
+	var pin sql.NullString
+
+	err := row.Scan(
+		&user.ID,
+		&user.Name,
+		&user.Email,
+		&user.Password,
+		&user.Role,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+		&pin,
+	)
+	if err != nil {
+		return err
+	}
+
+	user.PIN = pin.String
+
+	return nil
+}
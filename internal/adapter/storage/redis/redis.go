@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/bagashiz/go-pos/internal/adapter/config"
+	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port"
 	"github.com/redis/go-redis/v9"
 )
@@ -41,10 +42,31 @@ func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Dura
 // Get retrieves the value from the redis database
 func (r *Redis) Get(ctx context.Context, key string) ([]byte, error) {
 	res, err := r.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return nil, domain.ErrCacheMiss
+	}
+
 	bytes := []byte(res)
 	return bytes, err
 }
 
+// Increment increments the counter in the redis database atomically,
+// setting its ttl only when the counter is created so the window is not extended
+func (r *Redis) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
 // Delete removes the value from the redis database
 func (r *Redis) Delete(ctx context.Context, key string) error {
 	return r.client.Del(ctx, key).Err()
@@ -0,0 +1,152 @@
+package ticket
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+const (
+	// lineWidth is the number of characters printed on a line of an 80 mm receipt printer
+	lineWidth = 42
+	// indent is the indentation of the details printed under an order product
+	indent = "  "
+	// dateLayout is the layout of the order date printed on a ticket
+	dateLayout = "2006-01-02 15:04"
+)
+
+/**
+ * TicketRenderer implements port.TicketRenderer interface
+ * and renders plain text tickets for receipt printers
+ */
+type TicketRenderer struct{}
+
+// New creates a new ticket renderer instance
+func New() port.TicketRenderer {
+	return &TicketRenderer{}
+}
+
+// RenderReceipt renders the customer receipt of an order, listing the price of each order product
+// and of the modifiers chosen on it, followed by the totals of the order
+func (tr *TicketRenderer) RenderReceipt(order *domain.Order) (*domain.Ticket, error) {
+	var buf bytes.Buffer
+
+	writeCentered(&buf, fmt.Sprintf("Order #%d", order.ID))
+	writeLine(&buf, "Receipt: "+order.ReceiptCode.String())
+	writeLine(&buf, "Date: "+order.CreatedAt.Format(dateLayout))
+	if order.User != nil {
+		writeLine(&buf, "Cashier: "+order.User.Name)
+	}
+	writeLine(&buf, "Customer: "+order.CustomerName)
+	writeSeparator(&buf)
+
+	for _, orderProduct := range order.Products {
+		writeLine(&buf, productName(&orderProduct))
+		writeColumns(&buf, fmt.Sprintf("%s%s %s x %s", indent, formatQuantity(orderProduct.Quantity), orderProduct.Unit, formatPrice(orderProduct.UnitPrice*orderProduct.BaseQuantity/orderProduct.Quantity)), formatPrice(orderProduct.UnitPrice*orderProduct.BaseQuantity))
+
+		for _, modifier := range orderProduct.Modifiers {
+			writeColumns(&buf, indent+"+ "+modifierName(&modifier), formatPrice(modifier.Price*orderProduct.BaseQuantity))
+		}
+	}
+
+	writeSeparator(&buf)
+	writeColumns(&buf, "Total", formatPrice(order.TotalPrice))
+	if order.Payment != nil {
+		writeColumns(&buf, fmt.Sprintf("Paid (%s)", order.Payment.Name), formatPrice(order.TotalPaid))
+	} else {
+		writeColumns(&buf, "Paid", formatPrice(order.TotalPaid))
+	}
+	writeColumns(&buf, "Change", formatPrice(order.TotalReturn))
+
+	return &domain.Ticket{
+		Content: buf.Bytes(),
+	}, nil
+}
+
+// RenderKitchenTicket renders the kitchen ticket of an order, listing the quantity of each order product
+// and the modifiers chosen on it without prices
+func (tr *TicketRenderer) RenderKitchenTicket(order *domain.Order) (*domain.Ticket, error) {
+	var buf bytes.Buffer
+
+	writeCentered(&buf, fmt.Sprintf("KITCHEN - Order #%d", order.ID))
+	writeLine(&buf, "Date: "+order.CreatedAt.Format(dateLayout))
+	writeLine(&buf, "Customer: "+order.CustomerName)
+	writeSeparator(&buf)
+
+	for _, orderProduct := range order.Products {
+		writeLine(&buf, fmt.Sprintf("%s %s %s", formatQuantity(orderProduct.Quantity), orderProduct.Unit, productName(&orderProduct)))
+
+		for _, modifier := range orderProduct.Modifiers {
+			writeLine(&buf, indent+"+ "+modifierName(&modifier))
+		}
+	}
+
+	writeSeparator(&buf)
+
+	return &domain.Ticket{
+		Content: buf.Bytes(),
+	}, nil
+}
+
+// productName returns the name printed for an order product
+func productName(orderProduct *domain.OrderProduct) string {
+	if orderProduct.Product == nil {
+		return fmt.Sprintf("Product #%d", orderProduct.ProductID)
+	}
+
+	return orderProduct.Product.Name
+}
+
+// modifierName returns the name printed for a modifier, prefixed with the name of its group
+func modifierName(modifier *domain.OrderModifier) string {
+	return modifier.GroupName + ": " + modifier.Name
+}
+
+// formatQuantity formats a quantity without trailing zeros
+func formatQuantity(quantity float64) string {
+	return strconv.FormatFloat(quantity, 'f', -1, 64)
+}
+
+// formatPrice formats a price with two decimals
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', 2, 64)
+}
+
+// writeLine writes a line of text, wrapping it at the line width
+func writeLine(buf *bytes.Buffer, text string) {
+	runes := []rune(text)
+	for len(runes) > lineWidth {
+		buf.WriteString(string(runes[:lineWidth]) + "\n")
+		runes = runes[lineWidth:]
+	}
+
+	buf.WriteString(string(runes) + "\n")
+}
+
+// writeCentered writes a line of text centered on the line width
+func writeCentered(buf *bytes.Buffer, text string) {
+	padding := (lineWidth - utf8.RuneCountInString(text)) / 2
+	writeLine(buf, strings.Repeat(" ", max(padding, 0))+text)
+}
+
+// writeColumns writes a label on the left and an amount aligned on the right of a line,
+// moving the amount to its own line when both do not fit
+func writeColumns(buf *bytes.Buffer, label, amount string) {
+	padding := lineWidth - utf8.RuneCountInString(label) - utf8.RuneCountInString(amount)
+	if padding < 1 {
+		writeLine(buf, label)
+		label, padding = "", lineWidth-utf8.RuneCountInString(amount)
+	}
+
+	writeLine(buf, label+strings.Repeat(" ", padding)+amount)
+}
+
+// writeSeparator writes a dashed line across the line width
+func writeSeparator(buf *bytes.Buffer) {
+	writeLine(buf, strings.Repeat("-", lineWidth))
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// AuditEventType is an enum for the security-relevant events that are audited
+type AuditEventType string
+
+// AuditEventType enum values
+const (
+	AuditLoginLockedOut         AuditEventType = "login.locked_out"
+	AuditLoginUnlocked          AuditEventType = "login.unlocked"
+	AuditPasswordChanged        AuditEventType = "password.changed"
+	AuditPasswordResetRequested AuditEventType = "password.reset_requested"
+	AuditPasswordReset          AuditEventType = "password.reset"
+	AuditTwoFactorEnabled       AuditEventType = "two_factor.enabled"
+	AuditTwoFactorDisabled      AuditEventType = "two_factor.disabled"
+	AuditRecoveryCodeUsed       AuditEventType = "two_factor.recovery_code_used"
+)
+
+// AuditEvent is an entity that represents a security-relevant event.
+// ActorID is the user who caused the event and UserID the user it affects, both 0 if unknown.
+// Subject is what the event is about, such as an email or IP address
+type AuditEvent struct {
+	Type       AuditEventType
+	ActorID    uint64
+	UserID     uint64
+	Subject    string
+	IPAddress  string
+	OccurredAt time.Time
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// BarcodeType is an enum for barcode's type
+type BarcodeType string
+
+// BarcodeType enum values
+const (
+	BarcodeStandard BarcodeType = "standard"
+	BarcodeWeight   BarcodeType = "weight"
+	BarcodePrice    BarcodeType = "price"
+)
+
+// Barcode is an entity that represents a barcode of a product, either a manufacturer EAN/UPC code
+// or the prefix of in-store weighted barcodes that encode the weight or price of the item
+type Barcode struct {
+	ID        uint64
+	ProductID uint64
+	Code      string
+	Type      BarcodeType
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Product   *Product
+}
+
+// BarcodeScan is an entity that represents a product identified by a scanned barcode,
+// along with the quantity and price of the scanned item
+type BarcodeScan struct {
+	Code     string
+	Type     BarcodeType
+	Quantity float64
+	Price    float64
+	Product  *Product
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// BundleComponent is an entity that represents a component product of a bundle and its quantity per bundle
+type BundleComponent struct {
+	ID          uint64
+	BundleID    uint64
+	ComponentID uint64
+	Quantity    float64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Component   *Product
+}
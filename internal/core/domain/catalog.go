@@ -0,0 +1,55 @@
+package domain
+
+// CatalogFormat is an enum for the file format a product catalog is imported from or exported to
+type CatalogFormat string
+
+// CatalogFormat enum values
+const (
+	CatalogCSV  CatalogFormat = "csv"
+	CatalogXLSX CatalogFormat = "xlsx"
+)
+
+// Catalog is an entity that represents an encoded product catalog file
+type Catalog struct {
+	Format  CatalogFormat
+	Content []byte
+}
+
+// CatalogColumns are the columns of a product catalog file, in the order they are exported
+var CatalogColumns = []string{"sku", "barcode", "name", "category", "unit", "price", "cost", "stock"}
+
+// CatalogRow is an entity that represents a product row of a catalog file as written in its cells.
+// Row is the line number of the row in the file, counting the header
+type CatalogRow struct {
+	Row      int
+	SKU      string
+	Barcode  string
+	Name     string
+	Category string
+	Unit     string
+	Price    string
+	Cost     string
+	Stock    string
+}
+
+// CatalogRowError is an entity that represents a validation error of a cell of a catalog row
+type CatalogRowError struct {
+	Row     int
+	Column  string
+	Message string
+}
+
+// CatalogImport is an entity that represents the outcome of importing a product catalog.
+// Products are only imported when the import is not a dry run and no row has errors
+type CatalogImport struct {
+	DryRun   bool
+	Created  int
+	Updated  int
+	Errors   []CatalogRowError
+	Products []Product
+}
+
+// IsImported checks whether the products of the catalog have been imported
+func (ci *CatalogImport) IsImported() bool {
+	return !ci.DryRun && len(ci.Errors) == 0
+}
@@ -2,10 +2,17 @@ package domain
 
 import "time"
 
-// Category is an entity that represents a category of product
+// Category is an entity that represents a category of product, which may be a sub-category of a parent category
 type Category struct {
 	ID        uint64
+	ParentID  uint64
 	Name      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// CategoryNode is an entity that represents a category with its sub-categories in the category tree
+type CategoryNode struct {
+	Category
+	Children []CategoryNode
+}
@@ -9,24 +9,122 @@ var (
 	ErrInternal = errors.New("internal error")
 	// ErrDataNotFound is an error for when requested data is not found
 	ErrDataNotFound = errors.New("data not found")
+	// ErrCacheMiss is an error for when a key is not found in the cache
+	ErrCacheMiss = errors.New("key not found in cache")
 	// ErrNoUpdatedData is an error for when no data is provided to update
 	ErrNoUpdatedData = errors.New("no data to update")
 	// ErrConflictingData is an error for when data conflicts with existing data
 	ErrConflictingData = errors.New("data conflicts with existing data in unique column")
 	// ErrInsufficientStock is an error for when product stock is not enough
 	ErrInsufficientStock = errors.New("product stock is not enough")
+	// ErrExpiredStock is an error for when the remaining product stock is in expired lots
+	ErrExpiredStock = errors.New("product stock has expired")
+	// ErrLotNotTracked is an error for when a lot is received for a product without lot tracking
+	ErrLotNotTracked = errors.New("product is not tracked by lot")
+	// ErrSerialNotTracked is an error for when serial numbers are given for a product without serial tracking
+	ErrSerialNotTracked = errors.New("product is not tracked by serial number")
+	// ErrSerialNumberMismatch is an error for when the serial numbers sold do not match the product quantity
+	ErrSerialNumberMismatch = errors.New("serial numbers do not match the product quantity")
+	// ErrSerialNotInStock is an error for when a serial number sold is not in stock
+	ErrSerialNotInStock = errors.New("serial number is not in stock")
+	// ErrInvalidVariant is an error for when a variant does not match the option dimensions of its parent product
+	ErrInvalidVariant = errors.New("variant options do not match the parent product options")
+	// ErrVariantRequired is an error for when a parent product with variants is sold directly
+	ErrVariantRequired = errors.New("product has variants, one of its variants must be sold")
+	// ErrInvalidBundle is an error for when bundle components are invalid
+	ErrInvalidBundle = errors.New("bundle components are invalid")
+	// ErrInvalidUnit is an error for when a unit of measure is not defined for a product
+	ErrInvalidUnit = errors.New("unit of measure is not defined for the product")
+	// ErrInvalidBarcode is an error for when a barcode has an invalid format or check digit
+	ErrInvalidBarcode = errors.New("barcode is invalid")
+	// ErrBarcodeRequired is an error for when an EAN-13 label is rendered for a product without an EAN-13 or UPC-A barcode
+	ErrBarcodeRequired = errors.New("product has no EAN-13 or UPC-A barcode, an in-store barcode must be generated first")
+	// ErrLabelRendering is an error for when a barcode label cannot be rendered
+	ErrLabelRendering = errors.New("error rendering the label")
+	// ErrReceiptNotAllowed is an error for when stock is received directly for a product whose stock is received otherwise
+	ErrReceiptNotAllowed = errors.New("product stock must be received through its lots, serial numbers, variants or components")
+	// ErrFractionalQuantity is an error for when a fractional quantity is given for a product counted in whole units
+	ErrFractionalQuantity = errors.New("product quantity must be a whole number")
+	// ErrInvalidSchedule is an error for when a scheduled price does not take effect in the future
+	ErrInvalidSchedule = errors.New("scheduled price must take effect in the future")
+	// ErrScheduleApplied is an error for when a scheduled price that has already taken effect is canceled
+	ErrScheduleApplied = errors.New("scheduled price has already taken effect")
+	// ErrInvalidPriceList is an error for when the validity period or daily time window of a price list is invalid
+	ErrInvalidPriceList = errors.New("price list validity period or time window is invalid")
+	// ErrInvalidCatalog is an error for when a product catalog file cannot be read or has unknown columns
+	ErrInvalidCatalog = errors.New("product catalog file is invalid")
+	// ErrInvalidImage is an error for when an uploaded image is too large or not in a supported format
+	ErrInvalidImage = errors.New("image must be a JPEG, PNG or GIF file of at most 5 MB")
+	// ErrCategoryCycle is an error for when a category is moved under itself or one of its sub-categories
+	ErrCategoryCycle = errors.New("category cannot be moved under itself or one of its sub-categories")
+	// ErrInvalidModifierGroup is an error for when the selection limits of a modifier group are invalid
+	ErrInvalidModifierGroup = errors.New("modifier group must allow at least one selection, no fewer than its minimum and no more than its modifiers")
+	// ErrInvalidIngredient is an error for when a modifier consumes a product that is not counted in stock on its own
+	ErrInvalidIngredient = errors.New("modifier ingredient must be a product without serial numbers, variants or components")
+	// ErrInvalidModifiers is an error for when the modifiers chosen on an order product do not fit its modifier groups
+	ErrInvalidModifiers = errors.New("chosen modifiers must belong to the product and fit the selection limits of its modifier groups")
+	// ErrTransferNotAllowed is an error for when stock is transferred for a product whose stock is tracked otherwise
+	ErrTransferNotAllowed = errors.New("product stock tracked by lots, serial numbers, variants or components cannot be transferred")
+	// ErrInvalidTransfer is an error for when a transfer does not move stock between two locations or lists a product twice
+	ErrInvalidTransfer = errors.New("transfer must move a quantity of each of its products once between two different locations")
+	// ErrTransferStatus is an error for when a transfer is dispatched, received or canceled out of order
+	ErrTransferStatus = errors.New("transfer cannot take this step in its current status")
+	// ErrInvalidTransferReceipt is an error for when a transfer is received without the received quantity of each of its products
+	ErrInvalidTransferReceipt = errors.New("received quantities must be given for every product of the transfer and no other")
 	// ErrInsufficientPayment is an error for when total paid is less than total price
 	ErrInsufficientPayment = errors.New("total paid is less than total price")
 	// ErrTokenDuration is an error for when the token duration format is invalid
 	ErrTokenDuration = errors.New("invalid token duration format")
+	// ErrTokenKeys is an error for when the token keys are missing or malformed
+	ErrTokenKeys = errors.New("token keys are missing, malformed or have duplicate ids")
+	// ErrTokenClaims is an error for when the token issuer or audience is not set
+	ErrTokenClaims = errors.New("token issuer and audience must be set")
+	// ErrTokenType is an error for when the token type is not supported
+	ErrTokenType = errors.New("unsupported token type")
+	// ErrSchedulerInterval is an error for when the scheduler interval format is invalid
+	ErrSchedulerInterval = errors.New("invalid scheduler interval format")
+	// ErrStorageDriver is an error for when the file storage driver is not supported
+	ErrStorageDriver = errors.New("unsupported file storage driver")
+	// ErrMailConfig is an error for when the mail server or sender address is not configured
+	ErrMailConfig = errors.New("mail host, port and sender address are required")
+	// ErrTwoFactorIssuer is an error for when the issuer shown in authenticator apps is not configured
+	ErrTwoFactorIssuer = errors.New("two-factor issuer is required")
 	// ErrTokenCreation is an error for when the token creation fails
 	ErrTokenCreation = errors.New("error creating token")
 	// ErrExpiredToken is an error for when the access token is expired
 	ErrExpiredToken = errors.New("access token has expired")
 	// ErrInvalidToken is an error for when the access token is invalid
 	ErrInvalidToken = errors.New("access token is invalid")
+	// ErrRevokedToken is an error for when the access token has been revoked by a logout
+	ErrRevokedToken = errors.New("access token has been revoked")
+	// ErrInvalidRefreshToken is an error for when the refresh token is unknown, revoked or expired
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid or has expired")
+	// ErrRefreshTokenReused is an error for when a refresh token that has already been replaced is used again
+	ErrRefreshTokenReused = errors.New("refresh token has already been used, the sessions started from the same login are revoked")
+	// ErrInvalidResetToken is an error for when the password reset token is unknown, used or expired
+	ErrInvalidResetToken = errors.New("password reset token is invalid or has expired")
+	// ErrIncorrectPassword is an error for when the current password given to change the password is incorrect
+	ErrIncorrectPassword = errors.New("current password is incorrect")
 	// ErrInvalidCredentials is an error for when the credentials are invalid
 	ErrInvalidCredentials = errors.New("invalid email or password")
+	// ErrTooManyLoginAttempts is an error for when logging in is delayed or locked out after failed attempts
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts, try again later")
+	// ErrInvalidTwoFactorChallenge is an error for when the two-factor challenge of a login is unknown, completed or expired
+	ErrInvalidTwoFactorChallenge = errors.New("two-factor challenge is invalid or has expired")
+	// ErrInvalidTwoFactorCode is an error for when the two-factor code is neither a valid TOTP code nor an unused recovery code
+	ErrInvalidTwoFactorCode = errors.New("two-factor code is invalid")
+	// ErrTwoFactorEnabled is an error for when a user enrolls in two-factor authentication that is already enabled
+	ErrTwoFactorEnabled = errors.New("two-factor authentication is already enabled")
+	// ErrTwoFactorRequired is an error for when a user whose two-factor authentication is enforced disables it
+	ErrTwoFactorRequired = errors.New("two-factor authentication is required for the role of the user")
+	// ErrInvalidPIN is an error for when the PIN login credentials are invalid
+	ErrInvalidPIN = errors.New("invalid user or PIN")
+	// ErrInvalidTerminal is an error for when the terminal credential is missing or does not belong to a registered terminal
+	ErrInvalidTerminal = errors.New("PIN login is only available on a registered terminal")
+	// ErrPINNotAllowed is an error for when a PIN is set for a user whose role does not allow PIN login
+	ErrPINNotAllowed = errors.New("PIN login is not allowed for the role of the user")
+	// ErrBuiltInRole is an error for when a built-in role is renamed or deleted, or the admin role is changed
+	ErrBuiltInRole = errors.New("built-in roles cannot be renamed or deleted, and the admin role cannot be changed")
 	// ErrEmptyAuthorizationHeader is an error for when the authorization header is empty
 	ErrEmptyAuthorizationHeader = errors.New("authorization header is not provided")
 	// ErrInvalidAuthorizationHeader is an error for when the authorization header is invalid
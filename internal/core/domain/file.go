@@ -0,0 +1,18 @@
+package domain
+
+// MaxImageSize is the largest size in bytes of an uploaded image
+const MaxImageSize = 5 << 20
+
+// ImageExtensions maps the content types of the supported image formats to their file extension
+var ImageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// File is an entity that represents a stored file, such as an uploaded image or its thumbnail
+type File struct {
+	Key         string
+	ContentType string
+	Content     []byte
+}
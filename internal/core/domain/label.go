@@ -0,0 +1,35 @@
+package domain
+
+// BarcodeSymbology is an enum for the symbology a label barcode is rendered in
+type BarcodeSymbology string
+
+// BarcodeSymbology enum values
+const (
+	SymbologyCode128 BarcodeSymbology = "code128"
+	SymbologyEAN13   BarcodeSymbology = "ean13"
+)
+
+// LabelFormat is an enum for the file format a label is rendered in
+type LabelFormat string
+
+// LabelFormat enum values
+const (
+	LabelSVG LabelFormat = "svg"
+	LabelPNG LabelFormat = "png"
+	LabelPDF LabelFormat = "pdf"
+)
+
+// Label is an entity that represents a rendered barcode image or shelf-label sheet
+type Label struct {
+	Format  LabelFormat
+	Content []byte
+}
+
+// ShelfLabel is an entity that represents the content of a printed shelf label of a product
+type ShelfLabel struct {
+	Name      string
+	Price     float64
+	Unit      string
+	Symbology BarcodeSymbology
+	Code      string
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Location is an entity that represents a place stock is kept at, such as the store or a warehouse.
+// The stock of the default location, the store, is the stock of the products themselves
+type Location struct {
+	ID        uint64
+	Name      string
+	IsDefault bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LocationStock is an entity that represents the stock of a product at a location
+type LocationStock struct {
+	LocationID uint64
+	ProductID  uint64
+	Quantity   float64
+	Product    *Product
+}
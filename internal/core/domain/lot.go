@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Lot is an entity that represents a received batch of a product with an expiry date
+type Lot struct {
+	ID        uint64
+	ProductID uint64
+	LotNumber string
+	ExpiresAt time.Time
+	Quantity  float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Product   *Product
+}
+
+// IsExpired reports whether the lot has expired at the given time
+func (l *Lot) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// ModifierGroup is an entity that represents a group of modifiers chosen from when ordering a product,
+// like the milk or sweetness of a coffee. A group with a minimum number of selections is required
+type ModifierGroup struct {
+	ID            uint64
+	ProductID     uint64
+	Name          string
+	MinSelections uint64
+	MaxSelections uint64
+	Modifiers     []Modifier
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// IsRequired reports whether a modifier of the group must be chosen when ordering its product
+func (mg *ModifierGroup) IsRequired() bool {
+	return mg.MinSelections > 0
+}
+
+// Modifier is an entity that represents a modifier or add-on of a product, which adds its price to
+// each unit of the product ordered and may consume a quantity of an ingredient product for each unit
+type Modifier struct {
+	ID                 uint64
+	GroupID            uint64
+	Name               string
+	Price              float64
+	IngredientID       uint64
+	IngredientQuantity float64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// OrderModifier is an entity that represents a modifier chosen on an order product. It keeps the names and price
+// of the modifier at the time of the order, and the ingredient consumed by it while the order is created
+type OrderModifier struct {
+	ID                 uint64
+	OrderProductID     uint64
+	ModifierID         uint64
+	GroupName          string
+	Name               string
+	Price              float64
+	IngredientID       uint64
+	IngredientQuantity float64
+	CreatedAt          time.Time
+}
@@ -4,13 +4,35 @@ import "time"
 
 // OrderProduct is an entity that represents pivot table between order and product
 type OrderProduct struct {
-	ID         uint64
-	OrderID    uint64
-	ProductID  uint64
-	Quantity   int64
-	TotalPrice float64
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	Order      *Order
-	Product    *Product
+	ID            uint64
+	OrderID       uint64
+	ProductID     uint64
+	Quantity      float64
+	Unit          string
+	BaseQuantity  float64
+	UnitPrice     float64
+	TotalPrice    float64
+	PriceListID   uint64
+	Cost          float64
+	SerialNumbers []string
+	Modifiers     []OrderModifier
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Order         *Order
+	Product       *Product
+}
+
+// ModifiersPrice returns the price the modifiers chosen on the order product add to each unit of the product
+func (op *OrderProduct) ModifiersPrice() float64 {
+	var price float64
+	for _, modifier := range op.Modifiers {
+		price += modifier.Price
+	}
+
+	return price
+}
+
+// Margin returns the gross margin of the order product, its total price less the cost of the stock sold
+func (op *OrderProduct) Margin() float64 {
+	return op.TotalPrice - op.Cost
 }
@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// PasswordResetToken is an entity that represents a single-use token a user resets their password with.
+// Token is only set when the token is issued, as only its hash is stored
+type PasswordResetToken struct {
+	ID        uint64
+	UserID    uint64
+	Token     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsActive checks whether the password reset token is neither used nor expired
+func (prt *PasswordResetToken) IsActive(now time.Time) bool {
+	return prt.UsedAt == nil && now.Before(prt.ExpiresAt)
+}
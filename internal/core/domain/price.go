@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// PriceChange is an entity that represents a change of the price of a product,
+// either made directly by a user or by a scheduled price taking effect
+type PriceChange struct {
+	ID        uint64
+	ProductID uint64
+	UserID    uint64
+	OldPrice  float64
+	NewPrice  float64
+	CreatedAt time.Time
+}
+
+// ScheduledPrice is an entity that represents a future price of a product,
+// applied once its effective time is reached
+type ScheduledPrice struct {
+	ID          uint64
+	ProductID   uint64
+	UserID      uint64
+	Price       float64
+	EffectiveAt time.Time
+	AppliedAt   *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IsApplied checks whether the scheduled price has already taken effect
+func (sp *ScheduledPrice) IsApplied() bool {
+	return sp.AppliedAt != nil
+}
@@ -0,0 +1,107 @@
+package domain
+
+import "time"
+
+// CustomerGroup is an enum for the customer group an order is priced for
+type CustomerGroup string
+
+// CustomerGroup enum values
+const (
+	CustomerRetail    CustomerGroup = "retail"
+	CustomerWholesale CustomerGroup = "wholesale"
+	CustomerStaff     CustomerGroup = "staff"
+)
+
+// timeOfDayLayout is the layout of the daily start and end times of a price list
+const timeOfDayLayout = "15:04"
+
+// PriceList is an entity that represents a set of product prices that apply instead of the regular prices
+// to a customer group, or to every customer group when none is assigned, within an optional validity period
+// and an optional daily time window, such as a happy hour. A daily time window ending before it starts spans midnight
+type PriceList struct {
+	ID            uint64
+	Name          string
+	CustomerGroup CustomerGroup
+	Priority      int
+	StartsAt      *time.Time
+	EndsAt        *time.Time
+	StartTime     string
+	EndTime       string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Items         []PriceListItem
+}
+
+// PriceListItem is an entity that represents the price of a product in a price list
+type PriceListItem struct {
+	ID          uint64
+	PriceListID uint64
+	ProductID   uint64
+	Price       float64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ProductPrice is an entity that represents the price of a product for a customer group at a given time,
+// along with the price list it comes from, if any
+type ProductPrice struct {
+	ProductID     uint64
+	CustomerGroup CustomerGroup
+	Price         float64
+	PriceList     *PriceList
+}
+
+// IsValid checks whether the validity period and daily time window of the price list are well-formed
+func (pl *PriceList) IsValid() bool {
+	if pl.StartsAt != nil && pl.EndsAt != nil && !pl.StartsAt.Before(*pl.EndsAt) {
+		return false
+	}
+
+	if pl.StartTime == "" && pl.EndTime == "" {
+		return true
+	}
+
+	_, startErr := time.Parse(timeOfDayLayout, pl.StartTime)
+	_, endErr := time.Parse(timeOfDayLayout, pl.EndTime)
+
+	return startErr == nil && endErr == nil && pl.StartTime != pl.EndTime
+}
+
+// AppliesTo checks whether the price list applies to a customer group at the given time.
+// Its daily time window is compared with the clock time in the time zone of the given time
+func (pl *PriceList) AppliesTo(group CustomerGroup, at time.Time) bool {
+	if pl.CustomerGroup != "" && pl.CustomerGroup != group {
+		return false
+	}
+
+	if pl.StartsAt != nil && at.Before(*pl.StartsAt) {
+		return false
+	}
+
+	if pl.EndsAt != nil && !at.Before(*pl.EndsAt) {
+		return false
+	}
+
+	if pl.StartTime == "" {
+		return true
+	}
+
+	clock := at.Format(timeOfDayLayout)
+
+	if pl.StartTime < pl.EndTime {
+		return clock >= pl.StartTime && clock < pl.EndTime
+	}
+
+	return clock >= pl.StartTime || clock < pl.EndTime
+}
+
+// ItemPrice returns the price of a product in the price list
+func (pl *PriceList) ItemPrice(productID uint64) (float64, bool) {
+	for _, item := range pl.Items {
+		if item.ProductID == productID {
+			return item.Price, true
+		}
+	}
+
+	return 0, false
+}
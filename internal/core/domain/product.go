@@ -1,21 +1,80 @@
 package domain
 
 import (
+	"math"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// ProductOption is an entity that represents an option dimension of a parent product and its allowed values
+type ProductOption struct {
+	Name   string
+	Values []string
+}
+
+// ProductUnit is an entity that represents an alternative unit of measure of a product and how many base units it holds
+type ProductUnit struct {
+	Name   string
+	Factor float64
+}
+
 // Product is an entity that represents a product
 type Product struct {
-	ID         uint64
-	CategoryID uint64
-	SKU        uuid.UUID
-	Name       string
-	Stock      int64
-	Price      float64
-	Image      string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	Category   *Category
+	ID           uint64
+	CategoryID   uint64
+	SKU          uuid.UUID
+	Name         string
+	Stock        float64
+	Price        float64
+	Cost         float64
+	Valuation    ValuationMethod
+	Image        string
+	Thumbnail    string
+	TrackLots    bool
+	TrackSerials bool
+	ParentID     uint64
+	Options      []ProductOption
+	OptionValues map[string]string
+	IsBundle     bool
+	Unit         string
+	Fractional   bool
+	Units        []ProductUnit
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Category     *Category
+	Variants     []Product
+	Components   []BundleComponent
+	Barcodes     []Barcode
+}
+
+// DefaultUnit is the base unit of measure of products sold by piece
+const DefaultUnit = "pcs"
+
+// BaseQuantity converts a quantity in the given unit of measure into the base unit of the product,
+// rounded to the precision stock is kept in
+func (p *Product) BaseQuantity(quantity float64, unit string) (float64, error) {
+	factor := 1.0
+
+	if unit != "" && unit != p.Unit {
+		factor = 0
+
+		for _, productUnit := range p.Units {
+			if productUnit.Name == unit {
+				factor = productUnit.Factor
+				break
+			}
+		}
+
+		if factor == 0 {
+			return 0, ErrInvalidUnit
+		}
+	}
+
+	baseQuantity := math.Round(quantity*factor*1000) / 1000
+	if !p.Fractional && baseQuantity != math.Trunc(baseQuantity) {
+		return 0, ErrFractionalQuantity
+	}
+
+	return baseQuantity, nil
 }
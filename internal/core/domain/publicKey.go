@@ -0,0 +1,7 @@
+package domain
+
+// PublicKey is an entity that represents an Ed25519 public key verifying access tokens
+type PublicKey struct {
+	ID  string
+	Key []byte
+}
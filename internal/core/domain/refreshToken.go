@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Device is an entity that represents the device a user logs in from
+type Device struct {
+	Name      string
+	UserAgent string
+	IPAddress string
+}
+
+// RefreshToken is an entity that represents a refresh token issued to a device, stored hashed.
+// Refreshing replaces it with a new token of the same family, so reusing a replaced token reveals its theft
+type RefreshToken struct {
+	ID        uint64
+	UserID    uint64
+	FamilyID  uuid.UUID
+	TokenHash string
+	Device    Device
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// IsUsed checks whether the refresh token has already been replaced by a new one
+func (rt *RefreshToken) IsUsed() bool {
+	return rt.UsedAt != nil
+}
+
+// IsActive checks whether the refresh token can still be used at the given time
+func (rt *RefreshToken) IsActive(now time.Time) bool {
+	return rt.RevokedAt == nil && rt.UsedAt == nil && now.Before(rt.ExpiresAt)
+}
+
+// AuthToken is an entity that represents the tokens given to a user on login or refresh.
+// Challenge is set instead of the tokens when the login has to be completed with a two-factor code
+type AuthToken struct {
+	AccessToken      string
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	Challenge        *TwoFactorChallenge
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Permission is an enum for the actions a role allows
+type Permission string
+
+// Permission enum values
+const (
+	UsersManage         Permission = "users.manage"
+	RolesManage         Permission = "roles.manage"
+	TerminalsManage     Permission = "terminals.manage"
+	PaymentsManage      Permission = "payments.manage"
+	CategoriesManage    Permission = "categories.manage"
+	ProductsManage      Permission = "products.manage"
+	ProductsEditPrice   Permission = "products.edit_price"
+	CatalogManage       Permission = "catalog.manage"
+	InventoryManage     Permission = "inventory.manage"
+	PriceListsManage    Permission = "price_lists.manage"
+	OrdersCustomerGroup Permission = "orders.customer_group"
+	ReportsView         Permission = "reports.view"
+	AuthPINLogin        Permission = "auth.pin_login"
+)
+
+// Permissions lists every permission a role can be given
+var Permissions = []Permission{
+	UsersManage,
+	RolesManage,
+	TerminalsManage,
+	PaymentsManage,
+	CategoriesManage,
+	ProductsManage,
+	ProductsEditPrice,
+	CatalogManage,
+	InventoryManage,
+	PriceListsManage,
+	OrdersCustomerGroup,
+	ReportsView,
+	AuthPINLogin,
+}
+
+// PrivilegedPermissions lists the permissions that give control over the accounts and roles of other users
+var PrivilegedPermissions = []Permission{
+	UsersManage,
+	RolesManage,
+}
+
+// Role is an entity that represents a named set of permissions users are given.
+// The admin and cashier roles are built in: neither can be renamed or deleted,
+// and the permissions of the admin role cannot be changed
+type Role struct {
+	ID          uint64
+	Name        UserRole
+	Permissions []Permission
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// HasPermission checks whether the role allows an action
+func (r *Role) HasPermission(permission Permission) bool {
+	return slices.Contains(r.Permissions, permission)
+}
+
+// IsPrivileged checks whether the role gives control over the accounts or roles of other users
+func (r *Role) IsPrivileged() bool {
+	return slices.ContainsFunc(PrivilegedPermissions, r.HasPermission)
+}
+
+// IsBuiltIn checks whether the role is the admin or cashier role
+func (r *Role) IsBuiltIn() bool {
+	return r.Name == Admin || r.Name == Cashier
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// SerialStatus is an enum for serial number's status
+type SerialStatus string
+
+// SerialStatus enum values
+const (
+	SerialInStock SerialStatus = "in_stock"
+	SerialSold    SerialStatus = "sold"
+)
+
+// Serial is an entity that represents a serial number of a single unit of a serial-tracked product
+type Serial struct {
+	ID             uint64
+	ProductID      uint64
+	SerialNumber   string
+	Status         SerialStatus
+	OrderProductID uint64
+	OrderID        uint64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Product        *Product
+	Order          *Order
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// Terminal is an entity that represents a registered till cashiers can log in on with a PIN.
+// Credential is only set when the terminal is registered, as only its hash is stored
+type Terminal struct {
+	ID             uint64
+	Name           string
+	Credential     string
+	CredentialHash string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
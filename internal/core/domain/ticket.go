@@ -0,0 +1,6 @@
+package domain
+
+// Ticket is an entity that represents a rendered plain text ticket of an order, ready to be sent to a receipt printer
+type Ticket struct {
+	Content []byte
+}
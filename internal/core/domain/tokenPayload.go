@@ -1,12 +1,26 @@
 package domain
 
 import (
+	"slices"
+	"time"
+
 	"github.com/google/uuid"
 )
 
-// TokenPayload is an entity that represents the payload of the token
+// TokenPayload is an entity that represents the payload of the token.
+// SessionID identifies the login the token was issued from, shared with its refresh tokens.
+// Permissions are those of the role when the token was issued
 type TokenPayload struct {
-	ID     uuid.UUID
-	UserID uint64
-	Role   UserRole
+	ID          uuid.UUID
+	UserID      uint64
+	Role        UserRole
+	Permissions []Permission
+	SessionID   uuid.UUID
+	IssuedAt    time.Time
+	ExpiredAt   time.Time
+}
+
+// HasPermission checks whether the token allows an action
+func (tp *TokenPayload) HasPermission(permission Permission) bool {
+	return slices.Contains(tp.Permissions, permission)
 }
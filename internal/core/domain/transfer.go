@@ -0,0 +1,79 @@
+package domain
+
+import "time"
+
+// TransferStatus is an enum for the steps a transfer of stock between locations goes through
+type TransferStatus string
+
+// TransferStatus enum values
+const (
+	TransferRequested TransferStatus = "requested"
+	TransferInTransit TransferStatus = "in_transit"
+	TransferReceived  TransferStatus = "received"
+	TransferCanceled  TransferStatus = "canceled"
+)
+
+// Transfer is an entity that represents a document moving stock from one location to another.
+// It is requested, then dispatched from the source location, leaving its stock in transit,
+// and finally received at the destination location
+type Transfer struct {
+	ID             uint64
+	FromLocationID uint64
+	ToLocationID   uint64
+	UserID         uint64
+	Status         TransferStatus
+	Note           string
+	DispatchedAt   *time.Time
+	ReceivedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	FromLocation   *Location
+	ToLocation     *Location
+	Items          []TransferItem
+	Movements      []StockMovement
+}
+
+// TransferItem is an entity that represents a product quantity moved by a transfer
+type TransferItem struct {
+	ID               uint64
+	TransferID       uint64
+	ProductID        uint64
+	Quantity         float64
+	ReceivedQuantity *float64
+	UnitCost         float64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Product          *Product
+}
+
+// Discrepancy returns the quantity dispatched but not received, negative when more was received than dispatched.
+// It is zero until the item is received
+func (ti *TransferItem) Discrepancy() float64 {
+	if ti.ReceivedQuantity == nil {
+		return 0
+	}
+
+	return ti.Quantity - *ti.ReceivedQuantity
+}
+
+// StockMovementType is an enum for the reasons stock moves in or out of a location
+type StockMovementType string
+
+// StockMovementType enum values
+const (
+	StockTransferOut StockMovementType = "transfer_out"
+	StockTransferIn  StockMovementType = "transfer_in"
+)
+
+// StockMovement is an entity that represents a change in the stock of a product at a location,
+// negative when stock leaves the location
+type StockMovement struct {
+	ID         uint64
+	LocationID uint64
+	ProductID  uint64
+	Type       StockMovementType
+	Quantity   float64
+	TransferID uint64
+	UserID     uint64
+	CreatedAt  time.Time
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// TwoFactor is an entity that represents the TOTP two-factor authentication of a user.
+// It is enrolled with a secret first, and only enabled once it is confirmed with a code generated from the secret
+type TwoFactor struct {
+	UserID      uint64
+	Secret      string
+	ConfirmedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// IsEnabled checks whether the two-factor authentication has been confirmed
+func (tf *TwoFactor) IsEnabled() bool {
+	return tf.ConfirmedAt != nil
+}
+
+// TwoFactorEnrollment is an entity that represents the enrollment of a user in two-factor authentication.
+// URI is the otpauth:// provisioning URI an authenticator app adds the secret with, usually shown as a QR code,
+// and RecoveryCodes are the single-use codes the user can log in with instead, which are only shown once
+type TwoFactorEnrollment struct {
+	Secret        string
+	URI           string
+	RecoveryCodes []string
+}
+
+// TwoFactorChallenge is an entity that represents a login that has to be completed with a two-factor code
+// before the tokens are issued. Token is only set when the challenge is issued,
+// Subject identifies the credentials of the login in the login attempt counters, and
+// Enrollment is set instead of requiring an enabled two-factor authentication when the user has to enroll first
+type TwoFactorChallenge struct {
+	Token      string
+	UserID     uint64
+	Subject    string
+	Device     Device
+	ExpiresAt  time.Time
+	Enrollment *TwoFactorEnrollment
+}
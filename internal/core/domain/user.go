@@ -4,22 +4,24 @@ import (
 	"time"
 )
 
-// UserRole is an enum for user's role
+// UserRole is the name of the role a user is given
 type UserRole string
 
-// UserRole enum values
+// UserRole values of the built-in roles
 const (
 	Admin   UserRole = "admin"
 	Cashier UserRole = "cashier"
 )
 
-// User is an entity that represents a user
+// User is an entity that represents a user.
+// PIN is the hashed numeric PIN a cashier logs in with on a registered terminal, empty if not set
 type User struct {
 	ID        uint64
 	Name      string
 	Email     string
 	Password  string
 	Role      UserRole
+	PIN       string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
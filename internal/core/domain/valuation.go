@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// ValuationMethod is an enum for the method a product's stock is valued and costed with
+type ValuationMethod string
+
+// ValuationMethod enum values
+const (
+	ValuationAverage ValuationMethod = "average"
+	ValuationFIFO    ValuationMethod = "fifo"
+)
+
+// CostLayer is an entity that represents a received quantity of a product at its unit cost,
+// consumed in first-in-first-out order as the product is sold
+type CostLayer struct {
+	ID        uint64
+	ProductID uint64
+	Quantity  float64
+	Remaining float64
+	UnitCost  float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// StockValuation is an entity that represents the value of the stock on hand of a product
+type StockValuation struct {
+	ProductID       uint64
+	Name            string
+	Stock           float64
+	Unit            string
+	Method          ValuationMethod
+	AverageCost     float64
+	LayeredQuantity float64
+	LayeredValue    float64
+	Value           float64
+}
+
+// InventoryValuation is an entity that represents the value of the stock on hand of all products
+type InventoryValuation struct {
+	Products []StockValuation
+	Value    float64
+}
+
+// ProductMargin is an entity that represents the sales, cost and gross margin of a product over a period
+type ProductMargin struct {
+	ProductID uint64
+	Name      string
+	Quantity  float64
+	Revenue   float64
+	Cost      float64
+	Margin    float64
+}
+
+// MarginReport is an entity that represents the gross margin of the sales over a period
+type MarginReport struct {
+	From     time.Time
+	To       time.Time
+	Products []ProductMargin
+	Revenue  float64
+	Cost     float64
+	Margin   float64
+}
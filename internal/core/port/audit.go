@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=audit.go -destination=mock/audit.go -package=mock
+
+// AuditLogger is an interface for recording security-relevant events
+type AuditLogger interface {
+	// Record records an audit event
+	Record(ctx context.Context, event *domain.AuditEvent)
+}
@@ -2,22 +2,54 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=auth.go -destination=mock/auth.go -package=mock
 
 // TokenService is an interface for interacting with token-related business logic
 type TokenService interface {
-	// CreateToken creates a new token for a given user
-	CreateToken(user *domain.User) (string, error)
+	// CreateToken creates a new token for a given user with the permissions of their role and session
+	CreateToken(user *domain.User, permissions []domain.Permission, sessionID uuid.UUID) (string, error)
 	// VerifyToken verifies the token and returns the payload
 	VerifyToken(token string) (*domain.TokenPayload, error)
+	// CreateRefreshToken creates a new opaque refresh token and returns it with its expiration time
+	CreateRefreshToken() (string, time.Time, error)
+	// CreatePasswordResetToken creates a new opaque password reset token and returns it with its expiration time
+	CreatePasswordResetToken() (string, time.Time, error)
+	// PublicKeys returns the public keys verifying the tokens, if they are signed with asymmetric keys
+	PublicKeys() []domain.PublicKey
 }
 
 // UserService is an interface for interacting with user authentication-related business logic
 type AuthService interface {
-	// Login authenticates a user by email and password and returns a token
-	Login(ctx context.Context, email, password string) (string, error)
+	// Login authenticates a user by email and password and returns an access token and a refresh token for the device
+	Login(ctx context.Context, email, password string, device domain.Device) (*domain.AuthToken, error)
+	// Refresh replaces a refresh token with a new one and returns it with a new access token
+	Refresh(ctx context.Context, refreshToken string, device domain.Device) (*domain.AuthToken, error)
+	// CompleteTwoFactor completes a login challenged for a two-factor code and returns an access token and a refresh token for its device
+	CompleteTwoFactor(ctx context.Context, challengeToken, code string) (*domain.AuthToken, error)
+	// LoginWithPIN authenticates a user by PIN on a terminal identified by its credential and returns an access token and a refresh token for it
+	LoginWithPIN(ctx context.Context, terminalCredential string, userID uint64, pin string, device domain.Device) (*domain.AuthToken, error)
+	// Logout revokes an access token and the refresh tokens of its session
+	Logout(ctx context.Context, payload *domain.TokenPayload) error
+	// LogoutAll revokes all access tokens and refresh tokens of a user
+	LogoutAll(ctx context.Context, userID uint64) error
+	// EnrollTwoFactor starts the enrollment of a user in two-factor authentication
+	EnrollTwoFactor(ctx context.Context, userID uint64) (*domain.TwoFactorEnrollment, error)
+	// ConfirmTwoFactor enables the two-factor authentication of a user with a code from their authenticator app
+	ConfirmTwoFactor(ctx context.Context, userID uint64, code string) error
+	// DisableTwoFactor disables the two-factor authentication of a user with a two-factor code
+	DisableTwoFactor(ctx context.Context, userID uint64, code string) error
+	// RegenerateRecoveryCodes replaces the recovery codes of a user with new ones after checking a two-factor code
+	RegenerateRecoveryCodes(ctx context.Context, userID uint64, code string) ([]string, error)
+	// ResetTwoFactor removes the two-factor authentication of a user who lost access to it, on behalf of an admin
+	ResetTwoFactor(ctx context.Context, userID, actorID uint64) error
+	// UnlockLogin lifts the lockout after failed login attempts of a user, on behalf of an admin
+	UnlockLogin(ctx context.Context, userID, actorID uint64) error
+	// ListPublicKeys returns the public keys other services verify the access tokens with
+	ListPublicKeys(ctx context.Context) ([]domain.PublicKey, error)
 }
@@ -0,0 +1,37 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=barcode.go -destination=mock/barcode.go -package=mock
+
+// BarcodeRepository is an interface for interacting with barcode-related data
+type BarcodeRepository interface {
+	// CreateBarcode inserts a new barcode into the database
+	CreateBarcode(ctx context.Context, barcode *domain.Barcode) (*domain.Barcode, error)
+	// GetBarcodeByID selects a barcode by id
+	GetBarcodeByID(ctx context.Context, id uint64) (*domain.Barcode, error)
+	// GetBarcodeByCode selects a barcode by code
+	GetBarcodeByCode(ctx context.Context, code string) (*domain.Barcode, error)
+	// ListBarcodes selects a list of barcodes of a product
+	ListBarcodes(ctx context.Context, productID uint64) ([]domain.Barcode, error)
+	// DeleteBarcode deletes a barcode
+	DeleteBarcode(ctx context.Context, id uint64) error
+}
+
+// BarcodeService is an interface for interacting with barcode-related business logic
+type BarcodeService interface {
+	// CreateBarcode adds a new barcode to a product
+	CreateBarcode(ctx context.Context, barcode *domain.Barcode) (*domain.Barcode, error)
+	// GenerateBarcode adds a generated in-store barcode to a product
+	GenerateBarcode(ctx context.Context, productID uint64) (*domain.Barcode, error)
+	// ListBarcodes returns a list of barcodes of a product
+	ListBarcodes(ctx context.Context, productID uint64) ([]domain.Barcode, error)
+	// DeleteBarcode deletes a barcode
+	DeleteBarcode(ctx context.Context, id uint64) error
+	// ScanBarcode returns the product identified by a scanned barcode along with the quantity and price of the scanned item
+	ScanBarcode(ctx context.Context, code string) (*domain.BarcodeScan, error)
+}
@@ -11,8 +11,10 @@ import (
 type CacheRepository interface {
 	// Set stores the value in the cache
 	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
-	// Get retrieves the value from the cache
+	// Get retrieves the value from the cache, returning domain.ErrCacheMiss if the key is not found
 	Get(ctx context.Context, key string) ([]byte, error)
+	// Increment increments the counter stored in the cache, starting its ttl on the first increment
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
 	// Delete removes the value from the cache
 	Delete(ctx context.Context, key string) error
 	// DeleteByPrefix removes the value from the cache with the given prefix
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=catalog.go -destination=mock/catalog.go -package=mock
+
+// CatalogCodec is an interface for reading and writing product catalog files
+type CatalogCodec interface {
+	// DecodeCatalog reads the product rows of a catalog file
+	DecodeCatalog(catalog *domain.Catalog) ([]domain.CatalogRow, error)
+	// EncodeCatalog writes product rows into a catalog file in the given format
+	EncodeCatalog(format domain.CatalogFormat, rows []domain.CatalogRow) (*domain.Catalog, error)
+}
+
+// CatalogService is an interface for interacting with product catalog-related business logic
+type CatalogService interface {
+	// ImportCatalog validates the rows of a catalog file and, unless it is a dry run, creates or updates
+	// their products by SKU or barcode on behalf of a user, who must be allowed to edit prices to change them
+	ImportCatalog(ctx context.Context, catalog *domain.Catalog, dryRun bool, payload *domain.TokenPayload) (*domain.CatalogImport, error)
+	// ExportCatalog exports every product along with its current stock into a catalog file
+	ExportCatalog(ctx context.Context, format domain.CatalogFormat) (*domain.Catalog, error)
+}
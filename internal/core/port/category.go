@@ -14,10 +14,17 @@ type CategoryRepository interface {
 	CreateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error)
 	// GetCategoryByID selects a category by id
 	GetCategoryByID(ctx context.Context, id uint64) (*domain.Category, error)
+	// GetCategoryByName selects a category by name
+	GetCategoryByName(ctx context.Context, name string) (*domain.Category, error)
 	// ListCategories selects a list of categories with pagination
 	ListCategories(ctx context.Context, skip, limit uint64) ([]domain.Category, error)
+	// ListAllCategories selects every category ordered by name
+	ListAllCategories(ctx context.Context) ([]domain.Category, error)
 	// UpdateCategory updates a category
 	UpdateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error)
+	// UpdateCategoryParent moves a category under a parent category, or to the top level when the parent id is 0,
+	// unless the parent is the category or one of its sub-categories
+	UpdateCategoryParent(ctx context.Context, id, parentID uint64) (*domain.Category, error)
 	// DeleteCategory deletes a category
 	DeleteCategory(ctx context.Context, id uint64) error
 }
@@ -30,8 +37,12 @@ type CategoryService interface {
 	GetCategory(ctx context.Context, id uint64) (*domain.Category, error)
 	// ListCategories returns a list of categories with pagination
 	ListCategories(ctx context.Context, skip, limit uint64) ([]domain.Category, error)
+	// GetCategoryTree returns the top-level categories with their nested sub-categories
+	GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error)
 	// UpdateCategory updates a category
 	UpdateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error)
+	// MoveCategory moves a category under a parent category, or to the top level when the parent id is 0
+	MoveCategory(ctx context.Context, id, parentID uint64) (*domain.Category, error)
 	// DeleteCategory deletes a category
 	DeleteCategory(ctx context.Context, id uint64) error
 }
@@ -0,0 +1,39 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=image.go -destination=mock/image.go -package=mock
+
+// FileStorage is an interface for storing uploaded files
+type FileStorage interface {
+	// Put stores a file under its key, replacing any file already stored under it
+	Put(ctx context.Context, file *domain.File) error
+	// Get retrieves a stored file by key
+	Get(ctx context.Context, key string) (*domain.File, error)
+	// Delete deletes a stored file by key
+	Delete(ctx context.Context, key string) error
+	// URL returns the public URL a stored file is served at
+	URL(key string) string
+	// Key returns the key of the stored file served at a URL, if the URL is one of a stored file
+	Key(url string) (string, bool)
+}
+
+// ImageProcessor is an interface for reading uploaded images
+type ImageProcessor interface {
+	// Thumbnail checks that an image is in a supported format and generates its thumbnail in the same format
+	Thumbnail(content []byte) (*domain.File, error)
+}
+
+// ImageService is an interface for interacting with image-related business logic
+type ImageService interface {
+	// UploadProductImage stores an image and its thumbnail as the image of a product
+	UploadProductImage(ctx context.Context, productID uint64, content []byte) (*domain.Product, error)
+	// UploadPaymentLogo stores an image and its thumbnail as the logo of a payment
+	UploadPaymentLogo(ctx context.Context, paymentID uint64, content []byte) (*domain.Payment, error)
+	// GetImage returns a stored image or thumbnail by key
+	GetImage(ctx context.Context, key string) (*domain.File, error)
+}
@@ -0,0 +1,25 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=label.go -destination=mock/label.go -package=mock
+
+// LabelRenderer is an interface for rendering barcodes and shelf labels
+type LabelRenderer interface {
+	// RenderBarcode renders a code as a barcode image in the given symbology and format
+	RenderBarcode(symbology domain.BarcodeSymbology, code string, format domain.LabelFormat) (*domain.Label, error)
+	// RenderShelfLabels renders a printable sheet of shelf labels
+	RenderShelfLabels(labels []domain.ShelfLabel) (*domain.Label, error)
+}
+
+// LabelService is an interface for interacting with label-related business logic
+type LabelService interface {
+	// GetBarcodeLabel renders the barcode of a product
+	GetBarcodeLabel(ctx context.Context, productID uint64, symbology domain.BarcodeSymbology, format domain.LabelFormat) (*domain.Label, error)
+	// GetShelfLabels renders a sheet of shelf labels for the given products, or for the products of a category
+	GetShelfLabels(ctx context.Context, productIDs []uint64, categoryID uint64, symbology domain.BarcodeSymbology) (*domain.Label, error)
+}
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=location.go -destination=mock/location.go -package=mock
+
+// LocationRepository is an interface for interacting with location-related data
+type LocationRepository interface {
+	// CreateLocation inserts a new location into the database
+	CreateLocation(ctx context.Context, location *domain.Location) (*domain.Location, error)
+	// GetLocationByID selects a location by id
+	GetLocationByID(ctx context.Context, id uint64) (*domain.Location, error)
+	// ListLocations selects a list of locations with pagination
+	ListLocations(ctx context.Context, skip, limit uint64) ([]domain.Location, error)
+	// ListLocationStocks selects a list of the products in stock at a location with pagination
+	ListLocationStocks(ctx context.Context, location *domain.Location, skip, limit uint64) ([]domain.LocationStock, error)
+}
+
+// LocationService is an interface for interacting with location-related business logic
+type LocationService interface {
+	// CreateLocation creates a new location
+	CreateLocation(ctx context.Context, location *domain.Location) (*domain.Location, error)
+	// ListLocations returns a list of locations with pagination
+	ListLocations(ctx context.Context, skip, limit uint64) ([]domain.Location, error)
+	// ListLocationStocks returns a list of the products in stock at a location with pagination
+	ListLocationStocks(ctx context.Context, id, skip, limit uint64) ([]domain.LocationStock, error)
+}
@@ -0,0 +1,34 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=lot.go -destination=mock/lot.go -package=mock
+
+// LotRepository is an interface for interacting with lot-related data
+type LotRepository interface {
+	// CreateLot inserts a new lot into the database and adds its quantity at the given unit cost to the product stock
+	CreateLot(ctx context.Context, lot *domain.Lot, unitCost float64) (*domain.Lot, error)
+	// GetLotByID selects a lot by id
+	GetLotByID(ctx context.Context, id uint64) (*domain.Lot, error)
+	// ListLots selects a list of lots of a product with pagination
+	ListLots(ctx context.Context, productID, skip, limit uint64) ([]domain.Lot, error)
+	// ListExpiringLots selects a list of lots with remaining quantity that expire before the given time
+	ListExpiringLots(ctx context.Context, before time.Time, skip, limit uint64) ([]domain.Lot, error)
+}
+
+// LotService is an interface for interacting with lot-related business logic
+type LotService interface {
+	// CreateLot receives a new lot of a lot-tracked product, with its quantity given in the unit of measure at a cost per that unit
+	CreateLot(ctx context.Context, lot *domain.Lot, unit string, cost float64) (*domain.Lot, error)
+	// GetLot returns a lot by id
+	GetLot(ctx context.Context, id uint64) (*domain.Lot, error)
+	// ListLots returns a list of lots of a product with pagination
+	ListLots(ctx context.Context, productID, skip, limit uint64) ([]domain.Lot, error)
+	// ListExpiringLots returns a list of lots that expire within the given number of days
+	ListExpiringLots(ctx context.Context, days, skip, limit uint64) ([]domain.Lot, error)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit.go -destination=mock/audit.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditLogger is a mock of AuditLogger interface.
+type MockAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLoggerMockRecorder
+}
+
+// MockAuditLoggerMockRecorder is the mock recorder for MockAuditLogger.
+type MockAuditLoggerMockRecorder struct {
+	mock *MockAuditLogger
+}
+
+// NewMockAuditLogger creates a new mock instance.
+func NewMockAuditLogger(ctrl *gomock.Controller) *MockAuditLogger {
+	mock := &MockAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogger) EXPECT() *MockAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockAuditLogger) Record(ctx context.Context, event *domain.AuditEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", ctx, event)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditLoggerMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditLogger)(nil).Record), ctx, event)
+}
@@ -12,8 +12,10 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,19 +42,65 @@ func (m *MockTokenService) EXPECT() *MockTokenServiceMockRecorder {
 	return m.recorder
 }
 
+// CreatePasswordResetToken mocks base method.
+func (m *MockTokenService) CreatePasswordResetToken() (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockTokenServiceMockRecorder) CreatePasswordResetToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockTokenService)(nil).CreatePasswordResetToken))
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockTokenService) CreateRefreshToken() (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockTokenServiceMockRecorder) CreateRefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockTokenService)(nil).CreateRefreshToken))
+}
+
 // CreateToken mocks base method.
-func (m *MockTokenService) CreateToken(user *domain.User) (string, error) {
+func (m *MockTokenService) CreateToken(user *domain.User, permissions []domain.Permission, sessionID uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateToken", user)
+	ret := m.ctrl.Call(m, "CreateToken", user, permissions, sessionID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateToken indicates an expected call of CreateToken.
-func (mr *MockTokenServiceMockRecorder) CreateToken(user any) *gomock.Call {
+func (mr *MockTokenServiceMockRecorder) CreateToken(user, permissions, sessionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockTokenService)(nil).CreateToken), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockTokenService)(nil).CreateToken), user, permissions, sessionID)
+}
+
+// PublicKeys mocks base method.
+func (m *MockTokenService) PublicKeys() []domain.PublicKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys")
+	ret0, _ := ret[0].([]domain.PublicKey)
+	return ret0
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockTokenServiceMockRecorder) PublicKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockTokenService)(nil).PublicKeys))
 }
 
 // VerifyToken mocks base method.
@@ -93,17 +141,191 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 	return m.recorder
 }
 
+// CompleteTwoFactor mocks base method.
+func (m *MockAuthService) CompleteTwoFactor(ctx context.Context, challengeToken, code string) (*domain.AuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTwoFactor", ctx, challengeToken, code)
+	ret0, _ := ret[0].(*domain.AuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTwoFactor indicates an expected call of CompleteTwoFactor.
+func (mr *MockAuthServiceMockRecorder) CompleteTwoFactor(ctx, challengeToken, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTwoFactor", reflect.TypeOf((*MockAuthService)(nil).CompleteTwoFactor), ctx, challengeToken, code)
+}
+
+// ConfirmTwoFactor mocks base method.
+func (m *MockAuthService) ConfirmTwoFactor(ctx context.Context, userID uint64, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactor", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTwoFactor indicates an expected call of ConfirmTwoFactor.
+func (mr *MockAuthServiceMockRecorder) ConfirmTwoFactor(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactor", reflect.TypeOf((*MockAuthService)(nil).ConfirmTwoFactor), ctx, userID, code)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockAuthService) DisableTwoFactor(ctx context.Context, userID uint64, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockAuthServiceMockRecorder) DisableTwoFactor(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockAuthService)(nil).DisableTwoFactor), ctx, userID, code)
+}
+
+// EnrollTwoFactor mocks base method.
+func (m *MockAuthService) EnrollTwoFactor(ctx context.Context, userID uint64) (*domain.TwoFactorEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(*domain.TwoFactorEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTwoFactor indicates an expected call of EnrollTwoFactor.
+func (mr *MockAuthServiceMockRecorder) EnrollTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTwoFactor", reflect.TypeOf((*MockAuthService)(nil).EnrollTwoFactor), ctx, userID)
+}
+
+// ListPublicKeys mocks base method.
+func (m *MockAuthService) ListPublicKeys(ctx context.Context) ([]domain.PublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicKeys", ctx)
+	ret0, _ := ret[0].([]domain.PublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicKeys indicates an expected call of ListPublicKeys.
+func (mr *MockAuthServiceMockRecorder) ListPublicKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicKeys", reflect.TypeOf((*MockAuthService)(nil).ListPublicKeys), ctx)
+}
+
 // Login mocks base method.
-func (m *MockAuthService) Login(ctx context.Context, email, password string) (string, error) {
+func (m *MockAuthService) Login(ctx context.Context, email, password string, device domain.Device) (*domain.AuthToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, email, password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Login", ctx, email, password, device)
+	ret0, _ := ret[0].(*domain.AuthToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockAuthServiceMockRecorder) Login(ctx, email, password any) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) Login(ctx, email, password, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, email, password, device)
+}
+
+// LoginWithPIN mocks base method.
+func (m *MockAuthService) LoginWithPIN(ctx context.Context, terminalCredential string, userID uint64, pin string, device domain.Device) (*domain.AuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginWithPIN", ctx, terminalCredential, userID, pin, device)
+	ret0, _ := ret[0].(*domain.AuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginWithPIN indicates an expected call of LoginWithPIN.
+func (mr *MockAuthServiceMockRecorder) LoginWithPIN(ctx, terminalCredential, userID, pin, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithPIN", reflect.TypeOf((*MockAuthService)(nil).LoginWithPIN), ctx, terminalCredential, userID, pin, device)
+}
+
+// Logout mocks base method.
+func (m *MockAuthService) Logout(ctx context.Context, payload *domain.TokenPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(ctx, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), ctx, payload)
+}
+
+// LogoutAll mocks base method.
+func (m *MockAuthService) LogoutAll(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutAll", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutAll indicates an expected call of LogoutAll.
+func (mr *MockAuthServiceMockRecorder) LogoutAll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockAuthService)(nil).LogoutAll), ctx, userID)
+}
+
+// Refresh mocks base method.
+func (m *MockAuthService) Refresh(ctx context.Context, refreshToken string, device domain.Device) (*domain.AuthToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken, device)
+	ret0, _ := ret[0].(*domain.AuthToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthServiceMockRecorder) Refresh(ctx, refreshToken, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthService)(nil).Refresh), ctx, refreshToken, device)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockAuthService) RegenerateRecoveryCodes(ctx context.Context, userID uint64, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", ctx, userID, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockAuthServiceMockRecorder) RegenerateRecoveryCodes(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockAuthService)(nil).RegenerateRecoveryCodes), ctx, userID, code)
+}
+
+// ResetTwoFactor mocks base method.
+func (m *MockAuthService) ResetTwoFactor(ctx context.Context, userID, actorID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetTwoFactor", ctx, userID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetTwoFactor indicates an expected call of ResetTwoFactor.
+func (mr *MockAuthServiceMockRecorder) ResetTwoFactor(ctx, userID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetTwoFactor", reflect.TypeOf((*MockAuthService)(nil).ResetTwoFactor), ctx, userID, actorID)
+}
+
+// UnlockLogin mocks base method.
+func (m *MockAuthService) UnlockLogin(ctx context.Context, userID, actorID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockLogin", ctx, userID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockLogin indicates an expected call of UnlockLogin.
+func (mr *MockAuthServiceMockRecorder) UnlockLogin(ctx, userID, actorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockLogin", reflect.TypeOf((*MockAuthService)(nil).UnlockLogin), ctx, userID, actorID)
 }
@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: barcode.go
+//
+// Generated by this command:
+//
+//	mockgen -source=barcode.go -destination=mock/barcode.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBarcodeRepository is a mock of BarcodeRepository interface.
+type MockBarcodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBarcodeRepositoryMockRecorder
+}
+
+// MockBarcodeRepositoryMockRecorder is the mock recorder for MockBarcodeRepository.
+type MockBarcodeRepositoryMockRecorder struct {
+	mock *MockBarcodeRepository
+}
+
+// NewMockBarcodeRepository creates a new mock instance.
+func NewMockBarcodeRepository(ctrl *gomock.Controller) *MockBarcodeRepository {
+	mock := &MockBarcodeRepository{ctrl: ctrl}
+	mock.recorder = &MockBarcodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBarcodeRepository) EXPECT() *MockBarcodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBarcode mocks base method.
+func (m *MockBarcodeRepository) CreateBarcode(ctx context.Context, barcode *domain.Barcode) (*domain.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*domain.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBarcode indicates an expected call of CreateBarcode.
+func (mr *MockBarcodeRepositoryMockRecorder) CreateBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBarcode", reflect.TypeOf((*MockBarcodeRepository)(nil).CreateBarcode), ctx, barcode)
+}
+
+// DeleteBarcode mocks base method.
+func (m *MockBarcodeRepository) DeleteBarcode(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBarcode", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBarcode indicates an expected call of DeleteBarcode.
+func (mr *MockBarcodeRepositoryMockRecorder) DeleteBarcode(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBarcode", reflect.TypeOf((*MockBarcodeRepository)(nil).DeleteBarcode), ctx, id)
+}
+
+// GetBarcodeByCode mocks base method.
+func (m *MockBarcodeRepository) GetBarcodeByCode(ctx context.Context, code string) (*domain.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBarcodeByCode", ctx, code)
+	ret0, _ := ret[0].(*domain.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBarcodeByCode indicates an expected call of GetBarcodeByCode.
+func (mr *MockBarcodeRepositoryMockRecorder) GetBarcodeByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBarcodeByCode", reflect.TypeOf((*MockBarcodeRepository)(nil).GetBarcodeByCode), ctx, code)
+}
+
+// GetBarcodeByID mocks base method.
+func (m *MockBarcodeRepository) GetBarcodeByID(ctx context.Context, id uint64) (*domain.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBarcodeByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBarcodeByID indicates an expected call of GetBarcodeByID.
+func (mr *MockBarcodeRepositoryMockRecorder) GetBarcodeByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBarcodeByID", reflect.TypeOf((*MockBarcodeRepository)(nil).GetBarcodeByID), ctx, id)
+}
+
+// ListBarcodes mocks base method.
+func (m *MockBarcodeRepository) ListBarcodes(ctx context.Context, productID uint64) ([]domain.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBarcodes", ctx, productID)
+	ret0, _ := ret[0].([]domain.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBarcodes indicates an expected call of ListBarcodes.
+func (mr *MockBarcodeRepositoryMockRecorder) ListBarcodes(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBarcodes", reflect.TypeOf((*MockBarcodeRepository)(nil).ListBarcodes), ctx, productID)
+}
+
+// MockBarcodeService is a mock of BarcodeService interface.
+type MockBarcodeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBarcodeServiceMockRecorder
+}
+
+// MockBarcodeServiceMockRecorder is the mock recorder for MockBarcodeService.
+type MockBarcodeServiceMockRecorder struct {
+	mock *MockBarcodeService
+}
+
+// NewMockBarcodeService creates a new mock instance.
+func NewMockBarcodeService(ctrl *gomock.Controller) *MockBarcodeService {
+	mock := &MockBarcodeService{ctrl: ctrl}
+	mock.recorder = &MockBarcodeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBarcodeService) EXPECT() *MockBarcodeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateBarcode mocks base method.
+func (m *MockBarcodeService) CreateBarcode(ctx context.Context, barcode *domain.Barcode) (*domain.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*domain.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBarcode indicates an expected call of CreateBarcode.
+func (mr *MockBarcodeServiceMockRecorder) CreateBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBarcode", reflect.TypeOf((*MockBarcodeService)(nil).CreateBarcode), ctx, barcode)
+}
+
+// DeleteBarcode mocks base method.
+func (m *MockBarcodeService) DeleteBarcode(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBarcode", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBarcode indicates an expected call of DeleteBarcode.
+func (mr *MockBarcodeServiceMockRecorder) DeleteBarcode(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBarcode", reflect.TypeOf((*MockBarcodeService)(nil).DeleteBarcode), ctx, id)
+}
+
+// GenerateBarcode mocks base method.
+func (m *MockBarcodeService) GenerateBarcode(ctx context.Context, productID uint64) (*domain.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateBarcode", ctx, productID)
+	ret0, _ := ret[0].(*domain.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateBarcode indicates an expected call of GenerateBarcode.
+func (mr *MockBarcodeServiceMockRecorder) GenerateBarcode(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateBarcode", reflect.TypeOf((*MockBarcodeService)(nil).GenerateBarcode), ctx, productID)
+}
+
+// ListBarcodes mocks base method.
+func (m *MockBarcodeService) ListBarcodes(ctx context.Context, productID uint64) ([]domain.Barcode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBarcodes", ctx, productID)
+	ret0, _ := ret[0].([]domain.Barcode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBarcodes indicates an expected call of ListBarcodes.
+func (mr *MockBarcodeServiceMockRecorder) ListBarcodes(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBarcodes", reflect.TypeOf((*MockBarcodeService)(nil).ListBarcodes), ctx, productID)
+}
+
+// ScanBarcode mocks base method.
+func (m *MockBarcodeService) ScanBarcode(ctx context.Context, code string) (*domain.BarcodeScan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanBarcode", ctx, code)
+	ret0, _ := ret[0].(*domain.BarcodeScan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanBarcode indicates an expected call of ScanBarcode.
+func (mr *MockBarcodeServiceMockRecorder) ScanBarcode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanBarcode", reflect.TypeOf((*MockBarcodeService)(nil).ScanBarcode), ctx, code)
+}
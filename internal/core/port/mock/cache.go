@@ -97,6 +97,21 @@ func (mr *MockCacheRepositoryMockRecorder) Get(ctx, key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCacheRepository)(nil).Get), ctx, key)
 }
 
+// Increment mocks base method.
+func (m *MockCacheRepository) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, key, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockCacheRepositoryMockRecorder) Increment(ctx, key, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockCacheRepository)(nil).Increment), ctx, key, ttl)
+}
+
 // Set mocks base method.
 func (m *MockCacheRepository) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
 	m.ctrl.T.Helper()
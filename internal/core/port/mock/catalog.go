@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: catalog.go
+//
+// Generated by this command:
+//
+//	mockgen -source=catalog.go -destination=mock/catalog.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCatalogCodec is a mock of CatalogCodec interface.
+type MockCatalogCodec struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogCodecMockRecorder
+}
+
+// MockCatalogCodecMockRecorder is the mock recorder for MockCatalogCodec.
+type MockCatalogCodecMockRecorder struct {
+	mock *MockCatalogCodec
+}
+
+// NewMockCatalogCodec creates a new mock instance.
+func NewMockCatalogCodec(ctrl *gomock.Controller) *MockCatalogCodec {
+	mock := &MockCatalogCodec{ctrl: ctrl}
+	mock.recorder = &MockCatalogCodecMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogCodec) EXPECT() *MockCatalogCodecMockRecorder {
+	return m.recorder
+}
+
+// DecodeCatalog mocks base method.
+func (m *MockCatalogCodec) DecodeCatalog(catalog *domain.Catalog) ([]domain.CatalogRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeCatalog", catalog)
+	ret0, _ := ret[0].([]domain.CatalogRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecodeCatalog indicates an expected call of DecodeCatalog.
+func (mr *MockCatalogCodecMockRecorder) DecodeCatalog(catalog any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeCatalog", reflect.TypeOf((*MockCatalogCodec)(nil).DecodeCatalog), catalog)
+}
+
+// EncodeCatalog mocks base method.
+func (m *MockCatalogCodec) EncodeCatalog(format domain.CatalogFormat, rows []domain.CatalogRow) (*domain.Catalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncodeCatalog", format, rows)
+	ret0, _ := ret[0].(*domain.Catalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncodeCatalog indicates an expected call of EncodeCatalog.
+func (mr *MockCatalogCodecMockRecorder) EncodeCatalog(format, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncodeCatalog", reflect.TypeOf((*MockCatalogCodec)(nil).EncodeCatalog), format, rows)
+}
+
+// MockCatalogService is a mock of CatalogService interface.
+type MockCatalogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogServiceMockRecorder
+}
+
+// MockCatalogServiceMockRecorder is the mock recorder for MockCatalogService.
+type MockCatalogServiceMockRecorder struct {
+	mock *MockCatalogService
+}
+
+// NewMockCatalogService creates a new mock instance.
+func NewMockCatalogService(ctrl *gomock.Controller) *MockCatalogService {
+	mock := &MockCatalogService{ctrl: ctrl}
+	mock.recorder = &MockCatalogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogService) EXPECT() *MockCatalogServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportCatalog mocks base method.
+func (m *MockCatalogService) ExportCatalog(ctx context.Context, format domain.CatalogFormat) (*domain.Catalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCatalog", ctx, format)
+	ret0, _ := ret[0].(*domain.Catalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCatalog indicates an expected call of ExportCatalog.
+func (mr *MockCatalogServiceMockRecorder) ExportCatalog(ctx, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCatalog", reflect.TypeOf((*MockCatalogService)(nil).ExportCatalog), ctx, format)
+}
+
+// ImportCatalog mocks base method.
+func (m *MockCatalogService) ImportCatalog(ctx context.Context, catalog *domain.Catalog, dryRun bool, payload *domain.TokenPayload) (*domain.CatalogImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCatalog", ctx, catalog, dryRun, payload)
+	ret0, _ := ret[0].(*domain.CatalogImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCatalog indicates an expected call of ImportCatalog.
+func (mr *MockCatalogServiceMockRecorder) ImportCatalog(ctx, catalog, dryRun, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCatalog", reflect.TypeOf((*MockCatalogService)(nil).ImportCatalog), ctx, catalog, dryRun, payload)
+}
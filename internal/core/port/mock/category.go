@@ -84,6 +84,36 @@ func (mr *MockCategoryRepositoryMockRecorder) GetCategoryByID(ctx, id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockCategoryRepository)(nil).GetCategoryByID), ctx, id)
 }
 
+// GetCategoryByName mocks base method.
+func (m *MockCategoryRepository) GetCategoryByName(ctx context.Context, name string) (*domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByName", ctx, name)
+	ret0, _ := ret[0].(*domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByName indicates an expected call of GetCategoryByName.
+func (mr *MockCategoryRepositoryMockRecorder) GetCategoryByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByName", reflect.TypeOf((*MockCategoryRepository)(nil).GetCategoryByName), ctx, name)
+}
+
+// ListAllCategories mocks base method.
+func (m *MockCategoryRepository) ListAllCategories(ctx context.Context) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllCategories", ctx)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllCategories indicates an expected call of ListAllCategories.
+func (mr *MockCategoryRepositoryMockRecorder) ListAllCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllCategories", reflect.TypeOf((*MockCategoryRepository)(nil).ListAllCategories), ctx)
+}
+
 // ListCategories mocks base method.
 func (m *MockCategoryRepository) ListCategories(ctx context.Context, skip, limit uint64) ([]domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +144,21 @@ func (mr *MockCategoryRepositoryMockRecorder) UpdateCategory(ctx, category any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateCategory), ctx, category)
 }
 
+// UpdateCategoryParent mocks base method.
+func (m *MockCategoryRepository) UpdateCategoryParent(ctx context.Context, id, parentID uint64) (*domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategoryParent", ctx, id, parentID)
+	ret0, _ := ret[0].(*domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategoryParent indicates an expected call of UpdateCategoryParent.
+func (mr *MockCategoryRepositoryMockRecorder) UpdateCategoryParent(ctx, id, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryParent", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateCategoryParent), ctx, id, parentID)
+}
+
 // MockCategoryService is a mock of CategoryService interface.
 type MockCategoryService struct {
 	ctrl     *gomock.Controller
@@ -181,6 +226,21 @@ func (mr *MockCategoryServiceMockRecorder) GetCategory(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockCategoryService)(nil).GetCategory), ctx, id)
 }
 
+// GetCategoryTree mocks base method.
+func (m *MockCategoryService) GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTree", ctx)
+	ret0, _ := ret[0].([]domain.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTree indicates an expected call of GetCategoryTree.
+func (mr *MockCategoryServiceMockRecorder) GetCategoryTree(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTree", reflect.TypeOf((*MockCategoryService)(nil).GetCategoryTree), ctx)
+}
+
 // ListCategories mocks base method.
 func (m *MockCategoryService) ListCategories(ctx context.Context, skip, limit uint64) ([]domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +256,21 @@ func (mr *MockCategoryServiceMockRecorder) ListCategories(ctx, skip, limit any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCategoryService)(nil).ListCategories), ctx, skip, limit)
 }
 
+// MoveCategory mocks base method.
+func (m *MockCategoryService) MoveCategory(ctx context.Context, id, parentID uint64) (*domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCategory", ctx, id, parentID)
+	ret0, _ := ret[0].(*domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCategory indicates an expected call of MoveCategory.
+func (mr *MockCategoryServiceMockRecorder) MoveCategory(ctx, id, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCategory", reflect.TypeOf((*MockCategoryService)(nil).MoveCategory), ctx, id, parentID)
+}
+
 // UpdateCategory mocks base method.
 func (m *MockCategoryService) UpdateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: image.go
+//
+// Generated by this command:
+//
+//	mockgen -source=image.go -destination=mock/image.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFileStorage is a mock of FileStorage interface.
+type MockFileStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileStorageMockRecorder
+}
+
+// MockFileStorageMockRecorder is the mock recorder for MockFileStorage.
+type MockFileStorageMockRecorder struct {
+	mock *MockFileStorage
+}
+
+// NewMockFileStorage creates a new mock instance.
+func NewMockFileStorage(ctrl *gomock.Controller) *MockFileStorage {
+	mock := &MockFileStorage{ctrl: ctrl}
+	mock.recorder = &MockFileStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileStorage) EXPECT() *MockFileStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFileStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFileStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFileStorage)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockFileStorage) Get(ctx context.Context, key string) (*domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(*domain.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFileStorageMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFileStorage)(nil).Get), ctx, key)
+}
+
+// Key mocks base method.
+func (m *MockFileStorage) Key(url string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", url)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockFileStorageMockRecorder) Key(url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockFileStorage)(nil).Key), url)
+}
+
+// Put mocks base method.
+func (m *MockFileStorage) Put(ctx context.Context, file *domain.File) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockFileStorageMockRecorder) Put(ctx, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockFileStorage)(nil).Put), ctx, file)
+}
+
+// URL mocks base method.
+func (m *MockFileStorage) URL(key string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URL", key)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URL indicates an expected call of URL.
+func (mr *MockFileStorageMockRecorder) URL(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URL", reflect.TypeOf((*MockFileStorage)(nil).URL), key)
+}
+
+// MockImageProcessor is a mock of ImageProcessor interface.
+type MockImageProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageProcessorMockRecorder
+}
+
+// MockImageProcessorMockRecorder is the mock recorder for MockImageProcessor.
+type MockImageProcessorMockRecorder struct {
+	mock *MockImageProcessor
+}
+
+// NewMockImageProcessor creates a new mock instance.
+func NewMockImageProcessor(ctrl *gomock.Controller) *MockImageProcessor {
+	mock := &MockImageProcessor{ctrl: ctrl}
+	mock.recorder = &MockImageProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageProcessor) EXPECT() *MockImageProcessorMockRecorder {
+	return m.recorder
+}
+
+// Thumbnail mocks base method.
+func (m *MockImageProcessor) Thumbnail(content []byte) (*domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Thumbnail", content)
+	ret0, _ := ret[0].(*domain.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Thumbnail indicates an expected call of Thumbnail.
+func (mr *MockImageProcessorMockRecorder) Thumbnail(content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Thumbnail", reflect.TypeOf((*MockImageProcessor)(nil).Thumbnail), content)
+}
+
+// MockImageService is a mock of ImageService interface.
+type MockImageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageServiceMockRecorder
+}
+
+// MockImageServiceMockRecorder is the mock recorder for MockImageService.
+type MockImageServiceMockRecorder struct {
+	mock *MockImageService
+}
+
+// NewMockImageService creates a new mock instance.
+func NewMockImageService(ctrl *gomock.Controller) *MockImageService {
+	mock := &MockImageService{ctrl: ctrl}
+	mock.recorder = &MockImageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageService) EXPECT() *MockImageServiceMockRecorder {
+	return m.recorder
+}
+
+// GetImage mocks base method.
+func (m *MockImageService) GetImage(ctx context.Context, key string) (*domain.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImage", ctx, key)
+	ret0, _ := ret[0].(*domain.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImage indicates an expected call of GetImage.
+func (mr *MockImageServiceMockRecorder) GetImage(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockImageService)(nil).GetImage), ctx, key)
+}
+
+// UploadPaymentLogo mocks base method.
+func (m *MockImageService) UploadPaymentLogo(ctx context.Context, paymentID uint64, content []byte) (*domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPaymentLogo", ctx, paymentID, content)
+	ret0, _ := ret[0].(*domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPaymentLogo indicates an expected call of UploadPaymentLogo.
+func (mr *MockImageServiceMockRecorder) UploadPaymentLogo(ctx, paymentID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPaymentLogo", reflect.TypeOf((*MockImageService)(nil).UploadPaymentLogo), ctx, paymentID, content)
+}
+
+// UploadProductImage mocks base method.
+func (m *MockImageService) UploadProductImage(ctx context.Context, productID uint64, content []byte) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadProductImage", ctx, productID, content)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadProductImage indicates an expected call of UploadProductImage.
+func (mr *MockImageServiceMockRecorder) UploadProductImage(ctx, productID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadProductImage", reflect.TypeOf((*MockImageService)(nil).UploadProductImage), ctx, productID, content)
+}
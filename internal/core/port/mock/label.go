@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: label.go
+//
+// Generated by this command:
+//
+//	mockgen -source=label.go -destination=mock/label.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLabelRenderer is a mock of LabelRenderer interface.
+type MockLabelRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelRendererMockRecorder
+}
+
+// MockLabelRendererMockRecorder is the mock recorder for MockLabelRenderer.
+type MockLabelRendererMockRecorder struct {
+	mock *MockLabelRenderer
+}
+
+// NewMockLabelRenderer creates a new mock instance.
+func NewMockLabelRenderer(ctrl *gomock.Controller) *MockLabelRenderer {
+	mock := &MockLabelRenderer{ctrl: ctrl}
+	mock.recorder = &MockLabelRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelRenderer) EXPECT() *MockLabelRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderBarcode mocks base method.
+func (m *MockLabelRenderer) RenderBarcode(symbology domain.BarcodeSymbology, code string, format domain.LabelFormat) (*domain.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderBarcode", symbology, code, format)
+	ret0, _ := ret[0].(*domain.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderBarcode indicates an expected call of RenderBarcode.
+func (mr *MockLabelRendererMockRecorder) RenderBarcode(symbology, code, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderBarcode", reflect.TypeOf((*MockLabelRenderer)(nil).RenderBarcode), symbology, code, format)
+}
+
+// RenderShelfLabels mocks base method.
+func (m *MockLabelRenderer) RenderShelfLabels(labels []domain.ShelfLabel) (*domain.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderShelfLabels", labels)
+	ret0, _ := ret[0].(*domain.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderShelfLabels indicates an expected call of RenderShelfLabels.
+func (mr *MockLabelRendererMockRecorder) RenderShelfLabels(labels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderShelfLabels", reflect.TypeOf((*MockLabelRenderer)(nil).RenderShelfLabels), labels)
+}
+
+// MockLabelService is a mock of LabelService interface.
+type MockLabelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelServiceMockRecorder
+}
+
+// MockLabelServiceMockRecorder is the mock recorder for MockLabelService.
+type MockLabelServiceMockRecorder struct {
+	mock *MockLabelService
+}
+
+// NewMockLabelService creates a new mock instance.
+func NewMockLabelService(ctrl *gomock.Controller) *MockLabelService {
+	mock := &MockLabelService{ctrl: ctrl}
+	mock.recorder = &MockLabelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelService) EXPECT() *MockLabelServiceMockRecorder {
+	return m.recorder
+}
+
+// GetBarcodeLabel mocks base method.
+func (m *MockLabelService) GetBarcodeLabel(ctx context.Context, productID uint64, symbology domain.BarcodeSymbology, format domain.LabelFormat) (*domain.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBarcodeLabel", ctx, productID, symbology, format)
+	ret0, _ := ret[0].(*domain.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBarcodeLabel indicates an expected call of GetBarcodeLabel.
+func (mr *MockLabelServiceMockRecorder) GetBarcodeLabel(ctx, productID, symbology, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBarcodeLabel", reflect.TypeOf((*MockLabelService)(nil).GetBarcodeLabel), ctx, productID, symbology, format)
+}
+
+// GetShelfLabels mocks base method.
+func (m *MockLabelService) GetShelfLabels(ctx context.Context, productIDs []uint64, categoryID uint64, symbology domain.BarcodeSymbology) (*domain.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShelfLabels", ctx, productIDs, categoryID, symbology)
+	ret0, _ := ret[0].(*domain.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShelfLabels indicates an expected call of GetShelfLabels.
+func (mr *MockLabelServiceMockRecorder) GetShelfLabels(ctx, productIDs, categoryID, symbology any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShelfLabels", reflect.TypeOf((*MockLabelService)(nil).GetShelfLabels), ctx, productIDs, categoryID, symbology)
+}
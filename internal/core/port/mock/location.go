@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: location.go
+//
+// Generated by this command:
+//
+//	mockgen -source=location.go -destination=mock/location.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLocationRepository is a mock of LocationRepository interface.
+type MockLocationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationRepositoryMockRecorder
+}
+
+// MockLocationRepositoryMockRecorder is the mock recorder for MockLocationRepository.
+type MockLocationRepositoryMockRecorder struct {
+	mock *MockLocationRepository
+}
+
+// NewMockLocationRepository creates a new mock instance.
+func NewMockLocationRepository(ctrl *gomock.Controller) *MockLocationRepository {
+	mock := &MockLocationRepository{ctrl: ctrl}
+	mock.recorder = &MockLocationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationRepository) EXPECT() *MockLocationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateLocation mocks base method.
+func (m *MockLocationRepository) CreateLocation(ctx context.Context, location *domain.Location) (*domain.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocation", ctx, location)
+	ret0, _ := ret[0].(*domain.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocation indicates an expected call of CreateLocation.
+func (mr *MockLocationRepositoryMockRecorder) CreateLocation(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocation", reflect.TypeOf((*MockLocationRepository)(nil).CreateLocation), ctx, location)
+}
+
+// GetLocationByID mocks base method.
+func (m *MockLocationRepository) GetLocationByID(ctx context.Context, id uint64) (*domain.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocationByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocationByID indicates an expected call of GetLocationByID.
+func (mr *MockLocationRepositoryMockRecorder) GetLocationByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationByID", reflect.TypeOf((*MockLocationRepository)(nil).GetLocationByID), ctx, id)
+}
+
+// ListLocationStocks mocks base method.
+func (m *MockLocationRepository) ListLocationStocks(ctx context.Context, location *domain.Location, skip, limit uint64) ([]domain.LocationStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocationStocks", ctx, location, skip, limit)
+	ret0, _ := ret[0].([]domain.LocationStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocationStocks indicates an expected call of ListLocationStocks.
+func (mr *MockLocationRepositoryMockRecorder) ListLocationStocks(ctx, location, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocationStocks", reflect.TypeOf((*MockLocationRepository)(nil).ListLocationStocks), ctx, location, skip, limit)
+}
+
+// ListLocations mocks base method.
+func (m *MockLocationRepository) ListLocations(ctx context.Context, skip, limit uint64) ([]domain.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocations", ctx, skip, limit)
+	ret0, _ := ret[0].([]domain.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocations indicates an expected call of ListLocations.
+func (mr *MockLocationRepositoryMockRecorder) ListLocations(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocations", reflect.TypeOf((*MockLocationRepository)(nil).ListLocations), ctx, skip, limit)
+}
+
+// MockLocationService is a mock of LocationService interface.
+type MockLocationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationServiceMockRecorder
+}
+
+// MockLocationServiceMockRecorder is the mock recorder for MockLocationService.
+type MockLocationServiceMockRecorder struct {
+	mock *MockLocationService
+}
+
+// NewMockLocationService creates a new mock instance.
+func NewMockLocationService(ctrl *gomock.Controller) *MockLocationService {
+	mock := &MockLocationService{ctrl: ctrl}
+	mock.recorder = &MockLocationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationService) EXPECT() *MockLocationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateLocation mocks base method.
+func (m *MockLocationService) CreateLocation(ctx context.Context, location *domain.Location) (*domain.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocation", ctx, location)
+	ret0, _ := ret[0].(*domain.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocation indicates an expected call of CreateLocation.
+func (mr *MockLocationServiceMockRecorder) CreateLocation(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocation", reflect.TypeOf((*MockLocationService)(nil).CreateLocation), ctx, location)
+}
+
+// ListLocationStocks mocks base method.
+func (m *MockLocationService) ListLocationStocks(ctx context.Context, id, skip, limit uint64) ([]domain.LocationStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocationStocks", ctx, id, skip, limit)
+	ret0, _ := ret[0].([]domain.LocationStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocationStocks indicates an expected call of ListLocationStocks.
+func (mr *MockLocationServiceMockRecorder) ListLocationStocks(ctx, id, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocationStocks", reflect.TypeOf((*MockLocationService)(nil).ListLocationStocks), ctx, id, skip, limit)
+}
+
+// ListLocations mocks base method.
+func (m *MockLocationService) ListLocations(ctx context.Context, skip, limit uint64) ([]domain.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocations", ctx, skip, limit)
+	ret0, _ := ret[0].([]domain.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocations indicates an expected call of ListLocations.
+func (mr *MockLocationServiceMockRecorder) ListLocations(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocations", reflect.TypeOf((*MockLocationService)(nil).ListLocations), ctx, skip, limit)
+}
@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lot.go
+//
+// Generated by this command:
+//
+//	mockgen -source=lot.go -destination=mock/lot.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLotRepository is a mock of LotRepository interface.
+type MockLotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLotRepositoryMockRecorder
+}
+
+// MockLotRepositoryMockRecorder is the mock recorder for MockLotRepository.
+type MockLotRepositoryMockRecorder struct {
+	mock *MockLotRepository
+}
+
+// NewMockLotRepository creates a new mock instance.
+func NewMockLotRepository(ctrl *gomock.Controller) *MockLotRepository {
+	mock := &MockLotRepository{ctrl: ctrl}
+	mock.recorder = &MockLotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLotRepository) EXPECT() *MockLotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateLot mocks base method.
+func (m *MockLotRepository) CreateLot(ctx context.Context, lot *domain.Lot, unitCost float64) (*domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLot", ctx, lot, unitCost)
+	ret0, _ := ret[0].(*domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLot indicates an expected call of CreateLot.
+func (mr *MockLotRepositoryMockRecorder) CreateLot(ctx, lot, unitCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLot", reflect.TypeOf((*MockLotRepository)(nil).CreateLot), ctx, lot, unitCost)
+}
+
+// GetLotByID mocks base method.
+func (m *MockLotRepository) GetLotByID(ctx context.Context, id uint64) (*domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLotByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLotByID indicates an expected call of GetLotByID.
+func (mr *MockLotRepositoryMockRecorder) GetLotByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLotByID", reflect.TypeOf((*MockLotRepository)(nil).GetLotByID), ctx, id)
+}
+
+// ListExpiringLots mocks base method.
+func (m *MockLotRepository) ListExpiringLots(ctx context.Context, before time.Time, skip, limit uint64) ([]domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiringLots", ctx, before, skip, limit)
+	ret0, _ := ret[0].([]domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiringLots indicates an expected call of ListExpiringLots.
+func (mr *MockLotRepositoryMockRecorder) ListExpiringLots(ctx, before, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiringLots", reflect.TypeOf((*MockLotRepository)(nil).ListExpiringLots), ctx, before, skip, limit)
+}
+
+// ListLots mocks base method.
+func (m *MockLotRepository) ListLots(ctx context.Context, productID, skip, limit uint64) ([]domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLots", ctx, productID, skip, limit)
+	ret0, _ := ret[0].([]domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLots indicates an expected call of ListLots.
+func (mr *MockLotRepositoryMockRecorder) ListLots(ctx, productID, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLots", reflect.TypeOf((*MockLotRepository)(nil).ListLots), ctx, productID, skip, limit)
+}
+
+// MockLotService is a mock of LotService interface.
+type MockLotService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLotServiceMockRecorder
+}
+
+// MockLotServiceMockRecorder is the mock recorder for MockLotService.
+type MockLotServiceMockRecorder struct {
+	mock *MockLotService
+}
+
+// NewMockLotService creates a new mock instance.
+func NewMockLotService(ctrl *gomock.Controller) *MockLotService {
+	mock := &MockLotService{ctrl: ctrl}
+	mock.recorder = &MockLotServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLotService) EXPECT() *MockLotServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateLot mocks base method.
+func (m *MockLotService) CreateLot(ctx context.Context, lot *domain.Lot, unit string, cost float64) (*domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLot", ctx, lot, unit, cost)
+	ret0, _ := ret[0].(*domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLot indicates an expected call of CreateLot.
+func (mr *MockLotServiceMockRecorder) CreateLot(ctx, lot, unit, cost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLot", reflect.TypeOf((*MockLotService)(nil).CreateLot), ctx, lot, unit, cost)
+}
+
+// GetLot mocks base method.
+func (m *MockLotService) GetLot(ctx context.Context, id uint64) (*domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLot", ctx, id)
+	ret0, _ := ret[0].(*domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLot indicates an expected call of GetLot.
+func (mr *MockLotServiceMockRecorder) GetLot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLot", reflect.TypeOf((*MockLotService)(nil).GetLot), ctx, id)
+}
+
+// ListExpiringLots mocks base method.
+func (m *MockLotService) ListExpiringLots(ctx context.Context, days, skip, limit uint64) ([]domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiringLots", ctx, days, skip, limit)
+	ret0, _ := ret[0].([]domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiringLots indicates an expected call of ListExpiringLots.
+func (mr *MockLotServiceMockRecorder) ListExpiringLots(ctx, days, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiringLots", reflect.TypeOf((*MockLotService)(nil).ListExpiringLots), ctx, days, skip, limit)
+}
+
+// ListLots mocks base method.
+func (m *MockLotService) ListLots(ctx context.Context, productID, skip, limit uint64) ([]domain.Lot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLots", ctx, productID, skip, limit)
+	ret0, _ := ret[0].([]domain.Lot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLots indicates an expected call of ListLots.
+func (mr *MockLotServiceMockRecorder) ListLots(ctx, productID, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLots", reflect.TypeOf((*MockLotService)(nil).ListLots), ctx, productID, skip, limit)
+}
@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: modifier.go
+//
+// Generated by this command:
+//
+//	mockgen -source=modifier.go -destination=mock/modifier.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockModifierRepository is a mock of ModifierRepository interface.
+type MockModifierRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockModifierRepositoryMockRecorder
+}
+
+// MockModifierRepositoryMockRecorder is the mock recorder for MockModifierRepository.
+type MockModifierRepositoryMockRecorder struct {
+	mock *MockModifierRepository
+}
+
+// NewMockModifierRepository creates a new mock instance.
+func NewMockModifierRepository(ctrl *gomock.Controller) *MockModifierRepository {
+	mock := &MockModifierRepository{ctrl: ctrl}
+	mock.recorder = &MockModifierRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModifierRepository) EXPECT() *MockModifierRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateModifierGroup mocks base method.
+func (m *MockModifierRepository) CreateModifierGroup(ctx context.Context, group *domain.ModifierGroup) (*domain.ModifierGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModifierGroup", ctx, group)
+	ret0, _ := ret[0].(*domain.ModifierGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModifierGroup indicates an expected call of CreateModifierGroup.
+func (mr *MockModifierRepositoryMockRecorder) CreateModifierGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModifierGroup", reflect.TypeOf((*MockModifierRepository)(nil).CreateModifierGroup), ctx, group)
+}
+
+// DeleteModifierGroup mocks base method.
+func (m *MockModifierRepository) DeleteModifierGroup(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModifierGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteModifierGroup indicates an expected call of DeleteModifierGroup.
+func (mr *MockModifierRepositoryMockRecorder) DeleteModifierGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModifierGroup", reflect.TypeOf((*MockModifierRepository)(nil).DeleteModifierGroup), ctx, id)
+}
+
+// GetModifierGroupByID mocks base method.
+func (m *MockModifierRepository) GetModifierGroupByID(ctx context.Context, id uint64) (*domain.ModifierGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModifierGroupByID", ctx, id)
+	ret0, _ := ret[0].(*domain.ModifierGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModifierGroupByID indicates an expected call of GetModifierGroupByID.
+func (mr *MockModifierRepositoryMockRecorder) GetModifierGroupByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModifierGroupByID", reflect.TypeOf((*MockModifierRepository)(nil).GetModifierGroupByID), ctx, id)
+}
+
+// ListModifierGroups mocks base method.
+func (m *MockModifierRepository) ListModifierGroups(ctx context.Context, productID uint64) ([]domain.ModifierGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModifierGroups", ctx, productID)
+	ret0, _ := ret[0].([]domain.ModifierGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModifierGroups indicates an expected call of ListModifierGroups.
+func (mr *MockModifierRepositoryMockRecorder) ListModifierGroups(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModifierGroups", reflect.TypeOf((*MockModifierRepository)(nil).ListModifierGroups), ctx, productID)
+}
+
+// MockModifierService is a mock of ModifierService interface.
+type MockModifierService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModifierServiceMockRecorder
+}
+
+// MockModifierServiceMockRecorder is the mock recorder for MockModifierService.
+type MockModifierServiceMockRecorder struct {
+	mock *MockModifierService
+}
+
+// NewMockModifierService creates a new mock instance.
+func NewMockModifierService(ctrl *gomock.Controller) *MockModifierService {
+	mock := &MockModifierService{ctrl: ctrl}
+	mock.recorder = &MockModifierServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModifierService) EXPECT() *MockModifierServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateModifierGroup mocks base method.
+func (m *MockModifierService) CreateModifierGroup(ctx context.Context, group *domain.ModifierGroup) (*domain.ModifierGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModifierGroup", ctx, group)
+	ret0, _ := ret[0].(*domain.ModifierGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModifierGroup indicates an expected call of CreateModifierGroup.
+func (mr *MockModifierServiceMockRecorder) CreateModifierGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModifierGroup", reflect.TypeOf((*MockModifierService)(nil).CreateModifierGroup), ctx, group)
+}
+
+// DeleteModifierGroup mocks base method.
+func (m *MockModifierService) DeleteModifierGroup(ctx context.Context, productID, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModifierGroup", ctx, productID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteModifierGroup indicates an expected call of DeleteModifierGroup.
+func (mr *MockModifierServiceMockRecorder) DeleteModifierGroup(ctx, productID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModifierGroup", reflect.TypeOf((*MockModifierService)(nil).DeleteModifierGroup), ctx, productID, id)
+}
+
+// ListModifierGroups mocks base method.
+func (m *MockModifierService) ListModifierGroups(ctx context.Context, productID uint64) ([]domain.ModifierGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModifierGroups", ctx, productID)
+	ret0, _ := ret[0].([]domain.ModifierGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModifierGroups indicates an expected call of ListModifierGroups.
+func (mr *MockModifierServiceMockRecorder) ListModifierGroups(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModifierGroups", reflect.TypeOf((*MockModifierService)(nil).ListModifierGroups), ctx, productID)
+}
@@ -123,6 +123,21 @@ func (mr *MockOrderServiceMockRecorder) CreateOrder(ctx, order any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderService)(nil).CreateOrder), ctx, order)
 }
 
+// GetKitchenTicket mocks base method.
+func (m *MockOrderService) GetKitchenTicket(ctx context.Context, id uint64) (*domain.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKitchenTicket", ctx, id)
+	ret0, _ := ret[0].(*domain.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKitchenTicket indicates an expected call of GetKitchenTicket.
+func (mr *MockOrderServiceMockRecorder) GetKitchenTicket(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKitchenTicket", reflect.TypeOf((*MockOrderService)(nil).GetKitchenTicket), ctx, id)
+}
+
 // GetOrder mocks base method.
 func (m *MockOrderService) GetOrder(ctx context.Context, id uint64) (*domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +153,21 @@ func (mr *MockOrderServiceMockRecorder) GetOrder(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockOrderService)(nil).GetOrder), ctx, id)
 }
 
+// GetReceipt mocks base method.
+func (m *MockOrderService) GetReceipt(ctx context.Context, id uint64) (*domain.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceipt", ctx, id)
+	ret0, _ := ret[0].(*domain.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceipt indicates an expected call of GetReceipt.
+func (mr *MockOrderServiceMockRecorder) GetReceipt(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipt", reflect.TypeOf((*MockOrderService)(nil).GetReceipt), ctx, id)
+}
+
 // ListOrders mocks base method.
 func (m *MockOrderService) ListOrders(ctx context.Context, skip, limit uint64) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
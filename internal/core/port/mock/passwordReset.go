@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: passwordReset.go
+//
+// Generated by this command:
+//
+//	mockgen -source=passwordReset.go -destination=mock/passwordReset.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasswordResetRepository is a mock of PasswordResetRepository interface.
+type MockPasswordResetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetRepositoryMockRecorder
+}
+
+// MockPasswordResetRepositoryMockRecorder is the mock recorder for MockPasswordResetRepository.
+type MockPasswordResetRepositoryMockRecorder struct {
+	mock *MockPasswordResetRepository
+}
+
+// NewMockPasswordResetRepository creates a new mock instance.
+func NewMockPasswordResetRepository(ctrl *gomock.Controller) *MockPasswordResetRepository {
+	mock := &MockPasswordResetRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetRepository) EXPECT() *MockPasswordResetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockPasswordResetRepository) CreatePasswordResetToken(ctx context.Context, resetToken *domain.PasswordResetToken) (*domain.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", ctx, resetToken)
+	ret0, _ := ret[0].(*domain.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockPasswordResetRepositoryMockRecorder) CreatePasswordResetToken(ctx, resetToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockPasswordResetRepository)(nil).CreatePasswordResetToken), ctx, resetToken)
+}
+
+// GetPasswordResetTokenByHash mocks base method.
+func (m *MockPasswordResetRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordResetTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordResetTokenByHash indicates an expected call of GetPasswordResetTokenByHash.
+func (mr *MockPasswordResetRepositoryMockRecorder) GetPasswordResetTokenByHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordResetTokenByHash", reflect.TypeOf((*MockPasswordResetRepository)(nil).GetPasswordResetTokenByHash), ctx, tokenHash)
+}
+
+// InvalidateUserPasswordResetTokens mocks base method.
+func (m *MockPasswordResetRepository) InvalidateUserPasswordResetTokens(ctx context.Context, userID uint64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateUserPasswordResetTokens", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateUserPasswordResetTokens indicates an expected call of InvalidateUserPasswordResetTokens.
+func (mr *MockPasswordResetRepositoryMockRecorder) InvalidateUserPasswordResetTokens(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateUserPasswordResetTokens", reflect.TypeOf((*MockPasswordResetRepository)(nil).InvalidateUserPasswordResetTokens), ctx, userID, now)
+}
+
+// UsePasswordResetToken mocks base method.
+func (m *MockPasswordResetRepository) UsePasswordResetToken(ctx context.Context, id uint64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsePasswordResetToken", ctx, id, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UsePasswordResetToken indicates an expected call of UsePasswordResetToken.
+func (mr *MockPasswordResetRepositoryMockRecorder) UsePasswordResetToken(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsePasswordResetToken", reflect.TypeOf((*MockPasswordResetRepository)(nil).UsePasswordResetToken), ctx, id, now)
+}
+
+// MockPasswordService is a mock of PasswordService interface.
+type MockPasswordService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordServiceMockRecorder
+}
+
+// MockPasswordServiceMockRecorder is the mock recorder for MockPasswordService.
+type MockPasswordServiceMockRecorder struct {
+	mock *MockPasswordService
+}
+
+// NewMockPasswordService creates a new mock instance.
+func NewMockPasswordService(ctrl *gomock.Controller) *MockPasswordService {
+	mock := &MockPasswordService{ctrl: ctrl}
+	mock.recorder = &MockPasswordServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordService) EXPECT() *MockPasswordServiceMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockPasswordService) ChangePassword(ctx context.Context, userID uint64, currentPassword, newPassword, ipAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, currentPassword, newPassword, ipAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockPasswordServiceMockRecorder) ChangePassword(ctx, userID, currentPassword, newPassword, ipAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockPasswordService)(nil).ChangePassword), ctx, userID, currentPassword, newPassword, ipAddress)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockPasswordService) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockPasswordServiceMockRecorder) RequestPasswordReset(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockPasswordService)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockPasswordService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockPasswordServiceMockRecorder) ResetPassword(ctx, token, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockPasswordService)(nil).ResetPassword), ctx, token, newPassword)
+}
@@ -114,6 +114,21 @@ func (mr *MockPaymentRepositoryMockRecorder) UpdatePayment(ctx, payment any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePayment), ctx, payment)
 }
 
+// UpdatePaymentLogo mocks base method.
+func (m *MockPaymentRepository) UpdatePaymentLogo(ctx context.Context, id uint64, logo, thumbnail string) (*domain.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentLogo", ctx, id, logo, thumbnail)
+	ret0, _ := ret[0].(*domain.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentLogo indicates an expected call of UpdatePaymentLogo.
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePaymentLogo(ctx, id, logo, thumbnail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentLogo", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePaymentLogo), ctx, id, logo, thumbnail)
+}
+
 // MockPaymentService is a mock of PaymentService interface.
 type MockPaymentService struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,243 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: price.go
+//
+// Generated by this command:
+//
+//	mockgen -source=price.go -destination=mock/price.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPriceRepository is a mock of PriceRepository interface.
+type MockPriceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceRepositoryMockRecorder
+}
+
+// MockPriceRepositoryMockRecorder is the mock recorder for MockPriceRepository.
+type MockPriceRepositoryMockRecorder struct {
+	mock *MockPriceRepository
+}
+
+// NewMockPriceRepository creates a new mock instance.
+func NewMockPriceRepository(ctrl *gomock.Controller) *MockPriceRepository {
+	mock := &MockPriceRepository{ctrl: ctrl}
+	mock.recorder = &MockPriceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceRepository) EXPECT() *MockPriceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplyScheduledPrice mocks base method.
+func (m *MockPriceRepository) ApplyScheduledPrice(ctx context.Context, id uint64, now time.Time) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyScheduledPrice", ctx, id, now)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyScheduledPrice indicates an expected call of ApplyScheduledPrice.
+func (mr *MockPriceRepositoryMockRecorder) ApplyScheduledPrice(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyScheduledPrice", reflect.TypeOf((*MockPriceRepository)(nil).ApplyScheduledPrice), ctx, id, now)
+}
+
+// CreateScheduledPrice mocks base method.
+func (m *MockPriceRepository) CreateScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) (*domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledPrice", ctx, scheduledPrice)
+	ret0, _ := ret[0].(*domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledPrice indicates an expected call of CreateScheduledPrice.
+func (mr *MockPriceRepositoryMockRecorder) CreateScheduledPrice(ctx, scheduledPrice any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledPrice", reflect.TypeOf((*MockPriceRepository)(nil).CreateScheduledPrice), ctx, scheduledPrice)
+}
+
+// DeleteScheduledPrice mocks base method.
+func (m *MockPriceRepository) DeleteScheduledPrice(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledPrice", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledPrice indicates an expected call of DeleteScheduledPrice.
+func (mr *MockPriceRepositoryMockRecorder) DeleteScheduledPrice(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledPrice", reflect.TypeOf((*MockPriceRepository)(nil).DeleteScheduledPrice), ctx, id)
+}
+
+// GetScheduledPriceByID mocks base method.
+func (m *MockPriceRepository) GetScheduledPriceByID(ctx context.Context, id uint64) (*domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledPriceByID", ctx, id)
+	ret0, _ := ret[0].(*domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledPriceByID indicates an expected call of GetScheduledPriceByID.
+func (mr *MockPriceRepositoryMockRecorder) GetScheduledPriceByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledPriceByID", reflect.TypeOf((*MockPriceRepository)(nil).GetScheduledPriceByID), ctx, id)
+}
+
+// ListDueScheduledPrices mocks base method.
+func (m *MockPriceRepository) ListDueScheduledPrices(ctx context.Context, now time.Time) ([]domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueScheduledPrices", ctx, now)
+	ret0, _ := ret[0].([]domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueScheduledPrices indicates an expected call of ListDueScheduledPrices.
+func (mr *MockPriceRepositoryMockRecorder) ListDueScheduledPrices(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueScheduledPrices", reflect.TypeOf((*MockPriceRepository)(nil).ListDueScheduledPrices), ctx, now)
+}
+
+// ListPriceChanges mocks base method.
+func (m *MockPriceRepository) ListPriceChanges(ctx context.Context, productID, skip, limit uint64) ([]domain.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceChanges", ctx, productID, skip, limit)
+	ret0, _ := ret[0].([]domain.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceChanges indicates an expected call of ListPriceChanges.
+func (mr *MockPriceRepositoryMockRecorder) ListPriceChanges(ctx, productID, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceChanges", reflect.TypeOf((*MockPriceRepository)(nil).ListPriceChanges), ctx, productID, skip, limit)
+}
+
+// ListScheduledPrices mocks base method.
+func (m *MockPriceRepository) ListScheduledPrices(ctx context.Context, productID uint64, pending bool) ([]domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledPrices", ctx, productID, pending)
+	ret0, _ := ret[0].([]domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledPrices indicates an expected call of ListScheduledPrices.
+func (mr *MockPriceRepositoryMockRecorder) ListScheduledPrices(ctx, productID, pending any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledPrices", reflect.TypeOf((*MockPriceRepository)(nil).ListScheduledPrices), ctx, productID, pending)
+}
+
+// MockPriceService is a mock of PriceService interface.
+type MockPriceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceServiceMockRecorder
+}
+
+// MockPriceServiceMockRecorder is the mock recorder for MockPriceService.
+type MockPriceServiceMockRecorder struct {
+	mock *MockPriceService
+}
+
+// NewMockPriceService creates a new mock instance.
+func NewMockPriceService(ctrl *gomock.Controller) *MockPriceService {
+	mock := &MockPriceService{ctrl: ctrl}
+	mock.recorder = &MockPriceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceService) EXPECT() *MockPriceServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyScheduledPrices mocks base method.
+func (m *MockPriceService) ApplyScheduledPrices(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyScheduledPrices", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyScheduledPrices indicates an expected call of ApplyScheduledPrices.
+func (mr *MockPriceServiceMockRecorder) ApplyScheduledPrices(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyScheduledPrices", reflect.TypeOf((*MockPriceService)(nil).ApplyScheduledPrices), ctx, now)
+}
+
+// CancelScheduledPrice mocks base method.
+func (m *MockPriceService) CancelScheduledPrice(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledPrice", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelScheduledPrice indicates an expected call of CancelScheduledPrice.
+func (mr *MockPriceServiceMockRecorder) CancelScheduledPrice(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledPrice", reflect.TypeOf((*MockPriceService)(nil).CancelScheduledPrice), ctx, id)
+}
+
+// ListPriceHistory mocks base method.
+func (m *MockPriceService) ListPriceHistory(ctx context.Context, productID, skip, limit uint64) ([]domain.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceHistory", ctx, productID, skip, limit)
+	ret0, _ := ret[0].([]domain.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceHistory indicates an expected call of ListPriceHistory.
+func (mr *MockPriceServiceMockRecorder) ListPriceHistory(ctx, productID, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceHistory", reflect.TypeOf((*MockPriceService)(nil).ListPriceHistory), ctx, productID, skip, limit)
+}
+
+// ListScheduledPrices mocks base method.
+func (m *MockPriceService) ListScheduledPrices(ctx context.Context, productID uint64, pending bool) ([]domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledPrices", ctx, productID, pending)
+	ret0, _ := ret[0].([]domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledPrices indicates an expected call of ListScheduledPrices.
+func (mr *MockPriceServiceMockRecorder) ListScheduledPrices(ctx, productID, pending any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledPrices", reflect.TypeOf((*MockPriceService)(nil).ListScheduledPrices), ctx, productID, pending)
+}
+
+// SchedulePrice mocks base method.
+func (m *MockPriceService) SchedulePrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) (*domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePrice", ctx, scheduledPrice)
+	ret0, _ := ret[0].(*domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePrice indicates an expected call of SchedulePrice.
+func (mr *MockPriceServiceMockRecorder) SchedulePrice(ctx, scheduledPrice any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePrice", reflect.TypeOf((*MockPriceService)(nil).SchedulePrice), ctx, scheduledPrice)
+}
@@ -0,0 +1,243 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: priceList.go
+//
+// Generated by this command:
+//
+//	mockgen -source=priceList.go -destination=mock/priceList.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPriceListRepository is a mock of PriceListRepository interface.
+type MockPriceListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceListRepositoryMockRecorder
+}
+
+// MockPriceListRepositoryMockRecorder is the mock recorder for MockPriceListRepository.
+type MockPriceListRepositoryMockRecorder struct {
+	mock *MockPriceListRepository
+}
+
+// NewMockPriceListRepository creates a new mock instance.
+func NewMockPriceListRepository(ctrl *gomock.Controller) *MockPriceListRepository {
+	mock := &MockPriceListRepository{ctrl: ctrl}
+	mock.recorder = &MockPriceListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceListRepository) EXPECT() *MockPriceListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePriceList mocks base method.
+func (m *MockPriceListRepository) CreatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceList", ctx, priceList)
+	ret0, _ := ret[0].(*domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceList indicates an expected call of CreatePriceList.
+func (mr *MockPriceListRepositoryMockRecorder) CreatePriceList(ctx, priceList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceList", reflect.TypeOf((*MockPriceListRepository)(nil).CreatePriceList), ctx, priceList)
+}
+
+// DeletePriceList mocks base method.
+func (m *MockPriceListRepository) DeletePriceList(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceList", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceList indicates an expected call of DeletePriceList.
+func (mr *MockPriceListRepositoryMockRecorder) DeletePriceList(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceList", reflect.TypeOf((*MockPriceListRepository)(nil).DeletePriceList), ctx, id)
+}
+
+// GetPriceListByID mocks base method.
+func (m *MockPriceListRepository) GetPriceListByID(ctx context.Context, id uint64) (*domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceListByID", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceListByID indicates an expected call of GetPriceListByID.
+func (mr *MockPriceListRepositoryMockRecorder) GetPriceListByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceListByID", reflect.TypeOf((*MockPriceListRepository)(nil).GetPriceListByID), ctx, id)
+}
+
+// ListPriceLists mocks base method.
+func (m *MockPriceListRepository) ListPriceLists(ctx context.Context, skip, limit uint64) ([]domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceLists", ctx, skip, limit)
+	ret0, _ := ret[0].([]domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceLists indicates an expected call of ListPriceLists.
+func (mr *MockPriceListRepositoryMockRecorder) ListPriceLists(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceLists", reflect.TypeOf((*MockPriceListRepository)(nil).ListPriceLists), ctx, skip, limit)
+}
+
+// ListPriceListsByProduct mocks base method.
+func (m *MockPriceListRepository) ListPriceListsByProduct(ctx context.Context, productID uint64) ([]domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceListsByProduct", ctx, productID)
+	ret0, _ := ret[0].([]domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceListsByProduct indicates an expected call of ListPriceListsByProduct.
+func (mr *MockPriceListRepositoryMockRecorder) ListPriceListsByProduct(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceListsByProduct", reflect.TypeOf((*MockPriceListRepository)(nil).ListPriceListsByProduct), ctx, productID)
+}
+
+// UpdatePriceList mocks base method.
+func (m *MockPriceListRepository) UpdatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePriceList", ctx, priceList)
+	ret0, _ := ret[0].(*domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePriceList indicates an expected call of UpdatePriceList.
+func (mr *MockPriceListRepositoryMockRecorder) UpdatePriceList(ctx, priceList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePriceList", reflect.TypeOf((*MockPriceListRepository)(nil).UpdatePriceList), ctx, priceList)
+}
+
+// MockPriceListService is a mock of PriceListService interface.
+type MockPriceListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceListServiceMockRecorder
+}
+
+// MockPriceListServiceMockRecorder is the mock recorder for MockPriceListService.
+type MockPriceListServiceMockRecorder struct {
+	mock *MockPriceListService
+}
+
+// NewMockPriceListService creates a new mock instance.
+func NewMockPriceListService(ctrl *gomock.Controller) *MockPriceListService {
+	mock := &MockPriceListService{ctrl: ctrl}
+	mock.recorder = &MockPriceListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceListService) EXPECT() *MockPriceListServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePriceList mocks base method.
+func (m *MockPriceListService) CreatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceList", ctx, priceList)
+	ret0, _ := ret[0].(*domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceList indicates an expected call of CreatePriceList.
+func (mr *MockPriceListServiceMockRecorder) CreatePriceList(ctx, priceList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceList", reflect.TypeOf((*MockPriceListService)(nil).CreatePriceList), ctx, priceList)
+}
+
+// DeletePriceList mocks base method.
+func (m *MockPriceListService) DeletePriceList(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePriceList", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePriceList indicates an expected call of DeletePriceList.
+func (mr *MockPriceListServiceMockRecorder) DeletePriceList(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePriceList", reflect.TypeOf((*MockPriceListService)(nil).DeletePriceList), ctx, id)
+}
+
+// GetPriceList mocks base method.
+func (m *MockPriceListService) GetPriceList(ctx context.Context, id uint64) (*domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceList", ctx, id)
+	ret0, _ := ret[0].(*domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceList indicates an expected call of GetPriceList.
+func (mr *MockPriceListServiceMockRecorder) GetPriceList(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceList", reflect.TypeOf((*MockPriceListService)(nil).GetPriceList), ctx, id)
+}
+
+// GetProductPrice mocks base method.
+func (m *MockPriceListService) GetProductPrice(ctx context.Context, productID uint64, group domain.CustomerGroup, at time.Time) (*domain.ProductPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductPrice", ctx, productID, group, at)
+	ret0, _ := ret[0].(*domain.ProductPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductPrice indicates an expected call of GetProductPrice.
+func (mr *MockPriceListServiceMockRecorder) GetProductPrice(ctx, productID, group, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductPrice", reflect.TypeOf((*MockPriceListService)(nil).GetProductPrice), ctx, productID, group, at)
+}
+
+// ListPriceLists mocks base method.
+func (m *MockPriceListService) ListPriceLists(ctx context.Context, skip, limit uint64) ([]domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceLists", ctx, skip, limit)
+	ret0, _ := ret[0].([]domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceLists indicates an expected call of ListPriceLists.
+func (mr *MockPriceListServiceMockRecorder) ListPriceLists(ctx, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceLists", reflect.TypeOf((*MockPriceListService)(nil).ListPriceLists), ctx, skip, limit)
+}
+
+// UpdatePriceList mocks base method.
+func (m *MockPriceListService) UpdatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePriceList", ctx, priceList)
+	ret0, _ := ret[0].(*domain.PriceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePriceList indicates an expected call of UpdatePriceList.
+func (mr *MockPriceListServiceMockRecorder) UpdatePriceList(ctx, priceList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePriceList", reflect.TypeOf((*MockPriceListService)(nil).UpdatePriceList), ctx, priceList)
+}
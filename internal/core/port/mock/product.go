@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -84,34 +85,124 @@ func (mr *MockProductRepositoryMockRecorder) GetProductByID(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockProductRepository)(nil).GetProductByID), ctx, id)
 }
 
+// GetProductBySKU mocks base method.
+func (m *MockProductRepository) GetProductBySKU(ctx context.Context, sku uuid.UUID) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductBySKU", ctx, sku)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductBySKU indicates an expected call of GetProductBySKU.
+func (mr *MockProductRepositoryMockRecorder) GetProductBySKU(ctx, sku any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductBySKU", reflect.TypeOf((*MockProductRepository)(nil).GetProductBySKU), ctx, sku)
+}
+
+// ImportProducts mocks base method.
+func (m *MockProductRepository) ImportProducts(ctx context.Context, products []domain.Product, userID uint64) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportProducts", ctx, products, userID)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportProducts indicates an expected call of ImportProducts.
+func (mr *MockProductRepositoryMockRecorder) ImportProducts(ctx, products, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportProducts", reflect.TypeOf((*MockProductRepository)(nil).ImportProducts), ctx, products, userID)
+}
+
+// IncrementStock mocks base method.
+func (m *MockProductRepository) IncrementStock(ctx context.Context, id uint64, quantity, unitCost float64) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementStock", ctx, id, quantity, unitCost)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementStock indicates an expected call of IncrementStock.
+func (mr *MockProductRepositoryMockRecorder) IncrementStock(ctx, id, quantity, unitCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementStock", reflect.TypeOf((*MockProductRepository)(nil).IncrementStock), ctx, id, quantity, unitCost)
+}
+
+// ListBundleComponents mocks base method.
+func (m *MockProductRepository) ListBundleComponents(ctx context.Context, bundleId uint64) ([]domain.BundleComponent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBundleComponents", ctx, bundleId)
+	ret0, _ := ret[0].([]domain.BundleComponent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBundleComponents indicates an expected call of ListBundleComponents.
+func (mr *MockProductRepositoryMockRecorder) ListBundleComponents(ctx, bundleId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBundleComponents", reflect.TypeOf((*MockProductRepository)(nil).ListBundleComponents), ctx, bundleId)
+}
+
 // ListProducts mocks base method.
-func (m *MockProductRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domain.Product, error) {
+func (m *MockProductRepository) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, topLevel bool) ([]domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit)
+	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit, topLevel)
 	ret0, _ := ret[0].([]domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProducts indicates an expected call of ListProducts.
-func (mr *MockProductRepositoryMockRecorder) ListProducts(ctx, search, categoryId, skip, limit any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) ListProducts(ctx, search, categoryId, skip, limit, topLevel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductRepository)(nil).ListProducts), ctx, search, categoryId, skip, limit, topLevel)
+}
+
+// ListVariants mocks base method.
+func (m *MockProductRepository) ListVariants(ctx context.Context, parentId uint64) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVariants", ctx, parentId)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVariants indicates an expected call of ListVariants.
+func (mr *MockProductRepositoryMockRecorder) ListVariants(ctx, parentId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductRepository)(nil).ListProducts), ctx, search, categoryId, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVariants", reflect.TypeOf((*MockProductRepository)(nil).ListVariants), ctx, parentId)
 }
 
 // UpdateProduct mocks base method.
-func (m *MockProductRepository) UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+func (m *MockProductRepository) UpdateProduct(ctx context.Context, product *domain.Product, userID uint64) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProduct", ctx, product)
+	ret := m.ctrl.Call(m, "UpdateProduct", ctx, product, userID)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProduct indicates an expected call of UpdateProduct.
-func (mr *MockProductRepositoryMockRecorder) UpdateProduct(ctx, product any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) UpdateProduct(ctx, product, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductRepository)(nil).UpdateProduct), ctx, product)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductRepository)(nil).UpdateProduct), ctx, product, userID)
+}
+
+// UpdateProductImage mocks base method.
+func (m *MockProductRepository) UpdateProductImage(ctx context.Context, id uint64, image, thumbnail string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductImage", ctx, id, image, thumbnail)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductImage indicates an expected call of UpdateProductImage.
+func (mr *MockProductRepositoryMockRecorder) UpdateProductImage(ctx, id, image, thumbnail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductImage", reflect.TypeOf((*MockProductRepository)(nil).UpdateProductImage), ctx, id, image, thumbnail)
 }
 
 // MockProductService is a mock of ProductService interface.
@@ -182,31 +273,46 @@ func (mr *MockProductServiceMockRecorder) GetProduct(ctx, id any) *gomock.Call {
 }
 
 // ListProducts mocks base method.
-func (m *MockProductService) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domain.Product, error) {
+func (m *MockProductService) ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, grouped bool) ([]domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit)
+	ret := m.ctrl.Call(m, "ListProducts", ctx, search, categoryId, skip, limit, grouped)
 	ret0, _ := ret[0].([]domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListProducts indicates an expected call of ListProducts.
-func (mr *MockProductServiceMockRecorder) ListProducts(ctx, search, categoryId, skip, limit any) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) ListProducts(ctx, search, categoryId, skip, limit, grouped any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductService)(nil).ListProducts), ctx, search, categoryId, skip, limit, grouped)
+}
+
+// ReceiveProduct mocks base method.
+func (m *MockProductService) ReceiveProduct(ctx context.Context, id uint64, quantity float64, unit string, cost float64) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveProduct", ctx, id, quantity, unit, cost)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveProduct indicates an expected call of ReceiveProduct.
+func (mr *MockProductServiceMockRecorder) ReceiveProduct(ctx, id, quantity, unit, cost any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockProductService)(nil).ListProducts), ctx, search, categoryId, skip, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveProduct", reflect.TypeOf((*MockProductService)(nil).ReceiveProduct), ctx, id, quantity, unit, cost)
 }
 
 // UpdateProduct mocks base method.
-func (m *MockProductService) UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+func (m *MockProductService) UpdateProduct(ctx context.Context, product *domain.Product, userID uint64) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProduct", ctx, product)
+	ret := m.ctrl.Call(m, "UpdateProduct", ctx, product, userID)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProduct indicates an expected call of UpdateProduct.
-func (mr *MockProductServiceMockRecorder) UpdateProduct(ctx, product any) *gomock.Call {
+func (mr *MockProductServiceMockRecorder) UpdateProduct(ctx, product, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductService)(nil).UpdateProduct), ctx, product)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductService)(nil).UpdateProduct), ctx, product, userID)
 }
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: refreshToken.go
+//
+// Generated by this command:
+//
+//	mockgen -source=refreshToken.go -destination=mock/refreshToken.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenRepository is a mock of RefreshTokenRepository interface.
+type MockRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenRepositoryMockRecorder
+}
+
+// MockRefreshTokenRepositoryMockRecorder is the mock recorder for MockRefreshTokenRepository.
+type MockRefreshTokenRepositoryMockRecorder struct {
+	mock *MockRefreshTokenRepository
+}
+
+// NewMockRefreshTokenRepository creates a new mock instance.
+func NewMockRefreshTokenRepository(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+	mock := &MockRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenRepository) EXPECT() *MockRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockRefreshTokenRepository) CreateRefreshToken(ctx context.Context, refreshToken *domain.RefreshToken) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, refreshToken)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockRefreshTokenRepositoryMockRecorder) CreateRefreshToken(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockRefreshTokenRepository)(nil).CreateRefreshToken), ctx, refreshToken)
+}
+
+// GetRefreshTokenByHash mocks base method.
+func (m *MockRefreshTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshTokenByHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshTokenByHash indicates an expected call of GetRefreshTokenByHash.
+func (mr *MockRefreshTokenRepositoryMockRecorder) GetRefreshTokenByHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenByHash", reflect.TypeOf((*MockRefreshTokenRepository)(nil).GetRefreshTokenByHash), ctx, tokenHash)
+}
+
+// RevokeRefreshTokenFamily mocks base method.
+func (m *MockRefreshTokenRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshTokenFamily", ctx, familyID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshTokenFamily indicates an expected call of RevokeRefreshTokenFamily.
+func (mr *MockRefreshTokenRepositoryMockRecorder) RevokeRefreshTokenFamily(ctx, familyID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshTokenFamily", reflect.TypeOf((*MockRefreshTokenRepository)(nil).RevokeRefreshTokenFamily), ctx, familyID, now)
+}
+
+// RevokeUserRefreshTokens mocks base method.
+func (m *MockRefreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID uint64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserRefreshTokens", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserRefreshTokens indicates an expected call of RevokeUserRefreshTokens.
+func (mr *MockRefreshTokenRepositoryMockRecorder) RevokeUserRefreshTokens(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserRefreshTokens", reflect.TypeOf((*MockRefreshTokenRepository)(nil).RevokeUserRefreshTokens), ctx, userID, now)
+}
+
+// UseRefreshToken mocks base method.
+func (m *MockRefreshTokenRepository) UseRefreshToken(ctx context.Context, id uint64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRefreshToken", ctx, id, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRefreshToken indicates an expected call of UseRefreshToken.
+func (mr *MockRefreshTokenRepositoryMockRecorder) UseRefreshToken(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRefreshToken", reflect.TypeOf((*MockRefreshTokenRepository)(nil).UseRefreshToken), ctx, id, now)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report.go
+//
+// Generated by this command:
+//
+//	mockgen -source=report.go -destination=mock/report.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListProductMargins mocks base method.
+func (m *MockReportRepository) ListProductMargins(ctx context.Context, from, to time.Time) ([]domain.ProductMargin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductMargins", ctx, from, to)
+	ret0, _ := ret[0].([]domain.ProductMargin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductMargins indicates an expected call of ListProductMargins.
+func (mr *MockReportRepositoryMockRecorder) ListProductMargins(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductMargins", reflect.TypeOf((*MockReportRepository)(nil).ListProductMargins), ctx, from, to)
+}
+
+// ListStockValuations mocks base method.
+func (m *MockReportRepository) ListStockValuations(ctx context.Context) ([]domain.StockValuation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStockValuations", ctx)
+	ret0, _ := ret[0].([]domain.StockValuation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStockValuations indicates an expected call of ListStockValuations.
+func (mr *MockReportRepositoryMockRecorder) ListStockValuations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStockValuations", reflect.TypeOf((*MockReportRepository)(nil).ListStockValuations), ctx)
+}
+
+// MockReportService is a mock of ReportService interface.
+type MockReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportServiceMockRecorder
+}
+
+// MockReportServiceMockRecorder is the mock recorder for MockReportService.
+type MockReportServiceMockRecorder struct {
+	mock *MockReportService
+}
+
+// NewMockReportService creates a new mock instance.
+func NewMockReportService(ctrl *gomock.Controller) *MockReportService {
+	mock := &MockReportService{ctrl: ctrl}
+	mock.recorder = &MockReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportService) EXPECT() *MockReportServiceMockRecorder {
+	return m.recorder
+}
+
+// GetInventoryValuation mocks base method.
+func (m *MockReportService) GetInventoryValuation(ctx context.Context) (*domain.InventoryValuation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventoryValuation", ctx)
+	ret0, _ := ret[0].(*domain.InventoryValuation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventoryValuation indicates an expected call of GetInventoryValuation.
+func (mr *MockReportServiceMockRecorder) GetInventoryValuation(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryValuation", reflect.TypeOf((*MockReportService)(nil).GetInventoryValuation), ctx)
+}
+
+// GetMarginReport mocks base method.
+func (m *MockReportService) GetMarginReport(ctx context.Context, from, to time.Time) (*domain.MarginReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarginReport", ctx, from, to)
+	ret0, _ := ret[0].(*domain.MarginReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMarginReport indicates an expected call of GetMarginReport.
+func (mr *MockReportServiceMockRecorder) GetMarginReport(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarginReport", reflect.TypeOf((*MockReportService)(nil).GetMarginReport), ctx, from, to)
+}
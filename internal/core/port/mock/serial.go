@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: serial.go
+//
+// Generated by this command:
+//
+//	mockgen -source=serial.go -destination=mock/serial.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSerialRepository is a mock of SerialRepository interface.
+type MockSerialRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSerialRepositoryMockRecorder
+}
+
+// MockSerialRepositoryMockRecorder is the mock recorder for MockSerialRepository.
+type MockSerialRepositoryMockRecorder struct {
+	mock *MockSerialRepository
+}
+
+// NewMockSerialRepository creates a new mock instance.
+func NewMockSerialRepository(ctrl *gomock.Controller) *MockSerialRepository {
+	mock := &MockSerialRepository{ctrl: ctrl}
+	mock.recorder = &MockSerialRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSerialRepository) EXPECT() *MockSerialRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSerials mocks base method.
+func (m *MockSerialRepository) CreateSerials(ctx context.Context, productID uint64, serialNumbers []string, unitCost float64) ([]domain.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSerials", ctx, productID, serialNumbers, unitCost)
+	ret0, _ := ret[0].([]domain.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSerials indicates an expected call of CreateSerials.
+func (mr *MockSerialRepositoryMockRecorder) CreateSerials(ctx, productID, serialNumbers, unitCost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSerials", reflect.TypeOf((*MockSerialRepository)(nil).CreateSerials), ctx, productID, serialNumbers, unitCost)
+}
+
+// ListSerials mocks base method.
+func (m *MockSerialRepository) ListSerials(ctx context.Context, productID uint64, status domain.SerialStatus, skip, limit uint64) ([]domain.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSerials", ctx, productID, status, skip, limit)
+	ret0, _ := ret[0].([]domain.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSerials indicates an expected call of ListSerials.
+func (mr *MockSerialRepositoryMockRecorder) ListSerials(ctx, productID, status, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSerials", reflect.TypeOf((*MockSerialRepository)(nil).ListSerials), ctx, productID, status, skip, limit)
+}
+
+// ListSerialsBySerialNumber mocks base method.
+func (m *MockSerialRepository) ListSerialsBySerialNumber(ctx context.Context, serialNumber string) ([]domain.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSerialsBySerialNumber", ctx, serialNumber)
+	ret0, _ := ret[0].([]domain.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSerialsBySerialNumber indicates an expected call of ListSerialsBySerialNumber.
+func (mr *MockSerialRepositoryMockRecorder) ListSerialsBySerialNumber(ctx, serialNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSerialsBySerialNumber", reflect.TypeOf((*MockSerialRepository)(nil).ListSerialsBySerialNumber), ctx, serialNumber)
+}
+
+// MockSerialService is a mock of SerialService interface.
+type MockSerialService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSerialServiceMockRecorder
+}
+
+// MockSerialServiceMockRecorder is the mock recorder for MockSerialService.
+type MockSerialServiceMockRecorder struct {
+	mock *MockSerialService
+}
+
+// NewMockSerialService creates a new mock instance.
+func NewMockSerialService(ctrl *gomock.Controller) *MockSerialService {
+	mock := &MockSerialService{ctrl: ctrl}
+	mock.recorder = &MockSerialServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSerialService) EXPECT() *MockSerialServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSerials mocks base method.
+func (m *MockSerialService) CreateSerials(ctx context.Context, productID uint64, serialNumbers []string, cost float64) ([]domain.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSerials", ctx, productID, serialNumbers, cost)
+	ret0, _ := ret[0].([]domain.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSerials indicates an expected call of CreateSerials.
+func (mr *MockSerialServiceMockRecorder) CreateSerials(ctx, productID, serialNumbers, cost any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSerials", reflect.TypeOf((*MockSerialService)(nil).CreateSerials), ctx, productID, serialNumbers, cost)
+}
+
+// ListSerials mocks base method.
+func (m *MockSerialService) ListSerials(ctx context.Context, productID uint64, status domain.SerialStatus, skip, limit uint64) ([]domain.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSerials", ctx, productID, status, skip, limit)
+	ret0, _ := ret[0].([]domain.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSerials indicates an expected call of ListSerials.
+func (mr *MockSerialServiceMockRecorder) ListSerials(ctx, productID, status, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSerials", reflect.TypeOf((*MockSerialService)(nil).ListSerials), ctx, productID, status, skip, limit)
+}
+
+// SearchSerials mocks base method.
+func (m *MockSerialService) SearchSerials(ctx context.Context, serialNumber string) ([]domain.Serial, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSerials", ctx, serialNumber)
+	ret0, _ := ret[0].([]domain.Serial)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSerials indicates an expected call of SearchSerials.
+func (mr *MockSerialServiceMockRecorder) SearchSerials(ctx, serialNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSerials", reflect.TypeOf((*MockSerialService)(nil).SearchSerials), ctx, serialNumber)
+}
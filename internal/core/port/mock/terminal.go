@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: terminal.go
+//
+// Generated by this command:
+//
+//	mockgen -source=terminal.go -destination=mock/terminal.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTerminalRepository is a mock of TerminalRepository interface.
+type MockTerminalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTerminalRepositoryMockRecorder
+}
+
+// MockTerminalRepositoryMockRecorder is the mock recorder for MockTerminalRepository.
+type MockTerminalRepositoryMockRecorder struct {
+	mock *MockTerminalRepository
+}
+
+// NewMockTerminalRepository creates a new mock instance.
+func NewMockTerminalRepository(ctrl *gomock.Controller) *MockTerminalRepository {
+	mock := &MockTerminalRepository{ctrl: ctrl}
+	mock.recorder = &MockTerminalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTerminalRepository) EXPECT() *MockTerminalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTerminal mocks base method.
+func (m *MockTerminalRepository) CreateTerminal(ctx context.Context, terminal *domain.Terminal) (*domain.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTerminal", ctx, terminal)
+	ret0, _ := ret[0].(*domain.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTerminal indicates an expected call of CreateTerminal.
+func (mr *MockTerminalRepositoryMockRecorder) CreateTerminal(ctx, terminal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTerminal", reflect.TypeOf((*MockTerminalRepository)(nil).CreateTerminal), ctx, terminal)
+}
+
+// DeleteTerminal mocks base method.
+func (m *MockTerminalRepository) DeleteTerminal(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTerminal", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTerminal indicates an expected call of DeleteTerminal.
+func (mr *MockTerminalRepositoryMockRecorder) DeleteTerminal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTerminal", reflect.TypeOf((*MockTerminalRepository)(nil).DeleteTerminal), ctx, id)
+}
+
+// GetTerminalByCredentialHash mocks base method.
+func (m *MockTerminalRepository) GetTerminalByCredentialHash(ctx context.Context, credentialHash string) (*domain.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTerminalByCredentialHash", ctx, credentialHash)
+	ret0, _ := ret[0].(*domain.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTerminalByCredentialHash indicates an expected call of GetTerminalByCredentialHash.
+func (mr *MockTerminalRepositoryMockRecorder) GetTerminalByCredentialHash(ctx, credentialHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTerminalByCredentialHash", reflect.TypeOf((*MockTerminalRepository)(nil).GetTerminalByCredentialHash), ctx, credentialHash)
+}
+
+// ListTerminals mocks base method.
+func (m *MockTerminalRepository) ListTerminals(ctx context.Context) ([]domain.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTerminals", ctx)
+	ret0, _ := ret[0].([]domain.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTerminals indicates an expected call of ListTerminals.
+func (mr *MockTerminalRepositoryMockRecorder) ListTerminals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTerminals", reflect.TypeOf((*MockTerminalRepository)(nil).ListTerminals), ctx)
+}
+
+// MockTerminalService is a mock of TerminalService interface.
+type MockTerminalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTerminalServiceMockRecorder
+}
+
+// MockTerminalServiceMockRecorder is the mock recorder for MockTerminalService.
+type MockTerminalServiceMockRecorder struct {
+	mock *MockTerminalService
+}
+
+// NewMockTerminalService creates a new mock instance.
+func NewMockTerminalService(ctrl *gomock.Controller) *MockTerminalService {
+	mock := &MockTerminalService{ctrl: ctrl}
+	mock.recorder = &MockTerminalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTerminalService) EXPECT() *MockTerminalServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteTerminal mocks base method.
+func (m *MockTerminalService) DeleteTerminal(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTerminal", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTerminal indicates an expected call of DeleteTerminal.
+func (mr *MockTerminalServiceMockRecorder) DeleteTerminal(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTerminal", reflect.TypeOf((*MockTerminalService)(nil).DeleteTerminal), ctx, id)
+}
+
+// ListTerminals mocks base method.
+func (m *MockTerminalService) ListTerminals(ctx context.Context) ([]domain.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTerminals", ctx)
+	ret0, _ := ret[0].([]domain.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTerminals indicates an expected call of ListTerminals.
+func (mr *MockTerminalServiceMockRecorder) ListTerminals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTerminals", reflect.TypeOf((*MockTerminalService)(nil).ListTerminals), ctx)
+}
+
+// RegisterTerminal mocks base method.
+func (m *MockTerminalService) RegisterTerminal(ctx context.Context, terminal *domain.Terminal) (*domain.Terminal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTerminal", ctx, terminal)
+	ret0, _ := ret[0].(*domain.Terminal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTerminal indicates an expected call of RegisterTerminal.
+func (mr *MockTerminalServiceMockRecorder) RegisterTerminal(ctx, terminal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTerminal", reflect.TypeOf((*MockTerminalService)(nil).RegisterTerminal), ctx, terminal)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ticket.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ticket.go -destination=mock/ticket.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTicketRenderer is a mock of TicketRenderer interface.
+type MockTicketRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketRendererMockRecorder
+}
+
+// MockTicketRendererMockRecorder is the mock recorder for MockTicketRenderer.
+type MockTicketRendererMockRecorder struct {
+	mock *MockTicketRenderer
+}
+
+// NewMockTicketRenderer creates a new mock instance.
+func NewMockTicketRenderer(ctrl *gomock.Controller) *MockTicketRenderer {
+	mock := &MockTicketRenderer{ctrl: ctrl}
+	mock.recorder = &MockTicketRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketRenderer) EXPECT() *MockTicketRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderKitchenTicket mocks base method.
+func (m *MockTicketRenderer) RenderKitchenTicket(order *domain.Order) (*domain.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderKitchenTicket", order)
+	ret0, _ := ret[0].(*domain.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderKitchenTicket indicates an expected call of RenderKitchenTicket.
+func (mr *MockTicketRendererMockRecorder) RenderKitchenTicket(order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderKitchenTicket", reflect.TypeOf((*MockTicketRenderer)(nil).RenderKitchenTicket), order)
+}
+
+// RenderReceipt mocks base method.
+func (m *MockTicketRenderer) RenderReceipt(order *domain.Order) (*domain.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderReceipt", order)
+	ret0, _ := ret[0].(*domain.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderReceipt indicates an expected call of RenderReceipt.
+func (mr *MockTicketRendererMockRecorder) RenderReceipt(order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderReceipt", reflect.TypeOf((*MockTicketRenderer)(nil).RenderReceipt), order)
+}
@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transfer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=transfer.go -destination=mock/transfer.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransferRepository is a mock of TransferRepository interface.
+type MockTransferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferRepositoryMockRecorder
+}
+
+// MockTransferRepositoryMockRecorder is the mock recorder for MockTransferRepository.
+type MockTransferRepositoryMockRecorder struct {
+	mock *MockTransferRepository
+}
+
+// NewMockTransferRepository creates a new mock instance.
+func NewMockTransferRepository(ctrl *gomock.Controller) *MockTransferRepository {
+	mock := &MockTransferRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferRepository) EXPECT() *MockTransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelTransfer mocks base method.
+func (m *MockTransferRepository) CancelTransfer(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTransfer", ctx, id)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTransfer indicates an expected call of CancelTransfer.
+func (mr *MockTransferRepositoryMockRecorder) CancelTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransfer", reflect.TypeOf((*MockTransferRepository)(nil).CancelTransfer), ctx, id)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockTransferRepository) CreateTransfer(ctx context.Context, transfer *domain.Transfer) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, transfer)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockTransferRepositoryMockRecorder) CreateTransfer(ctx, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockTransferRepository)(nil).CreateTransfer), ctx, transfer)
+}
+
+// DispatchTransfer mocks base method.
+func (m *MockTransferRepository) DispatchTransfer(ctx context.Context, id, userID uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchTransfer", ctx, id, userID)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchTransfer indicates an expected call of DispatchTransfer.
+func (mr *MockTransferRepositoryMockRecorder) DispatchTransfer(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchTransfer", reflect.TypeOf((*MockTransferRepository)(nil).DispatchTransfer), ctx, id, userID)
+}
+
+// GetTransferByID mocks base method.
+func (m *MockTransferRepository) GetTransferByID(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferByID indicates an expected call of GetTransferByID.
+func (mr *MockTransferRepositoryMockRecorder) GetTransferByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferByID", reflect.TypeOf((*MockTransferRepository)(nil).GetTransferByID), ctx, id)
+}
+
+// ListTransfers mocks base method.
+func (m *MockTransferRepository) ListTransfers(ctx context.Context, status domain.TransferStatus, skip, limit uint64) ([]domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, status, skip, limit)
+	ret0, _ := ret[0].([]domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockTransferRepositoryMockRecorder) ListTransfers(ctx, status, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockTransferRepository)(nil).ListTransfers), ctx, status, skip, limit)
+}
+
+// ReceiveTransfer mocks base method.
+func (m *MockTransferRepository) ReceiveTransfer(ctx context.Context, transfer *domain.Transfer, userID uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTransfer", ctx, transfer, userID)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTransfer indicates an expected call of ReceiveTransfer.
+func (mr *MockTransferRepositoryMockRecorder) ReceiveTransfer(ctx, transfer, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTransfer", reflect.TypeOf((*MockTransferRepository)(nil).ReceiveTransfer), ctx, transfer, userID)
+}
+
+// MockTransferService is a mock of TransferService interface.
+type MockTransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferServiceMockRecorder
+}
+
+// MockTransferServiceMockRecorder is the mock recorder for MockTransferService.
+type MockTransferServiceMockRecorder struct {
+	mock *MockTransferService
+}
+
+// NewMockTransferService creates a new mock instance.
+func NewMockTransferService(ctrl *gomock.Controller) *MockTransferService {
+	mock := &MockTransferService{ctrl: ctrl}
+	mock.recorder = &MockTransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferService) EXPECT() *MockTransferServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelTransfer mocks base method.
+func (m *MockTransferService) CancelTransfer(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTransfer", ctx, id)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTransfer indicates an expected call of CancelTransfer.
+func (mr *MockTransferServiceMockRecorder) CancelTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransfer", reflect.TypeOf((*MockTransferService)(nil).CancelTransfer), ctx, id)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockTransferService) CreateTransfer(ctx context.Context, transfer *domain.Transfer) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, transfer)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockTransferServiceMockRecorder) CreateTransfer(ctx, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockTransferService)(nil).CreateTransfer), ctx, transfer)
+}
+
+// DispatchTransfer mocks base method.
+func (m *MockTransferService) DispatchTransfer(ctx context.Context, id, userID uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchTransfer", ctx, id, userID)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchTransfer indicates an expected call of DispatchTransfer.
+func (mr *MockTransferServiceMockRecorder) DispatchTransfer(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchTransfer", reflect.TypeOf((*MockTransferService)(nil).DispatchTransfer), ctx, id, userID)
+}
+
+// GetTransfer mocks base method.
+func (m *MockTransferService) GetTransfer(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockTransferServiceMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockTransferService)(nil).GetTransfer), ctx, id)
+}
+
+// ListTransfers mocks base method.
+func (m *MockTransferService) ListTransfers(ctx context.Context, status domain.TransferStatus, skip, limit uint64) ([]domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, status, skip, limit)
+	ret0, _ := ret[0].([]domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockTransferServiceMockRecorder) ListTransfers(ctx, status, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockTransferService)(nil).ListTransfers), ctx, status, skip, limit)
+}
+
+// ReceiveTransfer mocks base method.
+func (m *MockTransferService) ReceiveTransfer(ctx context.Context, id uint64, received []domain.TransferItem, userID uint64) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTransfer", ctx, id, received, userID)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTransfer indicates an expected call of ReceiveTransfer.
+func (mr *MockTransferServiceMockRecorder) ReceiveTransfer(ctx, id, received, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTransfer", reflect.TypeOf((*MockTransferService)(nil).ReceiveTransfer), ctx, id, received, userID)
+}
@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: twoFactor.go
+//
+// Generated by this command:
+//
+//	mockgen -source=twoFactor.go -destination=mock/twoFactor.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/bagashiz/go-pos/internal/core/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTwoFactorRepository is a mock of TwoFactorRepository interface.
+type MockTwoFactorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwoFactorRepositoryMockRecorder
+}
+
+// MockTwoFactorRepositoryMockRecorder is the mock recorder for MockTwoFactorRepository.
+type MockTwoFactorRepositoryMockRecorder struct {
+	mock *MockTwoFactorRepository
+}
+
+// NewMockTwoFactorRepository creates a new mock instance.
+func NewMockTwoFactorRepository(ctrl *gomock.Controller) *MockTwoFactorRepository {
+	mock := &MockTwoFactorRepository{ctrl: ctrl}
+	mock.recorder = &MockTwoFactorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwoFactorRepository) EXPECT() *MockTwoFactorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConfirmTwoFactor mocks base method.
+func (m *MockTwoFactorRepository) ConfirmTwoFactor(ctx context.Context, userID uint64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactor", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTwoFactor indicates an expected call of ConfirmTwoFactor.
+func (mr *MockTwoFactorRepositoryMockRecorder) ConfirmTwoFactor(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactor", reflect.TypeOf((*MockTwoFactorRepository)(nil).ConfirmTwoFactor), ctx, userID, now)
+}
+
+// DeleteTwoFactor mocks base method.
+func (m *MockTwoFactorRepository) DeleteTwoFactor(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTwoFactor indicates an expected call of DeleteTwoFactor.
+func (mr *MockTwoFactorRepositoryMockRecorder) DeleteTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTwoFactor", reflect.TypeOf((*MockTwoFactorRepository)(nil).DeleteTwoFactor), ctx, userID)
+}
+
+// GetTwoFactorByUserID mocks base method.
+func (m *MockTwoFactorRepository) GetTwoFactorByUserID(ctx context.Context, userID uint64) (*domain.TwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactorByUserID", ctx, userID)
+	ret0, _ := ret[0].(*domain.TwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactorByUserID indicates an expected call of GetTwoFactorByUserID.
+func (mr *MockTwoFactorRepositoryMockRecorder) GetTwoFactorByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorByUserID", reflect.TypeOf((*MockTwoFactorRepository)(nil).GetTwoFactorByUserID), ctx, userID)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID uint64, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", ctx, userID, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockTwoFactorRepositoryMockRecorder) ReplaceRecoveryCodes(ctx, userID, codeHashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockTwoFactorRepository)(nil).ReplaceRecoveryCodes), ctx, userID, codeHashes)
+}
+
+// SaveTwoFactor mocks base method.
+func (m *MockTwoFactorRepository) SaveTwoFactor(ctx context.Context, twoFactor *domain.TwoFactor) (*domain.TwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTwoFactor", ctx, twoFactor)
+	ret0, _ := ret[0].(*domain.TwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTwoFactor indicates an expected call of SaveTwoFactor.
+func (mr *MockTwoFactorRepositoryMockRecorder) SaveTwoFactor(ctx, twoFactor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTwoFactor", reflect.TypeOf((*MockTwoFactorRepository)(nil).SaveTwoFactor), ctx, twoFactor)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID uint64, codeHash string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userID, codeHash, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockTwoFactorRepositoryMockRecorder) UseRecoveryCode(ctx, userID, codeHash, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockTwoFactorRepository)(nil).UseRecoveryCode), ctx, userID, codeHash, now)
+}
+
+// MockTOTPService is a mock of TOTPService interface.
+type MockTOTPService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTOTPServiceMockRecorder
+}
+
+// MockTOTPServiceMockRecorder is the mock recorder for MockTOTPService.
+type MockTOTPServiceMockRecorder struct {
+	mock *MockTOTPService
+}
+
+// NewMockTOTPService creates a new mock instance.
+func NewMockTOTPService(ctrl *gomock.Controller) *MockTOTPService {
+	mock := &MockTOTPService{ctrl: ctrl}
+	mock.recorder = &MockTOTPServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTOTPService) EXPECT() *MockTOTPServiceMockRecorder {
+	return m.recorder
+}
+
+// GenerateSecret mocks base method.
+func (m *MockTOTPService) GenerateSecret(accountName string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret", accountName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret.
+func (mr *MockTOTPServiceMockRecorder) GenerateSecret(accountName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockTOTPService)(nil).GenerateSecret), accountName)
+}
+
+// ValidateCode mocks base method.
+func (m *MockTOTPService) ValidateCode(secret, code string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCode", secret, code)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ValidateCode indicates an expected call of ValidateCode.
+func (mr *MockTOTPServiceMockRecorder) ValidateCode(secret, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCode", reflect.TypeOf((*MockTOTPService)(nil).ValidateCode), secret, code)
+}
@@ -129,6 +129,20 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUser(ctx, user any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), ctx, user)
 }
 
+// UpdateUserPIN mocks base method.
+func (m *MockUserRepository) UpdateUserPIN(ctx context.Context, id uint64, pin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPIN", ctx, id, pin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPIN indicates an expected call of UpdateUserPIN.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserPIN(ctx, id, pin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPIN", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserPIN), ctx, id, pin)
+}
+
 // MockUserService is a mock of UserService interface.
 type MockUserService struct {
 	ctrl     *gomock.Controller
@@ -211,6 +225,20 @@ func (mr *MockUserServiceMockRecorder) Register(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUserService)(nil).Register), ctx, user)
 }
 
+// SetPIN mocks base method.
+func (m *MockUserService) SetPIN(ctx context.Context, id uint64, pin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPIN", ctx, id, pin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPIN indicates an expected call of SetPIN.
+func (mr *MockUserServiceMockRecorder) SetPIN(ctx, id, pin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPIN", reflect.TypeOf((*MockUserService)(nil).SetPIN), ctx, id, pin)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserService) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=modifier.go -destination=mock/modifier.go -package=mock
+
+// ModifierRepository is an interface for interacting with modifier-related data
+type ModifierRepository interface {
+	// CreateModifierGroup inserts a new modifier group with its modifiers into the database
+	CreateModifierGroup(ctx context.Context, group *domain.ModifierGroup) (*domain.ModifierGroup, error)
+	// GetModifierGroupByID selects a modifier group with its modifiers by id
+	GetModifierGroupByID(ctx context.Context, id uint64) (*domain.ModifierGroup, error)
+	// ListModifierGroups selects the modifier groups of a product with their modifiers
+	ListModifierGroups(ctx context.Context, productID uint64) ([]domain.ModifierGroup, error)
+	// DeleteModifierGroup deletes a modifier group with its modifiers
+	DeleteModifierGroup(ctx context.Context, id uint64) error
+}
+
+// ModifierService is an interface for interacting with modifier-related business logic
+type ModifierService interface {
+	// CreateModifierGroup creates a new modifier group with its modifiers for a product
+	CreateModifierGroup(ctx context.Context, group *domain.ModifierGroup) (*domain.ModifierGroup, error)
+	// ListModifierGroups returns the modifier groups of a product with their modifiers
+	ListModifierGroups(ctx context.Context, productID uint64) ([]domain.ModifierGroup, error)
+	// DeleteModifierGroup deletes a modifier group of a product
+	DeleteModifierGroup(ctx context.Context, productID, id uint64) error
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=notifier.go -destination=mock/notifier.go -package=mock
+
+// Notifier is an interface for sending notifications to users
+type Notifier interface {
+	// SendPasswordReset sends a password reset token to a user
+	SendPasswordReset(ctx context.Context, user *domain.User, resetToken *domain.PasswordResetToken) error
+}
@@ -26,4 +26,8 @@ type OrderService interface {
 	GetOrder(ctx context.Context, id uint64) (*domain.Order, error)
 	// ListOrders returns a list of orders with pagination
 	ListOrders(ctx context.Context, skip, limit uint64) ([]domain.Order, error)
+	// GetReceipt renders the customer receipt of an order by id
+	GetReceipt(ctx context.Context, id uint64) (*domain.Ticket, error)
+	// GetKitchenTicket renders the kitchen ticket of an order by id
+	GetKitchenTicket(ctx context.Context, id uint64) (*domain.Ticket, error)
 }
@@ -0,0 +1,32 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=passwordReset.go -destination=mock/passwordReset.go -package=mock
+
+// PasswordResetRepository is an interface for interacting with password reset token-related data
+type PasswordResetRepository interface {
+	// CreatePasswordResetToken inserts a new password reset token into the database
+	CreatePasswordResetToken(ctx context.Context, resetToken *domain.PasswordResetToken) (*domain.PasswordResetToken, error)
+	// GetPasswordResetTokenByHash selects a password reset token by the hash of the token
+	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error)
+	// UsePasswordResetToken marks an unused password reset token as used, failing if it has already been used
+	UsePasswordResetToken(ctx context.Context, id uint64, now time.Time) error
+	// InvalidateUserPasswordResetTokens marks the unused password reset tokens of a user as used
+	InvalidateUserPasswordResetTokens(ctx context.Context, userID uint64, now time.Time) error
+}
+
+// PasswordService is an interface for interacting with password-related business logic
+type PasswordService interface {
+	// ChangePassword changes the password of a user who knows their current password
+	ChangePassword(ctx context.Context, userID uint64, currentPassword, newPassword, ipAddress string) error
+	// RequestPasswordReset sends a password reset token to the user with the email, if there is one
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword sets a new password for the user a password reset token was sent to
+	ResetPassword(ctx context.Context, token, newPassword string) error
+}
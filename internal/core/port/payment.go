@@ -18,6 +18,8 @@ type PaymentRepository interface {
 	ListPayments(ctx context.Context, skip, limit uint64) ([]domain.Payment, error)
 	// UpdatePayment updates a payment
 	UpdatePayment(ctx context.Context, payment *domain.Payment) (*domain.Payment, error)
+	// UpdatePaymentLogo replaces the logo and logo thumbnail URLs of a payment
+	UpdatePaymentLogo(ctx context.Context, id uint64, logo, thumbnail string) (*domain.Payment, error)
 	// DeletePayment deletes a payment
 	DeletePayment(ctx context.Context, id uint64) error
 }
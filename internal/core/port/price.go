@@ -0,0 +1,43 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=price.go -destination=mock/price.go -package=mock
+
+// PriceRepository is an interface for interacting with price history and scheduled price data
+type PriceRepository interface {
+	// ListPriceChanges selects the price history of a product with pagination, newest first
+	ListPriceChanges(ctx context.Context, productID, skip, limit uint64) ([]domain.PriceChange, error)
+	// CreateScheduledPrice inserts a new scheduled price into the database
+	CreateScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) (*domain.ScheduledPrice, error)
+	// GetScheduledPriceByID selects a scheduled price by id
+	GetScheduledPriceByID(ctx context.Context, id uint64) (*domain.ScheduledPrice, error)
+	// ListScheduledPrices selects the scheduled prices of a product, optionally only the ones not applied yet
+	ListScheduledPrices(ctx context.Context, productID uint64, pending bool) ([]domain.ScheduledPrice, error)
+	// ListDueScheduledPrices selects the scheduled prices not applied yet whose effective time has been reached
+	ListDueScheduledPrices(ctx context.Context, now time.Time) ([]domain.ScheduledPrice, error)
+	// ApplyScheduledPrice sets the price of a product to a scheduled price and records the price change
+	ApplyScheduledPrice(ctx context.Context, id uint64, now time.Time) (*domain.Product, error)
+	// DeleteScheduledPrice deletes a scheduled price
+	DeleteScheduledPrice(ctx context.Context, id uint64) error
+}
+
+// PriceService is an interface for interacting with price history and scheduled price business logic
+type PriceService interface {
+	// ListPriceHistory returns the price history of a product with pagination
+	ListPriceHistory(ctx context.Context, productID, skip, limit uint64) ([]domain.PriceChange, error)
+	// SchedulePrice schedules a future price of a product
+	SchedulePrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) (*domain.ScheduledPrice, error)
+	// ListScheduledPrices returns the scheduled prices of a product
+	ListScheduledPrices(ctx context.Context, productID uint64, pending bool) ([]domain.ScheduledPrice, error)
+	// CancelScheduledPrice cancels a scheduled price that has not taken effect yet
+	CancelScheduledPrice(ctx context.Context, id uint64) error
+	// ApplyScheduledPrices applies the scheduled prices whose effective time has been reached
+	// and returns the number of prices applied
+	ApplyScheduledPrices(ctx context.Context, now time.Time) (int, error)
+}
@@ -0,0 +1,42 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=priceList.go -destination=mock/priceList.go -package=mock
+
+// PriceListRepository is an interface for interacting with price list-related data
+type PriceListRepository interface {
+	// CreatePriceList inserts a new price list along with its items into the database
+	CreatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error)
+	// GetPriceListByID selects a price list along with its items by id
+	GetPriceListByID(ctx context.Context, id uint64) (*domain.PriceList, error)
+	// ListPriceLists selects a list of price lists with pagination
+	ListPriceLists(ctx context.Context, skip, limit uint64) ([]domain.PriceList, error)
+	// ListPriceListsByProduct selects the price lists containing a product, along with the item of that product only
+	ListPriceListsByProduct(ctx context.Context, productID uint64) ([]domain.PriceList, error)
+	// UpdatePriceList updates a price list and replaces its items
+	UpdatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error)
+	// DeletePriceList deletes a price list
+	DeletePriceList(ctx context.Context, id uint64) error
+}
+
+// PriceListService is an interface for interacting with price list-related business logic
+type PriceListService interface {
+	// CreatePriceList creates a new price list
+	CreatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error)
+	// GetPriceList returns a price list by id
+	GetPriceList(ctx context.Context, id uint64) (*domain.PriceList, error)
+	// ListPriceLists returns a list of price lists with pagination
+	ListPriceLists(ctx context.Context, skip, limit uint64) ([]domain.PriceList, error)
+	// UpdatePriceList updates a price list
+	UpdatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error)
+	// DeletePriceList deletes a price list
+	DeletePriceList(ctx context.Context, id uint64) error
+	// GetProductPrice returns the price of a product for a customer group at the given time
+	GetProductPrice(ctx context.Context, productID uint64, group domain.CustomerGroup, at time.Time) (*domain.ProductPrice, error)
+}
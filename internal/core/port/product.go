@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=product.go -destination=mock/product.go -package=mock
@@ -14,12 +15,26 @@ type ProductRepository interface {
 	CreateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error)
 	// GetProductByID selects a product by id
 	GetProductByID(ctx context.Context, id uint64) (*domain.Product, error)
-	// ListProducts selects a list of products with pagination
-	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domain.Product, error)
-	// UpdateProduct updates a product
-	UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error)
+	// GetProductBySKU selects a product by SKU
+	GetProductBySKU(ctx context.Context, sku uuid.UUID) (*domain.Product, error)
+	// ListProducts selects a list of products with pagination, optionally only the ones without a parent product.
+	// Filtering by category includes the products of its sub-categories at any depth
+	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, topLevel bool) ([]domain.Product, error)
+	// ListVariants selects a list of variants of a parent product
+	ListVariants(ctx context.Context, parentId uint64) ([]domain.Product, error)
+	// UpdateProductImage replaces the image and thumbnail URLs of a product
+	UpdateProductImage(ctx context.Context, id uint64, image, thumbnail string) (*domain.Product, error)
+	// IncrementStock adds a received quantity at the given unit cost to the stock of a product
+	IncrementStock(ctx context.Context, id uint64, quantity, unitCost float64) (*domain.Product, error)
+	// ListBundleComponents selects the components of a bundle product
+	ListBundleComponents(ctx context.Context, bundleId uint64) ([]domain.BundleComponent, error)
+	// UpdateProduct updates a product, recording a change of its price as made by the given user
+	UpdateProduct(ctx context.Context, product *domain.Product, userID uint64) (*domain.Product, error)
 	// DeleteProduct deletes a product
 	DeleteProduct(ctx context.Context, id uint64) error
+	// ImportProducts creates the products without an id and updates the others all at once, adding their new barcodes
+	// and recording changes of their prices as made by the given user. Changes of stock are recorded in the cost layers
+	ImportProducts(ctx context.Context, products []domain.Product, userID uint64) ([]domain.Product, error)
 }
 
 // ProductService is an interface for interacting with product-related business logic
@@ -28,10 +43,12 @@ type ProductService interface {
 	CreateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error)
 	// GetProduct returns a product by id
 	GetProduct(ctx context.Context, id uint64) (*domain.Product, error)
-	// ListProducts returns a list of products with pagination
-	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64) ([]domain.Product, error)
-	// UpdateProduct updates a product
-	UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error)
+	// ListProducts returns a list of products with pagination, optionally grouping variants under their parent product
+	ListProducts(ctx context.Context, search string, categoryId, skip, limit uint64, grouped bool) ([]domain.Product, error)
+	// UpdateProduct updates a product on behalf of a user
+	UpdateProduct(ctx context.Context, product *domain.Product, userID uint64) (*domain.Product, error)
+	// ReceiveProduct adds a received quantity, given in the unit of measure at a cost per that unit, to the stock of a product
+	ReceiveProduct(ctx context.Context, id uint64, quantity float64, unit string, cost float64) (*domain.Product, error)
 	// DeleteProduct deletes a product
 	DeleteProduct(ctx context.Context, id uint64) error
 }
@@ -0,0 +1,25 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=refreshToken.go -destination=mock/refreshToken.go -package=mock
+
+// RefreshTokenRepository is an interface for interacting with refresh token-related data
+type RefreshTokenRepository interface {
+	// CreateRefreshToken inserts a new refresh token into the database
+	CreateRefreshToken(ctx context.Context, refreshToken *domain.RefreshToken) (*domain.RefreshToken, error)
+	// GetRefreshTokenByHash selects a refresh token by the hash of the token
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	// UseRefreshToken marks an active refresh token as used, failing if it has already been used or revoked
+	UseRefreshToken(ctx context.Context, id uint64, now time.Time) error
+	// RevokeRefreshTokenFamily revokes the active refresh tokens of a family
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error
+	// RevokeUserRefreshTokens revokes the active refresh tokens of a user
+	RevokeUserRefreshTokens(ctx context.Context, userID uint64, now time.Time) error
+}
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=report.go -destination=mock/report.go -package=mock
+
+// ReportRepository is an interface for interacting with report-related data
+type ReportRepository interface {
+	// ListProductMargins selects the quantity sold, revenue and cost of the products sold within a period
+	ListProductMargins(ctx context.Context, from, to time.Time) ([]domain.ProductMargin, error)
+	// ListStockValuations selects the stock on hand and remaining cost layers of the products that hold stock
+	ListStockValuations(ctx context.Context) ([]domain.StockValuation, error)
+}
+
+// ReportService is an interface for interacting with report-related business logic
+type ReportService interface {
+	// GetMarginReport reports the revenue, cost and gross margin of the sales within a period
+	GetMarginReport(ctx context.Context, from, to time.Time) (*domain.MarginReport, error)
+	// GetInventoryValuation reports the value of the stock on hand
+	GetInventoryValuation(ctx context.Context) (*domain.InventoryValuation, error)
+}
@@ -0,0 +1,39 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=role.go -destination=mock/role.go -package=mock
+
+// RoleRepository is an interface for interacting with role-related data
+type RoleRepository interface {
+	// CreateRole inserts a new role into the database
+	CreateRole(ctx context.Context, role *domain.Role) (*domain.Role, error)
+	// GetRoleByID selects a role by id
+	GetRoleByID(ctx context.Context, id uint64) (*domain.Role, error)
+	// GetRoleByName selects a role by name
+	GetRoleByName(ctx context.Context, name domain.UserRole) (*domain.Role, error)
+	// ListRoles selects all roles
+	ListRoles(ctx context.Context) ([]domain.Role, error)
+	// UpdateRole updates a role
+	UpdateRole(ctx context.Context, role *domain.Role) (*domain.Role, error)
+	// DeleteRole deletes a role
+	DeleteRole(ctx context.Context, id uint64) error
+}
+
+// RoleService is an interface for interacting with role-related business logic
+type RoleService interface {
+	// CreateRole creates a new role
+	CreateRole(ctx context.Context, role *domain.Role) (*domain.Role, error)
+	// GetRole returns a role by id
+	GetRole(ctx context.Context, id uint64) (*domain.Role, error)
+	// ListRoles returns all roles
+	ListRoles(ctx context.Context) ([]domain.Role, error)
+	// UpdateRole updates the name or permissions of a role
+	UpdateRole(ctx context.Context, role *domain.Role) (*domain.Role, error)
+	// DeleteRole deletes a role no user is given
+	DeleteRole(ctx context.Context, id uint64) error
+}
@@ -0,0 +1,29 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=serial.go -destination=mock/serial.go -package=mock
+
+// SerialRepository is an interface for interacting with serial-related data
+type SerialRepository interface {
+	// CreateSerials inserts new in-stock serials of a product into the database and adds them at the given unit cost to the product stock
+	CreateSerials(ctx context.Context, productID uint64, serialNumbers []string, unitCost float64) ([]domain.Serial, error)
+	// ListSerials selects a list of serials of a product by status with pagination
+	ListSerials(ctx context.Context, productID uint64, status domain.SerialStatus, skip, limit uint64) ([]domain.Serial, error)
+	// ListSerialsBySerialNumber selects a list of serials by serial number
+	ListSerialsBySerialNumber(ctx context.Context, serialNumber string) ([]domain.Serial, error)
+}
+
+// SerialService is an interface for interacting with serial-related business logic
+type SerialService interface {
+	// CreateSerials receives new serial numbers of a serial-tracked product at a cost per unit
+	CreateSerials(ctx context.Context, productID uint64, serialNumbers []string, cost float64) ([]domain.Serial, error)
+	// ListSerials returns a list of serials of a product by status with pagination
+	ListSerials(ctx context.Context, productID uint64, status domain.SerialStatus, skip, limit uint64) ([]domain.Serial, error)
+	// SearchSerials returns the serials with the given serial number along with the orders they were sold on
+	SearchSerials(ctx context.Context, serialNumber string) ([]domain.Serial, error)
+}
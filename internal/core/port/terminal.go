@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=terminal.go -destination=mock/terminal.go -package=mock
+
+// TerminalRepository is an interface for interacting with terminal-related data
+type TerminalRepository interface {
+	// CreateTerminal inserts a new terminal into the database
+	CreateTerminal(ctx context.Context, terminal *domain.Terminal) (*domain.Terminal, error)
+	// GetTerminalByCredentialHash selects a terminal by the hash of its credential
+	GetTerminalByCredentialHash(ctx context.Context, credentialHash string) (*domain.Terminal, error)
+	// ListTerminals selects all terminals
+	ListTerminals(ctx context.Context) ([]domain.Terminal, error)
+	// DeleteTerminal deletes a terminal
+	DeleteTerminal(ctx context.Context, id uint64) error
+}
+
+// TerminalService is an interface for interacting with terminal-related business logic
+type TerminalService interface {
+	// RegisterTerminal registers a new terminal and returns it with its credential
+	RegisterTerminal(ctx context.Context, terminal *domain.Terminal) (*domain.Terminal, error)
+	// ListTerminals returns all terminals
+	ListTerminals(ctx context.Context) ([]domain.Terminal, error)
+	// DeleteTerminal deletes a terminal, so PIN login is no longer accepted on it
+	DeleteTerminal(ctx context.Context, id uint64) error
+}
@@ -0,0 +1,13 @@
+package port
+
+import "github.com/bagashiz/go-pos/internal/core/domain"
+
+//go:generate mockgen -source=ticket.go -destination=mock/ticket.go -package=mock
+
+// TicketRenderer is an interface for rendering printable order tickets
+type TicketRenderer interface {
+	// RenderReceipt renders the customer receipt of an order
+	RenderReceipt(order *domain.Order) (*domain.Ticket, error)
+	// RenderKitchenTicket renders the ticket of an order sent to the kitchen, listing what to prepare without prices
+	RenderKitchenTicket(order *domain.Order) (*domain.Ticket, error)
+}
@@ -0,0 +1,44 @@
+package port
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=transfer.go -destination=mock/transfer.go -package=mock
+
+// TransferRepository is an interface for interacting with transfer-related data
+type TransferRepository interface {
+	// CreateTransfer inserts a new requested transfer and its items into the database
+	CreateTransfer(ctx context.Context, transfer *domain.Transfer) (*domain.Transfer, error)
+	// GetTransferByID selects a transfer by id along with its items and stock movements
+	GetTransferByID(ctx context.Context, id uint64) (*domain.Transfer, error)
+	// ListTransfers selects a list of transfers, of any status if it is empty, with pagination
+	ListTransfers(ctx context.Context, status domain.TransferStatus, skip, limit uint64) ([]domain.Transfer, error)
+	// DispatchTransfer takes the items of a requested transfer out of the stock of its source location,
+	// recording the stock movements, and puts the transfer in transit
+	DispatchTransfer(ctx context.Context, id, userID uint64) (*domain.Transfer, error)
+	// ReceiveTransfer adds the received quantities of the items of a transfer in transit to the stock of its destination location,
+	// recording them and the stock movements, and marks the transfer as received
+	ReceiveTransfer(ctx context.Context, transfer *domain.Transfer, userID uint64) (*domain.Transfer, error)
+	// CancelTransfer cancels a requested transfer
+	CancelTransfer(ctx context.Context, id uint64) (*domain.Transfer, error)
+}
+
+// TransferService is an interface for interacting with transfer-related business logic
+type TransferService interface {
+	// CreateTransfer requests a transfer of stock between two locations
+	CreateTransfer(ctx context.Context, transfer *domain.Transfer) (*domain.Transfer, error)
+	// GetTransfer returns a transfer by id
+	GetTransfer(ctx context.Context, id uint64) (*domain.Transfer, error)
+	// ListTransfers returns a list of transfers, of any status if it is empty, with pagination
+	ListTransfers(ctx context.Context, status domain.TransferStatus, skip, limit uint64) ([]domain.Transfer, error)
+	// DispatchTransfer sends the stock of a requested transfer from its source location
+	DispatchTransfer(ctx context.Context, id, userID uint64) (*domain.Transfer, error)
+	// ReceiveTransfer receives the stock of a transfer in transit at its destination location,
+	// given the quantity received of each of its products
+	ReceiveTransfer(ctx context.Context, id uint64, received []domain.TransferItem, userID uint64) (*domain.Transfer, error)
+	// CancelTransfer cancels a requested transfer
+	CancelTransfer(ctx context.Context, id uint64) (*domain.Transfer, error)
+}
@@ -0,0 +1,35 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+)
+
+//go:generate mockgen -source=twoFactor.go -destination=mock/twoFactor.go -package=mock
+
+// TwoFactorRepository is an interface for interacting with two-factor authentication-related data
+type TwoFactorRepository interface {
+	// SaveTwoFactor inserts the two-factor authentication of a user into the database,
+	// replacing the previous one unless it has been confirmed
+	SaveTwoFactor(ctx context.Context, twoFactor *domain.TwoFactor) (*domain.TwoFactor, error)
+	// GetTwoFactorByUserID selects the two-factor authentication of a user
+	GetTwoFactorByUserID(ctx context.Context, userID uint64) (*domain.TwoFactor, error)
+	// ConfirmTwoFactor marks the unconfirmed two-factor authentication of a user as confirmed
+	ConfirmTwoFactor(ctx context.Context, userID uint64, now time.Time) error
+	// DeleteTwoFactor deletes the two-factor authentication of a user along with their recovery codes
+	DeleteTwoFactor(ctx context.Context, userID uint64) error
+	// ReplaceRecoveryCodes replaces the recovery codes of a user with the hashes of new ones
+	ReplaceRecoveryCodes(ctx context.Context, userID uint64, codeHashes []string) error
+	// UseRecoveryCode marks an unused recovery code of a user as used, failing if there is none with the hash
+	UseRecoveryCode(ctx context.Context, userID uint64, codeHash string, now time.Time) error
+}
+
+// TOTPService is an interface for generating and validating time-based one-time passwords
+type TOTPService interface {
+	// GenerateSecret generates a new secret for an account and returns it with its provisioning URI
+	GenerateSecret(accountName string) (secret, uri string, err error)
+	// ValidateCode checks whether a code is valid for a secret at the current time
+	ValidateCode(secret, code string) bool
+}
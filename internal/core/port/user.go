@@ -22,6 +22,8 @@ type UserRepository interface {
 	UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error)
 	// DeleteUser deletes a user
 	DeleteUser(ctx context.Context, id uint64) error
+	// UpdateUserPIN sets the hashed PIN of a user
+	UpdateUserPIN(ctx context.Context, id uint64, pin string) error
 }
 
 // UserService is an interface for interacting with user-related business logic
@@ -36,4 +38,6 @@ type UserService interface {
 	UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error)
 	// DeleteUser deletes a user
 	DeleteUser(ctx context.Context, id uint64) error
+	// SetPIN sets the PIN a cashier logs in with on a registered terminal
+	SetPIN(ctx context.Context, id uint64, pin string) error
 }
@@ -2,49 +2,294 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port"
 	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/google/uuid"
 )
 
 /**
  * AuthService implements port.AuthService interface
- * and provides an access to the user repository
- * and token service
+ * and provides an access to the user, refresh token, terminal, role and two-factor repositories,
+ * token service, TOTP service, cache service and audit logger.
+ * If enforceAdminTwoFactor is set, users with a privileged role, such as admins, cannot log in without two-factor authentication
  */
 type AuthService struct {
-	repo port.UserRepository
-	ts   port.TokenService
+	repo                  port.UserRepository
+	refreshRepo           port.RefreshTokenRepository
+	terminalRepo          port.TerminalRepository
+	roleRepo              port.RoleRepository
+	twoFactorRepo         port.TwoFactorRepository
+	ts                    port.TokenService
+	totp                  port.TOTPService
+	cache                 port.CacheRepository
+	audit                 port.AuditLogger
+	enforceAdminTwoFactor bool
 }
 
 // NewAuthService creates a new auth service instance
-func NewAuthService(repo port.UserRepository, ts port.TokenService) *AuthService {
+func NewAuthService(repo port.UserRepository, refreshRepo port.RefreshTokenRepository, terminalRepo port.TerminalRepository, roleRepo port.RoleRepository, twoFactorRepo port.TwoFactorRepository, ts port.TokenService, totp port.TOTPService, cache port.CacheRepository, audit port.AuditLogger, enforceAdminTwoFactor bool) *AuthService {
 	return &AuthService{
 		repo,
+		refreshRepo,
+		terminalRepo,
+		roleRepo,
+		twoFactorRepo,
 		ts,
+		totp,
+		cache,
+		audit,
+		enforceAdminTwoFactor,
 	}
 }
 
-// Login gives a registered user an access token if the credentials are valid
-func (as *AuthService) Login(ctx context.Context, email, password string) (string, error) {
+// Login gives a registered user an access token and a refresh token for the device if the credentials are valid,
+// or a two-factor challenge to complete the login with if the user has to pass two-factor authentication.
+// Failed attempts delay and then lock out further attempts for the email and the client IP address
+func (as *AuthService) Login(ctx context.Context, email, password string, device domain.Device) (*domain.AuthToken, error) {
+	subject := emailLoginSubject(email)
+
+	err := checkLoginAttempts(ctx, as.cache, subject, device.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := as.repo.GetUserByEmail(ctx, email)
 	if err != nil {
 		if err == domain.ErrDataNotFound {
-			return "", domain.ErrInvalidCredentials
+			return nil, as.loginFailed(ctx, subject, 0, device.IPAddress, domain.ErrInvalidCredentials)
 		}
-		return "", domain.ErrInternal
+		return nil, domain.ErrInternal
 	}
 
 	err = util.ComparePassword(password, user.Password)
 	if err != nil {
-		return "", domain.ErrInvalidCredentials
+		return nil, as.loginFailed(ctx, subject, user.ID, device.IPAddress, domain.ErrInvalidCredentials)
+	}
+
+	role, err := as.roleRepo.GetRoleByName(ctx, user.Role)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return as.finishLogin(ctx, user, role, subject, device)
+}
+
+// LoginWithPIN gives a user whose role allows PIN login an access token and a refresh token if the PIN is valid,
+// or a two-factor challenge if the user has to pass two-factor authentication,
+// only on a registered terminal, whose name is used as the device name.
+// Failed attempts delay and then lock out further attempts for the PIN of the user and the client IP address
+func (as *AuthService) LoginWithPIN(ctx context.Context, terminalCredential string, userID uint64, pin string, device domain.Device) (*domain.AuthToken, error) {
+	if terminalCredential == "" {
+		return nil, domain.ErrInvalidTerminal
+	}
+
+	terminal, err := as.terminalRepo.GetTerminalByCredentialHash(ctx, util.HashToken(terminalCredential))
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, domain.ErrInvalidTerminal
+		}
+		return nil, domain.ErrInternal
+	}
+
+	subject := pinLoginSubject(userID)
+
+	err = checkLoginAttempts(ctx, as.cache, subject, device.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, as.loginFailed(ctx, subject, 0, device.IPAddress, domain.ErrInvalidPIN)
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if user.PIN == "" {
+		return nil, as.loginFailed(ctx, subject, user.ID, device.IPAddress, domain.ErrInvalidPIN)
+	}
+
+	role, err := as.roleRepo.GetRoleByName(ctx, user.Role)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	if !role.HasPermission(domain.AuthPINLogin) {
+		return nil, as.loginFailed(ctx, subject, user.ID, device.IPAddress, domain.ErrInvalidPIN)
+	}
+
+	err = util.ComparePassword(pin, user.PIN)
+	if err != nil {
+		return nil, as.loginFailed(ctx, subject, user.ID, device.IPAddress, domain.ErrInvalidPIN)
+	}
+
+	device.Name = terminal.Name
+
+	return as.finishLogin(ctx, user, role, subject, device)
+}
+
+// Refresh replaces a refresh token with a new one of the same family and gives a new access token.
+// Using a refresh token that has already been replaced revokes its whole family,
+// as either the user or someone who stole the token holds the replacement.
+// The family is also revoked when the role of the user now requires two-factor authentication the user has not enabled,
+// so that a session started without a second factor does not keep getting privileged access tokens
+func (as *AuthService) Refresh(ctx context.Context, refreshToken string, device domain.Device) (*domain.AuthToken, error) {
+	token, err := as.refreshRepo.GetRefreshTokenByHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, domain.ErrInternal
+	}
+
+	now := time.Now()
+
+	if token.IsUsed() {
+		err = as.refreshRepo.RevokeRefreshTokenFamily(ctx, token.FamilyID, now)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+		return nil, domain.ErrRefreshTokenReused
+	}
+
+	if !token.IsActive(now) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+
+	err = as.refreshRepo.UseRefreshToken(ctx, token.ID, now)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			// Used or revoked by a concurrent request since it was read
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, domain.ErrInternal
+	}
+
+	user, err := as.repo.GetUserByID(ctx, token.UserID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+		return nil, domain.ErrInternal
+	}
+
+	role, err := as.roleRepo.GetRoleByName(ctx, user.Role)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	if as.requiresTwoFactor(role) {
+		twoFactor, err := as.twoFactorRepo.GetTwoFactorByUserID(ctx, user.ID)
+		if err != nil && err != domain.ErrDataNotFound {
+			return nil, domain.ErrInternal
+		}
+
+		if err != nil || !twoFactor.IsEnabled() {
+			err = as.refreshRepo.RevokeRefreshTokenFamily(ctx, token.FamilyID, now)
+			if err != nil {
+				return nil, domain.ErrInternal
+			}
+			return nil, domain.ErrInvalidRefreshToken
+		}
+	}
+
+	if device.Name == "" {
+		device.Name = token.Device.Name
+	}
+
+	return as.issueTokens(ctx, user, role, token.FamilyID, device)
+}
+
+// issueTokens creates an access token for a user with the permissions of their role
+// and stores a new refresh token of a family for the device
+func (as *AuthService) issueTokens(ctx context.Context, user *domain.User, role *domain.Role, familyID uuid.UUID, device domain.Device) (*domain.AuthToken, error) {
+	accessToken, err := as.ts.CreateToken(user, role.Permissions, familyID)
+	if err != nil {
+		return nil, domain.ErrTokenCreation
+	}
+
+	refreshToken, expiresAt, err := as.ts.CreateRefreshToken()
+	if err != nil {
+		return nil, domain.ErrTokenCreation
+	}
+
+	_, err = as.refreshRepo.CreateRefreshToken(ctx, &domain.RefreshToken{
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: util.HashToken(refreshToken),
+		Device:    device,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return &domain.AuthToken{
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: expiresAt,
+	}, nil
+}
+
+// Logout revokes an access token until it expires, and the refresh tokens of its session
+func (as *AuthService) Logout(ctx context.Context, payload *domain.TokenPayload) error {
+	ttl := time.Until(payload.ExpiredAt)
+	if ttl > 0 {
+		cacheKey := util.GenerateCacheKey("revoked_token", payload.ID)
+
+		err := as.cache.Set(ctx, cacheKey, []byte("1"), ttl)
+		if err != nil {
+			return domain.ErrInternal
+		}
+	}
+
+	err := as.refreshRepo.RevokeRefreshTokenFamily(ctx, payload.SessionID, time.Now())
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// LogoutAll revokes all access tokens and refresh tokens of a user, on every device
+func (as *AuthService) LogoutAll(ctx context.Context, userID uint64) error {
+	return revokeUserTokens(ctx, as.refreshRepo, as.cache, userID)
+}
+
+// revokeUserTokens revokes the refresh tokens of a user and the access tokens issued to them until now.
+// The revocation time is kept without expiry, as it only rejects tokens issued before it
+func revokeUserTokens(ctx context.Context, refreshRepo port.RefreshTokenRepository, cache port.CacheRepository, userID uint64) error {
+	now := time.Now()
+
+	cacheKey := util.GenerateCacheKey("revoked_user", userID)
+	nowSerialized, err := util.Serialize(now)
+	if err != nil {
+		return domain.ErrInternal
 	}
 
-	accessToken, err := as.ts.CreateToken(user)
+	err = cache.Set(ctx, cacheKey, nowSerialized, 0)
 	if err != nil {
-		return "", domain.ErrTokenCreation
+		return domain.ErrInternal
+	}
+
+	err = refreshRepo.RevokeUserRefreshTokens(ctx, userID, now)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// ListPublicKeys returns the public keys verifying the access tokens, if they are signed with asymmetric keys
+func (as *AuthService) ListPublicKeys(ctx context.Context) ([]domain.PublicKey, error) {
+	keys := as.ts.PublicKeys()
+	if len(keys) == 0 {
+		return nil, domain.ErrDataNotFound
 	}
 
-	return accessToken, nil
+	return keys, nil
 }
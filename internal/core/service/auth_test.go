@@ -3,22 +3,80 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port/mock"
 	"github.com/bagashiz/go-pos/internal/core/service"
 	"github.com/bagashiz/go-pos/internal/core/util"
 	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
 )
 
+// cashierRole is the built-in cashier role returned by the role repository of newRoleRepository
+var cashierRole = &domain.Role{
+	Name:        domain.Cashier,
+	Permissions: []domain.Permission{domain.AuthPINLogin},
+}
+
+// newRoleRepository mocks a role repository holding the built-in cashier role,
+// in which every other role, such as the admin role, can manage users and roles
+func newRoleRepository(ctrl *gomock.Controller) *mock.MockRoleRepository {
+	roleRepo := mock.NewMockRoleRepository(ctrl)
+	roleRepo.EXPECT().
+		GetRoleByName(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(_ context.Context, name domain.UserRole) (*domain.Role, error) {
+			if name == domain.Cashier {
+				return cashierRole, nil
+			}
+			return &domain.Role{
+				Name:        name,
+				Permissions: []domain.Permission{domain.UsersManage, domain.RolesManage},
+			}, nil
+		})
+
+	return roleRepo
+}
+
+// newTwoFactorRepository mocks a two-factor repository in which no user is enrolled
+func newTwoFactorRepository(ctrl *gomock.Controller) *mock.MockTwoFactorRepository {
+	twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+	twoFactorRepo.EXPECT().
+		GetTwoFactorByUserID(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(nil, domain.ErrDataNotFound)
+
+	return twoFactorRepo
+}
+
+// newLoginCache mocks a cache without failed login attempts, counting each failure as the first
+func newLoginCache(ctrl *gomock.Controller) *mock.MockCacheRepository {
+	cache := mock.NewMockCacheRepository(ctrl)
+	cache.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(nil, domain.ErrCacheMiss)
+	cache.EXPECT().
+		Increment(gomock.Any(), gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(int64(1), nil)
+	cache.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(nil)
+
+	return cache
+}
+
 type loginTestedInput struct {
 	email    string
 	password string
 }
 
 type loginExpectedOutput struct {
-	token string
+	token *domain.AuthToken
 	err   error
 }
 
@@ -35,12 +93,25 @@ func TestAuthService_Login(t *testing.T) {
 		Email:    email,
 		Password: "wrong password",
 	}
-	token := gofakeit.UUID()
+	device := domain.Device{
+		Name:      "Register 1",
+		UserAgent: gofakeit.UserAgent(),
+		IPAddress: gofakeit.IPv4Address(),
+	}
+	accessToken := gofakeit.UUID()
+	refreshToken := gofakeit.UUID()
+	refreshExpiresAt := time.Now().Add(7 * 24 * time.Hour)
+	token := &domain.AuthToken{
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+	}
 
 	testCases := []struct {
 		desc  string
 		mocks func(
 			userRepo *mock.MockUserRepository,
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
 			tokenService *mock.MockTokenService,
 		)
 		input    loginTestedInput
@@ -50,6 +121,7 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Success",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				tokenService *mock.MockTokenService,
 			) {
 				userRepo.EXPECT().
@@ -57,9 +129,20 @@ func TestAuthService_Login(t *testing.T) {
 					Times(1).
 					Return(user, nil)
 				tokenService.EXPECT().
-					CreateToken(gomock.Eq(user)).
+					CreateToken(gomock.Eq(user), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(accessToken, nil)
+				tokenService.EXPECT().
+					CreateRefreshToken().
+					Times(1).
+					Return(refreshToken, refreshExpiresAt, nil)
+				refreshTokenRepo.EXPECT().
+					CreateRefreshToken(gomock.Any(), gomock.Cond(func(x any) bool {
+						rt := x.(*domain.RefreshToken)
+						return rt.TokenHash == util.HashToken(refreshToken) && rt.Device == device && rt.UserID == user.ID
+					})).
 					Times(1).
-					Return(token, nil)
+					Return(&domain.RefreshToken{}, nil)
 			},
 			input: loginTestedInput{
 				email:    email,
@@ -74,6 +157,7 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Fail_UserNotFound",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				tokenService *mock.MockTokenService,
 			) {
 				userRepo.EXPECT().
@@ -86,7 +170,7 @@ func TestAuthService_Login(t *testing.T) {
 				password: password,
 			},
 			expected: loginExpectedOutput{
-				token: "",
+				token: nil,
 				err:   domain.ErrInvalidCredentials,
 			},
 		},
@@ -94,6 +178,7 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Fail_PasswordMismatch",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				tokenService *mock.MockTokenService,
 			) {
 				userRepo.EXPECT().
@@ -106,7 +191,7 @@ func TestAuthService_Login(t *testing.T) {
 				password: password,
 			},
 			expected: loginExpectedOutput{
-				token: "",
+				token: nil,
 				err:   domain.ErrInvalidCredentials,
 			},
 		},
@@ -114,6 +199,7 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Fail_TokenCreation",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				tokenService *mock.MockTokenService,
 			) {
 				userRepo.EXPECT().
@@ -121,7 +207,7 @@ func TestAuthService_Login(t *testing.T) {
 					Times(1).
 					Return(user, nil)
 				tokenService.EXPECT().
-					CreateToken(gomock.Eq(user)).
+					CreateToken(gomock.Eq(user), gomock.Any(), gomock.Any()).
 					Times(1).
 					Return("", domain.ErrTokenCreation)
 			},
@@ -130,7 +216,7 @@ func TestAuthService_Login(t *testing.T) {
 				password: password,
 			},
 			expected: loginExpectedOutput{
-				token: "",
+				token: nil,
 				err:   domain.ErrTokenCreation,
 			},
 		},
@@ -138,6 +224,7 @@ func TestAuthService_Login(t *testing.T) {
 			desc: "Fail_InternalError",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				tokenService *mock.MockTokenService,
 			) {
 				userRepo.EXPECT().
@@ -150,7 +237,7 @@ func TestAuthService_Login(t *testing.T) {
 				password: password,
 			},
 			expected: loginExpectedOutput{
-				token: "",
+				token: nil,
 				err:   domain.ErrInternal,
 			},
 		},
@@ -164,18 +251,836 @@ func TestAuthService_Login(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := newLoginCache(ctrl)
+
+			tc.mocks(userRepo, refreshTokenRepo, tokenService)
+
+			authService := service.NewAuthService(userRepo, refreshTokenRepo, mock.NewMockTerminalRepository(ctrl), newRoleRepository(ctrl), newTwoFactorRepository(ctrl), tokenService, mock.NewMockTOTPService(ctrl), cache, mock.NewMockAuditLogger(ctrl), false)
+
+			token, err := authService.Login(ctx, tc.input.email, tc.input.password, device)
+			if err != tc.expected.err {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+			if (token == nil) != (tc.expected.token == nil) || token != nil && *token != *tc.expected.token {
+				t.Errorf("[case: %s] expected to get %+v; got %+v", tc.desc, tc.expected.token, token)
+			}
+		})
+	}
+}
+
+type refreshExpectedOutput struct {
+	token *domain.AuthToken
+	err   error
+}
+
+type loginWithPINTestedInput struct {
+	credential string
+	userID     uint64
+	pin        string
+}
+
+func TestAuthService_LoginWithPIN(t *testing.T) {
+	ctx := context.Background()
+	credential := gofakeit.UUID()
+	terminal := &domain.Terminal{
+		ID:             gofakeit.Uint64(),
+		Name:           "Register 1",
+		CredentialHash: util.HashToken(credential),
+	}
+	pin := "1234"
+	hashedPIN, _ := util.HashPassword(pin)
+	userID := gofakeit.Uint64()
+	cashier := &domain.User{
+		ID:   userID,
+		Role: domain.Cashier,
+		PIN:  hashedPIN,
+	}
+	cashierWithoutPIN := &domain.User{
+		ID:   userID,
+		Role: domain.Cashier,
+	}
+	admin := &domain.User{
+		ID:   userID,
+		Role: domain.Admin,
+		PIN:  hashedPIN,
+	}
+	device := domain.Device{
+		UserAgent: gofakeit.UserAgent(),
+		IPAddress: gofakeit.IPv4Address(),
+	}
+	terminalDevice := device
+	terminalDevice.Name = terminal.Name
+	accessToken := gofakeit.UUID()
+	refreshToken := gofakeit.UUID()
+	refreshExpiresAt := time.Now().Add(7 * 24 * time.Hour)
+	token := &domain.AuthToken{
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
+			terminalRepo *mock.MockTerminalRepository,
+			tokenService *mock.MockTokenService,
+		)
+		input    loginWithPINTestedInput
+		expected loginExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByCredentialHash(gomock.Any(), gomock.Eq(terminal.CredentialHash)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(cashier, nil)
+				tokenService.EXPECT().
+					CreateToken(gomock.Eq(cashier), gomock.Eq(cashierRole.Permissions), gomock.Any()).
+					Times(1).
+					Return(accessToken, nil)
+				tokenService.EXPECT().
+					CreateRefreshToken().
+					Times(1).
+					Return(refreshToken, refreshExpiresAt, nil)
+				refreshTokenRepo.EXPECT().
+					CreateRefreshToken(gomock.Any(), gomock.Cond(func(x any) bool {
+						rt := x.(*domain.RefreshToken)
+						return rt.TokenHash == util.HashToken(refreshToken) && rt.Device == terminalDevice && rt.UserID == userID
+					})).
+					Times(1).
+					Return(&domain.RefreshToken{}, nil)
+			},
+			input: loginWithPINTestedInput{
+				credential: credential,
+				userID:     userID,
+				pin:        pin,
+			},
+			expected: loginExpectedOutput{
+				token: token,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_MissingTerminalCredential",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+			) {
+			},
+			input: loginWithPINTestedInput{
+				credential: "",
+				userID:     userID,
+				pin:        pin,
+			},
+			expected: loginExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidTerminal,
+			},
+		},
+		{
+			desc: "Fail_UnknownTerminal",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByCredentialHash(gomock.Any(), gomock.Eq(terminal.CredentialHash)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: loginWithPINTestedInput{
+				credential: credential,
+				userID:     userID,
+				pin:        pin,
+			},
+			expected: loginExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidTerminal,
+			},
+		},
+		{
+			desc: "Fail_UserNotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByCredentialHash(gomock.Any(), gomock.Eq(terminal.CredentialHash)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: loginWithPINTestedInput{
+				credential: credential,
+				userID:     userID,
+				pin:        pin,
+			},
+			expected: loginExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidPIN,
+			},
+		},
+		{
+			desc: "Fail_NotCashier",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByCredentialHash(gomock.Any(), gomock.Eq(terminal.CredentialHash)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(admin, nil)
+			},
+			input: loginWithPINTestedInput{
+				credential: credential,
+				userID:     userID,
+				pin:        pin,
+			},
+			expected: loginExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidPIN,
+			},
+		},
+		{
+			desc: "Fail_PINNotSet",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByCredentialHash(gomock.Any(), gomock.Eq(terminal.CredentialHash)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(cashierWithoutPIN, nil)
+			},
+			input: loginWithPINTestedInput{
+				credential: credential,
+				userID:     userID,
+				pin:        pin,
+			},
+			expected: loginExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidPIN,
+			},
+		},
+		{
+			desc: "Fail_PINMismatch",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				terminalRepo *mock.MockTerminalRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				terminalRepo.EXPECT().
+					GetTerminalByCredentialHash(gomock.Any(), gomock.Eq(terminal.CredentialHash)).
+					Times(1).
+					Return(terminal, nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Times(1).
+					Return(cashier, nil)
+			},
+			input: loginWithPINTestedInput{
+				credential: credential,
+				userID:     userID,
+				pin:        "4321",
+			},
+			expected: loginExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidPIN,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := newLoginCache(ctrl)
+
+			tc.mocks(userRepo, refreshTokenRepo, terminalRepo, tokenService)
+
+			authService := service.NewAuthService(userRepo, refreshTokenRepo, terminalRepo, newRoleRepository(ctrl), newTwoFactorRepository(ctrl), tokenService, mock.NewMockTOTPService(ctrl), cache, mock.NewMockAuditLogger(ctrl), false)
+
+			token, err := authService.LoginWithPIN(ctx, tc.input.credential, tc.input.userID, tc.input.pin, device)
+			if err != tc.expected.err {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+			if (token == nil) != (tc.expected.token == nil) || token != nil && *token != *tc.expected.token {
+				t.Errorf("[case: %s] expected to get %+v; got %+v", tc.desc, tc.expected.token, token)
+			}
+		})
+	}
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	ctx := context.Background()
+	user := &domain.User{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.Name(),
+		Role: domain.Cashier,
+	}
+	admin := &domain.User{
+		ID:   user.ID,
+		Name: gofakeit.Name(),
+		Role: domain.Admin,
+	}
+	device := domain.Device{
+		UserAgent: gofakeit.UserAgent(),
+		IPAddress: gofakeit.IPv4Address(),
+	}
+	oldRefreshToken := gofakeit.UUID()
+	familyID := uuid.New()
+	now := time.Now()
+	usedAt := now.Add(-time.Minute)
+	activeToken := &domain.RefreshToken{
+		ID:        gofakeit.Uint64(),
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: util.HashToken(oldRefreshToken),
+		Device:    domain.Device{Name: "Register 1"},
+		ExpiresAt: now.Add(time.Hour),
+	}
+	usedToken := &domain.RefreshToken{
+		ID:        activeToken.ID,
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: activeToken.TokenHash,
+		ExpiresAt: now.Add(time.Hour),
+		UsedAt:    &usedAt,
+	}
+	revokedToken := &domain.RefreshToken{
+		ID:        activeToken.ID,
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: activeToken.TokenHash,
+		ExpiresAt: now.Add(time.Hour),
+		RevokedAt: &usedAt,
+	}
+	expiredToken := &domain.RefreshToken{
+		ID:        activeToken.ID,
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: activeToken.TokenHash,
+		ExpiresAt: now.Add(-time.Hour),
+	}
+	accessToken := gofakeit.UUID()
+	refreshToken := gofakeit.UUID()
+	refreshExpiresAt := now.Add(7 * 24 * time.Hour)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
+			tokenService *mock.MockTokenService,
+		)
+		expected refreshExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshTokenRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(util.HashToken(oldRefreshToken))).
+					Times(1).
+					Return(activeToken, nil)
+				refreshTokenRepo.EXPECT().
+					UseRefreshToken(gomock.Any(), gomock.Eq(activeToken.ID), gomock.Any()).
+					Times(1).
+					Return(nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				tokenService.EXPECT().
+					CreateToken(gomock.Eq(user), gomock.Any(), gomock.Eq(familyID)).
+					Times(1).
+					Return(accessToken, nil)
+				tokenService.EXPECT().
+					CreateRefreshToken().
+					Times(1).
+					Return(refreshToken, refreshExpiresAt, nil)
+				refreshTokenRepo.EXPECT().
+					CreateRefreshToken(gomock.Any(), gomock.Cond(func(x any) bool {
+						rt := x.(*domain.RefreshToken)
+						return rt.FamilyID == familyID && rt.TokenHash == util.HashToken(refreshToken) && rt.Device.Name == "Register 1"
+					})).
+					Times(1).
+					Return(&domain.RefreshToken{}, nil)
+			},
+			expected: refreshExpectedOutput{
+				token: &domain.AuthToken{
+					AccessToken:      accessToken,
+					RefreshToken:     refreshToken,
+					RefreshExpiresAt: refreshExpiresAt,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshTokenRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(util.HashToken(oldRefreshToken))).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			expected: refreshExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidRefreshToken,
+			},
+		},
+		{
+			desc: "Fail_Reused",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshTokenRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(util.HashToken(oldRefreshToken))).
+					Times(1).
+					Return(usedToken, nil)
+				refreshTokenRepo.EXPECT().
+					RevokeRefreshTokenFamily(gomock.Any(), gomock.Eq(familyID), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			expected: refreshExpectedOutput{
+				token: nil,
+				err:   domain.ErrRefreshTokenReused,
+			},
+		},
+		{
+			desc: "Fail_Revoked",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshTokenRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(util.HashToken(oldRefreshToken))).
+					Times(1).
+					Return(revokedToken, nil)
+			},
+			expected: refreshExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidRefreshToken,
+			},
+		},
+		{
+			desc: "Fail_Expired",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshTokenRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(util.HashToken(oldRefreshToken))).
+					Times(1).
+					Return(expiredToken, nil)
+			},
+			expected: refreshExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidRefreshToken,
+			},
+		},
+		{
+			desc: "Fail_UsedConcurrently",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshTokenRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(util.HashToken(oldRefreshToken))).
+					Times(1).
+					Return(activeToken, nil)
+				refreshTokenRepo.EXPECT().
+					UseRefreshToken(gomock.Any(), gomock.Eq(activeToken.ID), gomock.Any()).
+					Times(1).
+					Return(domain.ErrDataNotFound)
+			},
+			expected: refreshExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidRefreshToken,
+			},
+		},
+		{
+			desc: "Fail_TwoFactorRequired",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				tokenService *mock.MockTokenService,
+			) {
+				refreshTokenRepo.EXPECT().
+					GetRefreshTokenByHash(gomock.Any(), gomock.Eq(util.HashToken(oldRefreshToken))).
+					Times(1).
+					Return(activeToken, nil)
+				refreshTokenRepo.EXPECT().
+					UseRefreshToken(gomock.Any(), gomock.Eq(activeToken.ID), gomock.Any()).
+					Times(1).
+					Return(nil)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(admin.ID)).
+					Times(1).
+					Return(admin, nil)
+				refreshTokenRepo.EXPECT().
+					RevokeRefreshTokenFamily(gomock.Any(), gomock.Eq(familyID), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			expected: refreshExpectedOutput{
+				token: nil,
+				err:   domain.ErrInvalidRefreshToken,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(userRepo, refreshTokenRepo, tokenService)
+
+			authService := service.NewAuthService(userRepo, refreshTokenRepo, mock.NewMockTerminalRepository(ctrl), newRoleRepository(ctrl), newTwoFactorRepository(ctrl), tokenService, mock.NewMockTOTPService(ctrl), cache, mock.NewMockAuditLogger(ctrl), true)
+
+			token, err := authService.Refresh(ctx, oldRefreshToken, device)
+			if err != tc.expected.err {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+			if (token == nil) != (tc.expected.token == nil) || token != nil && *token != *tc.expected.token {
+				t.Errorf("[case: %s] expected to get %+v; got %+v", tc.desc, tc.expected.token, token)
+			}
+		})
+	}
+}
+
+type listPublicKeysExpectedOutput struct {
+	keys []domain.PublicKey
+	err  error
+}
+
+func TestAuthService_ListPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	keys := []domain.PublicKey{
+		{ID: "5e7bb340", Key: []byte(gofakeit.LetterN(32))},
+		{ID: "707a0d77", Key: []byte(gofakeit.LetterN(32))},
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(tokenService *mock.MockTokenService)
+		expected listPublicKeysExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(tokenService *mock.MockTokenService) {
+				tokenService.EXPECT().
+					PublicKeys().
+					Times(1).
+					Return(keys)
+			},
+			expected: listPublicKeysExpectedOutput{
+				keys: keys,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Fail_LocalTokens",
+			mocks: func(tokenService *mock.MockTokenService) {
+				tokenService.EXPECT().
+					PublicKeys().
+					Times(1).
+					Return(nil)
+			},
+			expected: listPublicKeysExpectedOutput{
+				keys: nil,
+				err:  domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(tokenService)
+
+			authService := service.NewAuthService(userRepo, refreshTokenRepo, mock.NewMockTerminalRepository(ctrl), newRoleRepository(ctrl), newTwoFactorRepository(ctrl), tokenService, mock.NewMockTOTPService(ctrl), cache, mock.NewMockAuditLogger(ctrl), false)
+
+			keys, err := authService.ListPublicKeys(ctx)
+			if err != tc.expected.err {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
+			}
+			if len(keys) != len(tc.expected.keys) {
+				t.Errorf("[case: %s] expected to get %d keys; got %d", tc.desc, len(tc.expected.keys), len(keys))
+			}
+		})
+	}
+}
+
+type logoutTestedInput struct {
+	payload *domain.TokenPayload
+}
+
+type logoutExpectedOutput struct {
+	err error
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	ctx := context.Background()
+	payload := &domain.TokenPayload{
+		ID:        uuid.New(),
+		UserID:    gofakeit.Uint64(),
+		Role:      domain.Cashier,
+		SessionID: uuid.New(),
+		IssuedAt:  time.Now().Add(-time.Minute),
+		ExpiredAt: time.Now().Add(4 * time.Minute),
+	}
+	expiredPayload := &domain.TokenPayload{
+		ID:        uuid.New(),
+		UserID:    payload.UserID,
+		Role:      domain.Cashier,
+		SessionID: payload.SessionID,
+		IssuedAt:  time.Now().Add(-10 * time.Minute),
+		ExpiredAt: time.Now().Add(-5 * time.Minute),
+	}
+	cacheKey := util.GenerateCacheKey("revoked_token", payload.ID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    logoutTestedInput
+		expected logoutExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Any(), gomock.Cond(func(x any) bool {
+						ttl := x.(time.Duration)
+						return ttl > 0 && ttl <= 4*time.Minute
+					})).
+					Times(1).
+					Return(nil)
+				refreshTokenRepo.EXPECT().
+					RevokeRefreshTokenFamily(gomock.Any(), gomock.Eq(payload.SessionID), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			input: logoutTestedInput{
+				payload: payload,
+			},
+			expected: logoutExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_ExpiredToken",
+			mocks: func(
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				refreshTokenRepo.EXPECT().
+					RevokeRefreshTokenFamily(gomock.Any(), gomock.Eq(payload.SessionID), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			input: logoutTestedInput{
+				payload: expiredPayload,
+			},
+			expected: logoutExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_SetCache",
+			mocks: func(
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: logoutTestedInput{
+				payload: payload,
+			},
+			expected: logoutExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
 			tokenService := mock.NewMockTokenService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
 
-			tc.mocks(userRepo, tokenService)
+			tc.mocks(refreshTokenRepo, cache)
 
-			authService := service.NewAuthService(userRepo, tokenService)
+			authService := service.NewAuthService(userRepo, refreshTokenRepo, mock.NewMockTerminalRepository(ctrl), newRoleRepository(ctrl), newTwoFactorRepository(ctrl), tokenService, mock.NewMockTOTPService(ctrl), cache, mock.NewMockAuditLogger(ctrl), false)
 
-			token, err := authService.Login(ctx, tc.input.email, tc.input.password)
+			err := authService.Logout(ctx, tc.input.payload)
 			if err != tc.expected.err {
 				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
 			}
-			if token != tc.expected.token {
-				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.token, token)
+		})
+	}
+}
+
+func TestAuthService_LogoutAll(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	cacheKey := util.GenerateCacheKey("revoked_user", userID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
+			cache *mock.MockCacheRepository,
+		)
+		expected logoutExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Any(), gomock.Eq(time.Duration(0))).
+					Times(1).
+					Return(nil)
+				refreshTokenRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			expected: logoutExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_RevokeRefreshTokens",
+			mocks: func(
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Any(), gomock.Eq(time.Duration(0))).
+					Times(1).
+					Return(nil)
+				refreshTokenRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			expected: logoutExpectedOutput{
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(refreshTokenRepo, cache)
+
+			authService := service.NewAuthService(userRepo, refreshTokenRepo, mock.NewMockTerminalRepository(ctrl), newRoleRepository(ctrl), newTwoFactorRepository(ctrl), tokenService, mock.NewMockTOTPService(ctrl), cache, mock.NewMockAuditLogger(ctrl), false)
+
+			err := authService.LogoutAll(ctx, userID)
+			if err != tc.expected.err {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected.err, err)
 			}
 		})
 	}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/google/uuid"
+)
+
+/**
+ * BarcodeService implements port.BarcodeService interface
+ * and provides an access to the barcode, product and category repositories
+ * and cache service
+ */
+type BarcodeService struct {
+	barcodeRepo  port.BarcodeRepository
+	productRepo  port.ProductRepository
+	categoryRepo port.CategoryRepository
+	cache        port.CacheRepository
+}
+
+// NewBarcodeService creates a new barcode service instance
+func NewBarcodeService(barcodeRepo port.BarcodeRepository, productRepo port.ProductRepository, categoryRepo port.CategoryRepository, cache port.CacheRepository) *BarcodeService {
+	return &BarcodeService{
+		barcodeRepo,
+		productRepo,
+		categoryRepo,
+		cache,
+	}
+}
+
+// CreateBarcode validates a barcode and adds it to a product
+func (bs *BarcodeService) CreateBarcode(ctx context.Context, barcode *domain.Barcode) (*domain.Barcode, error) {
+	if barcode.Type == "" {
+		barcode.Type = domain.BarcodeStandard
+	}
+
+	if !isValidBarcode(barcode) {
+		return nil, domain.ErrInvalidBarcode
+	}
+
+	product, err := bs.productRepo.GetProductByID(ctx, barcode.ProductID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if barcode.Type == domain.BarcodeWeight && !product.Fractional {
+		return nil, domain.ErrInvalidBarcode
+	}
+
+	barcode, err = bs.barcodeRepo.CreateBarcode(ctx, barcode)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return barcode, nil
+}
+
+// GenerateBarcode adds an in-store EAN-13 barcode generated from its id to a product without a manufacturer barcode,
+// so that its EAN-13 labels can be printed and scanned
+func (bs *BarcodeService) GenerateBarcode(ctx context.Context, productID uint64) (*domain.Barcode, error) {
+	product, err := bs.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	barcode := &domain.Barcode{
+		ProductID: product.ID,
+		Code:      util.InStoreBarcode(product.ID),
+		Type:      domain.BarcodeStandard,
+	}
+
+	barcode, err = bs.barcodeRepo.CreateBarcode(ctx, barcode)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return barcode, nil
+}
+
+// ListBarcodes retrieves a list of barcodes of a product
+func (bs *BarcodeService) ListBarcodes(ctx context.Context, productID uint64) ([]domain.Barcode, error) {
+	barcodes, err := bs.barcodeRepo.ListBarcodes(ctx, productID)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return barcodes, nil
+}
+
+// DeleteBarcode deletes a barcode
+func (bs *BarcodeService) DeleteBarcode(ctx context.Context, id uint64) error {
+	barcode, err := bs.barcodeRepo.GetBarcodeByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	cacheKey := util.GenerateCacheKey("barcode", barcode.Code)
+
+	err = bs.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	err = bs.barcodeRepo.DeleteBarcode(ctx, id)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// ScanBarcode looks up the product of a scanned barcode, decoding the weight or price
+// encoded in in-store weighted barcodes. The SKU printed on Code128 labels is scanned as a standard barcode
+func (bs *BarcodeService) ScanBarcode(ctx context.Context, code string) (*domain.BarcodeScan, error) {
+	var value int64
+
+	barcode, err := bs.getBarcode(ctx, code)
+	if err == domain.ErrDataNotFound {
+		barcode, err = bs.getSKUBarcode(ctx, code)
+	}
+	if err != nil && err != domain.ErrDataNotFound {
+		return nil, err
+	}
+
+	if err == domain.ErrDataNotFound {
+		prefix, weightedValue, ok := util.ParseWeightedBarcode(code)
+		if !ok {
+			return nil, err
+		}
+
+		barcode, err = bs.getBarcode(ctx, prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		if barcode.Type == domain.BarcodeStandard {
+			return nil, domain.ErrDataNotFound
+		}
+
+		value = weightedValue
+	} else if barcode.Type != domain.BarcodeStandard {
+		return nil, domain.ErrDataNotFound
+	}
+
+	product, err := bs.productRepo.GetProductByID(ctx, barcode.ProductID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	category, err := bs.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	product.Category = category
+
+	scan := &domain.BarcodeScan{
+		Code:     code,
+		Type:     barcode.Type,
+		Quantity: 1,
+		Price:    product.Price,
+		Product:  product,
+	}
+
+	switch barcode.Type {
+	case domain.BarcodeWeight:
+		scan.Quantity = float64(value) / 1000
+		scan.Price = math.Round(product.Price*scan.Quantity*100) / 100
+	case domain.BarcodePrice:
+		scan.Price = float64(value)
+		scan.Quantity = 0
+		if product.Price > 0 {
+			scan.Quantity = math.Round(scan.Price/product.Price*1000) / 1000
+		}
+	}
+
+	return scan, nil
+}
+
+// getSKUBarcode gets a code that is the SKU of a product as a standard barcode of the product
+func (bs *BarcodeService) getSKUBarcode(ctx context.Context, code string) (*domain.Barcode, error) {
+	sku, err := uuid.Parse(code)
+	if err != nil {
+		return nil, domain.ErrDataNotFound
+	}
+
+	product, err := bs.productRepo.GetProductBySKU(ctx, sku)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return &domain.Barcode{
+		ProductID: product.ID,
+		Code:      code,
+		Type:      domain.BarcodeStandard,
+	}, nil
+}
+
+// getBarcode retrieves a barcode by code, from the cache if possible
+func (bs *BarcodeService) getBarcode(ctx context.Context, code string) (*domain.Barcode, error) {
+	var barcode *domain.Barcode
+
+	cacheKey := util.GenerateCacheKey("barcode", code)
+	cachedBarcode, err := bs.cache.Get(ctx, cacheKey)
+	if err == nil {
+		err := util.Deserialize(cachedBarcode, &barcode)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+		return barcode, nil
+	}
+
+	barcode, err = bs.barcodeRepo.GetBarcodeByCode(ctx, code)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	barcodeSerialized, err := util.Serialize(barcode)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = bs.cache.Set(ctx, cacheKey, barcodeSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return barcode, nil
+}
+
+// isValidBarcode checks whether a manufacturer barcode has a valid check digit,
+// or whether a weighted barcode is a valid in-store prefix
+func isValidBarcode(barcode *domain.Barcode) bool {
+	switch barcode.Type {
+	case domain.BarcodeStandard:
+		return util.IsValidBarcode(barcode.Code)
+	case domain.BarcodeWeight, domain.BarcodePrice:
+		return util.IsValidWeightedBarcodePrefix(barcode.Code)
+	default:
+		return false
+	}
+}
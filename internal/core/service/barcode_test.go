@@ -0,0 +1,606 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createBarcodeTestedInput struct {
+	barcode *domain.Barcode
+}
+
+type createBarcodeExpectedOutput struct {
+	barcode *domain.Barcode
+	err     error
+}
+
+func TestBarcodeService_CreateBarcode(t *testing.T) {
+	ctx := context.Background()
+	product := &domain.Product{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.ProductName(),
+	}
+
+	barcodeInput := &domain.Barcode{
+		ProductID: product.ID,
+		Code:      "8991234567891",
+	}
+	barcodeOutput := &domain.Barcode{
+		ID:        gofakeit.Uint64(),
+		ProductID: product.ID,
+		Code:      barcodeInput.Code,
+		Type:      domain.BarcodeStandard,
+	}
+
+	invalidCheckDigitInput := &domain.Barcode{
+		ProductID: product.ID,
+		Code:      "8991234567890",
+	}
+	weightInput := &domain.Barcode{
+		ProductID: product.ID,
+		Code:      "2100123",
+		Type:      domain.BarcodeWeight,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			barcodeRepo *mock.MockBarcodeRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		input    createBarcodeTestedInput
+		expected createBarcodeExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				barcodeRepo.EXPECT().
+					CreateBarcode(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(barcodeOutput, nil)
+			},
+			input: createBarcodeTestedInput{
+				barcode: barcodeInput,
+			},
+			expected: createBarcodeExpectedOutput{
+				barcode: barcodeOutput,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidCheckDigit",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+			},
+			input: createBarcodeTestedInput{
+				barcode: invalidCheckDigitInput,
+			},
+			expected: createBarcodeExpectedOutput{
+				barcode: nil,
+				err:     domain.ErrInvalidBarcode,
+			},
+		},
+		{
+			desc: "Fail_WeightNotFractional",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: createBarcodeTestedInput{
+				barcode: weightInput,
+			},
+			expected: createBarcodeExpectedOutput{
+				barcode: nil,
+				err:     domain.ErrInvalidBarcode,
+			},
+		},
+		{
+			desc: "Fail_NotFoundGetProduct",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: createBarcodeTestedInput{
+				barcode: barcodeInput,
+			},
+			expected: createBarcodeExpectedOutput{
+				barcode: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_DuplicateData",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				barcodeRepo.EXPECT().
+					CreateBarcode(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: createBarcodeTestedInput{
+				barcode: barcodeInput,
+			},
+			expected: createBarcodeExpectedOutput{
+				barcode: nil,
+				err:     domain.ErrConflictingData,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			barcodeRepo := mock.NewMockBarcodeRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(barcodeRepo, productRepo)
+
+			barcodeService := service.NewBarcodeService(barcodeRepo, productRepo, categoryRepo, cache)
+
+			input := *tc.input.barcode
+			barcode, err := barcodeService.CreateBarcode(ctx, &input)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.barcode, barcode, "Barcode mismatch")
+		})
+	}
+}
+
+type generateBarcodeExpectedOutput struct {
+	barcode *domain.Barcode
+	err     error
+}
+
+func TestBarcodeService_GenerateBarcode(t *testing.T) {
+	ctx := context.Background()
+	product := &domain.Product{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.ProductName(),
+	}
+	inStoreBarcode := &domain.Barcode{
+		ProductID: product.ID,
+		Code:      util.InStoreBarcode(product.ID),
+		Type:      domain.BarcodeStandard,
+	}
+	createdBarcode := &domain.Barcode{
+		ID:        gofakeit.Uint64(),
+		ProductID: product.ID,
+		Code:      inStoreBarcode.Code,
+		Type:      domain.BarcodeStandard,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			barcodeRepo *mock.MockBarcodeRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		expected generateBarcodeExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				barcodeRepo.EXPECT().
+					CreateBarcode(gomock.Any(), gomock.Eq(inStoreBarcode)).
+					Times(1).
+					Return(createdBarcode, nil)
+			},
+			expected: generateBarcodeExpectedOutput{
+				barcode: createdBarcode,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_ProductNotFound",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			expected: generateBarcodeExpectedOutput{
+				barcode: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_AlreadyGenerated",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				barcodeRepo.EXPECT().
+					CreateBarcode(gomock.Any(), gomock.Eq(inStoreBarcode)).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			expected: generateBarcodeExpectedOutput{
+				barcode: nil,
+				err:     domain.ErrConflictingData,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			barcodeRepo := mock.NewMockBarcodeRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(barcodeRepo, productRepo)
+
+			barcodeService := service.NewBarcodeService(barcodeRepo, productRepo, categoryRepo, cache)
+
+			barcode, err := barcodeService.GenerateBarcode(ctx, product.ID)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.barcode, barcode, "Barcode mismatch")
+		})
+	}
+}
+
+type scanBarcodeTestedInput struct {
+	code string
+}
+
+type scanBarcodeExpectedOutput struct {
+	scan *domain.BarcodeScan
+	err  error
+}
+
+func TestBarcodeService_ScanBarcode(t *testing.T) {
+	ctx := context.Background()
+	category := &domain.Category{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.ProductCategory(),
+	}
+	productID := gofakeit.Uint64()
+	ttl := time.Duration(0)
+	scannedProduct := func() *domain.Product {
+		return &domain.Product{
+			ID:         productID,
+			CategoryID: category.ID,
+			Name:       gofakeit.ProductName(),
+			Price:      50000,
+			Fractional: true,
+		}
+	}
+
+	standardCode := "8991234567891"
+	standardBarcode := &domain.Barcode{
+		ID:        gofakeit.Uint64(),
+		ProductID: productID,
+		Code:      standardCode,
+		Type:      domain.BarcodeStandard,
+	}
+	standardBarcodeSerialized, _ := util.Serialize(standardBarcode)
+	standardCacheKey := util.GenerateCacheKey("barcode", standardCode)
+
+	weightCode := "2100123012343"
+	weightPrefix := "2100123"
+	weightBarcode := &domain.Barcode{
+		ID:        gofakeit.Uint64(),
+		ProductID: productID,
+		Code:      weightPrefix,
+		Type:      domain.BarcodeWeight,
+	}
+	weightBarcodeSerialized, _ := util.Serialize(weightBarcode)
+	weightCacheKey := util.GenerateCacheKey("barcode", weightCode)
+	weightPrefixCacheKey := util.GenerateCacheKey("barcode", weightPrefix)
+
+	// labelCode is the SKU printed on a Code128 label of the product
+	sku := uuid.New()
+	labelCode := sku.String()
+	labelCacheKey := util.GenerateCacheKey("barcode", labelCode)
+
+	// inStoreCode must not be read as a weighted barcode, as it is not registered as one
+	inStoreCode := util.InStoreBarcode(12345)
+	inStoreCacheKey := util.GenerateCacheKey("barcode", inStoreCode)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			barcodeRepo *mock.MockBarcodeRepository,
+			productRepo *mock.MockProductRepository,
+			categoryRepo *mock.MockCategoryRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    scanBarcodeTestedInput
+		expected scanBarcodeExpectedOutput
+	}{
+		{
+			desc: "Success_Standard",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(standardCacheKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(standardCode)).
+					Times(1).
+					Return(standardBarcode, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(standardCacheKey), gomock.Eq(standardBarcodeSerialized), gomock.Eq(ttl)).
+					Times(1).
+					Return(nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(scannedProduct(), nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(category.ID)).
+					Times(1).
+					Return(category, nil)
+			},
+			input: scanBarcodeTestedInput{
+				code: standardCode,
+			},
+			expected: scanBarcodeExpectedOutput{
+				scan: &domain.BarcodeScan{
+					Code:     standardCode,
+					Type:     domain.BarcodeStandard,
+					Quantity: 1,
+					Price:    50000,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_Weight",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(weightCacheKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(weightCode)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(weightPrefixCacheKey)).
+					Times(1).
+					Return(weightBarcodeSerialized, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(scannedProduct(), nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(category.ID)).
+					Times(1).
+					Return(category, nil)
+			},
+			input: scanBarcodeTestedInput{
+				code: weightCode,
+			},
+			expected: scanBarcodeExpectedOutput{
+				scan: &domain.BarcodeScan{
+					Code:     weightCode,
+					Type:     domain.BarcodeWeight,
+					Quantity: 1.234,
+					Price:    61700,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_Code128Label",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(labelCacheKey)).
+					Times(1).
+					Return(nil, domain.ErrCacheMiss)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(labelCode)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				productRepo.EXPECT().
+					GetProductBySKU(gomock.Any(), gomock.Eq(sku)).
+					Times(1).
+					Return(scannedProduct(), nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(scannedProduct(), nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(category.ID)).
+					Times(1).
+					Return(category, nil)
+			},
+			input: scanBarcodeTestedInput{
+				code: labelCode,
+			},
+			expected: scanBarcodeExpectedOutput{
+				scan: &domain.BarcodeScan{
+					Code:     labelCode,
+					Type:     domain.BarcodeStandard,
+					Quantity: 1,
+					Price:    50000,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_UnknownSKU",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(labelCacheKey)).
+					Times(1).
+					Return(nil, domain.ErrCacheMiss)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(labelCode)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				productRepo.EXPECT().
+					GetProductBySKU(gomock.Any(), gomock.Eq(sku)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: scanBarcodeTestedInput{
+				code: labelCode,
+			},
+			expected: scanBarcodeExpectedOutput{
+				scan: nil,
+				err:  domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(standardCacheKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(standardCode)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: scanBarcodeTestedInput{
+				code: standardCode,
+			},
+			expected: scanBarcodeExpectedOutput{
+				scan: nil,
+				err:  domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InStoreBarcodeNotWeighted",
+			mocks: func(
+				barcodeRepo *mock.MockBarcodeRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(inStoreCacheKey)).
+					Times(1).
+					Return(nil, domain.ErrCacheMiss)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(inStoreCode)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: scanBarcodeTestedInput{
+				code: inStoreCode,
+			},
+			expected: scanBarcodeExpectedOutput{
+				scan: nil,
+				err:  domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			barcodeRepo := mock.NewMockBarcodeRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(barcodeRepo, productRepo, categoryRepo, cache)
+
+			barcodeService := service.NewBarcodeService(barcodeRepo, productRepo, categoryRepo, cache)
+
+			scan, err := barcodeService.ScanBarcode(ctx, tc.input.code)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			if tc.expected.scan == nil {
+				assert.Nil(t, scan, "Scan mismatch")
+				return
+			}
+			assert.Equal(t, tc.expected.scan.Type, scan.Type, "Type mismatch")
+			assert.Equal(t, tc.expected.scan.Quantity, scan.Quantity, "Quantity mismatch")
+			assert.Equal(t, tc.expected.scan.Price, scan.Price, "Price mismatch")
+			assert.Equal(t, productID, scan.Product.ID, "Product mismatch")
+			assert.Equal(t, category, scan.Product.Category, "Category mismatch")
+		})
+	}
+}
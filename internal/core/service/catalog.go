@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/google/uuid"
+)
+
+// catalogPageSize is the number of products read at a time when exporting the catalog
+const catalogPageSize = 100
+
+/**
+ * CatalogService implements port.CatalogService interface
+ * and provides an access to the product, category and barcode repositories,
+ * catalog codec and cache service
+ */
+type CatalogService struct {
+	productRepo  port.ProductRepository
+	categoryRepo port.CategoryRepository
+	barcodeRepo  port.BarcodeRepository
+	codec        port.CatalogCodec
+	cache        port.CacheRepository
+}
+
+// NewCatalogService creates a new catalog service instance
+func NewCatalogService(productRepo port.ProductRepository, categoryRepo port.CategoryRepository, barcodeRepo port.BarcodeRepository, codec port.CatalogCodec, cache port.CacheRepository) *CatalogService {
+	return &CatalogService{
+		productRepo,
+		categoryRepo,
+		barcodeRepo,
+		codec,
+		cache,
+	}
+}
+
+// catalogRows keeps track of what the rows of a catalog already used while they are validated
+type catalogRows struct {
+	skus       map[uuid.UUID]int
+	barcodes   map[string]int
+	products   map[uint64]int
+	categories map[string]*domain.Category
+}
+
+// ImportCatalog validates every row of a catalog, matching them to existing products by SKU, then by barcode.
+// Matched products are updated with the cells of their row that are not empty, and the other rows create new products.
+// Nothing is imported when the import is a dry run or any row has errors, such as a row changing the price of
+// a product while the user is not allowed to edit prices
+func (cs *CatalogService) ImportCatalog(ctx context.Context, catalog *domain.Catalog, dryRun bool, payload *domain.TokenPayload) (*domain.CatalogImport, error) {
+	rows, err := cs.codec.DecodeCatalog(catalog)
+	if err != nil {
+		if err == domain.ErrInvalidCatalog {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	result := &domain.CatalogImport{
+		DryRun: dryRun,
+	}
+
+	seen := &catalogRows{
+		skus:       make(map[uuid.UUID]int),
+		barcodes:   make(map[string]int),
+		products:   make(map[uint64]int),
+		categories: make(map[string]*domain.Category),
+	}
+
+	var products []domain.Product
+
+	for _, row := range rows {
+		product, rowErrors, err := cs.importRow(ctx, &row, seen, payload.HasPermission(domain.ProductsEditPrice))
+		if err != nil {
+			return nil, err
+		}
+
+		result.Errors = append(result.Errors, rowErrors...)
+
+		if product.ID == 0 {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+
+		products = append(products, *product)
+	}
+
+	if !result.IsImported() || len(products) == 0 {
+		return result, nil
+	}
+
+	products, err = cs.productRepo.ImportProducts(ctx, products, payload.UserID)
+	if err != nil {
+		if err == domain.ErrConflictingData || err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	result.Products = products
+
+	for _, product := range products {
+		cacheKey := util.GenerateCacheKey("product", product.ID)
+
+		err = cs.cache.Delete(ctx, cacheKey)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+
+		if product.ParentID != 0 {
+			parentCacheKey := util.GenerateCacheKey("product", product.ParentID)
+
+			err = cs.cache.Delete(ctx, parentCacheKey)
+			if err != nil {
+				return nil, domain.ErrInternal
+			}
+		}
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return result, nil
+}
+
+// importRow validates a row of a catalog and returns the product it creates or updates, along with the errors of its cells.
+// The price of an existing product can only be changed when editPrice is set
+func (cs *CatalogService) importRow(ctx context.Context, row *domain.CatalogRow, seen *catalogRows, editPrice bool) (*domain.Product, []domain.CatalogRowError, error) {
+	var rowErrors []domain.CatalogRowError
+	var product *domain.Product
+	var sku uuid.UUID
+	var err error
+
+	rowError := func(column, format string, args ...any) {
+		rowErrors = append(rowErrors, domain.CatalogRowError{
+			Row:     row.Row,
+			Column:  column,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if row.SKU != "" {
+		sku, err = uuid.Parse(row.SKU)
+		if err != nil {
+			rowError("sku", "must be a UUID")
+		} else if duplicate, ok := seen.skus[sku]; ok {
+			rowError("sku", "duplicates row %d", duplicate)
+		} else {
+			seen.skus[sku] = row.Row
+
+			product, err = cs.productRepo.GetProductBySKU(ctx, sku)
+			if err != nil && err != domain.ErrDataNotFound {
+				return nil, nil, domain.ErrInternal
+			}
+		}
+	}
+
+	newBarcode := false
+
+	if row.Barcode != "" {
+		if !util.IsValidBarcode(row.Barcode) {
+			rowError("barcode", "must be an EAN-13, EAN-8 or UPC-A barcode with a valid check digit")
+		} else if duplicate, ok := seen.barcodes[row.Barcode]; ok {
+			rowError("barcode", "duplicates row %d", duplicate)
+		} else {
+			seen.barcodes[row.Barcode] = row.Row
+
+			barcode, err := cs.barcodeRepo.GetBarcodeByCode(ctx, row.Barcode)
+			switch {
+			case err == domain.ErrDataNotFound:
+				newBarcode = true
+			case err != nil:
+				return nil, nil, domain.ErrInternal
+			case sku == uuid.Nil:
+				product, err = cs.productRepo.GetProductByID(ctx, barcode.ProductID)
+				if err != nil {
+					return nil, nil, domain.ErrInternal
+				}
+			case product == nil || product.ID != barcode.ProductID:
+				rowError("barcode", "belongs to another product")
+			}
+		}
+	}
+
+	if product == nil {
+		product = &domain.Product{
+			SKU:  sku,
+			Unit: domain.DefaultUnit,
+		}
+
+		if row.Name == "" {
+			rowError("name", "is required for a new product")
+		}
+		if row.Category == "" {
+			rowError("category", "is required for a new product")
+		}
+		if row.Price == "" {
+			rowError("price", "is required for a new product")
+		}
+		if row.Unit != "" {
+			product.Unit = row.Unit
+		}
+	} else {
+		if duplicate, ok := seen.products[product.ID]; ok {
+			column := "sku"
+			if sku == uuid.Nil {
+				column = "barcode"
+			}
+			rowError(column, "updates the same product as row %d", duplicate)
+		}
+		seen.products[product.ID] = row.Row
+
+		if row.Unit != "" && row.Unit != product.Unit {
+			rowError("unit", "cannot be changed from %s", product.Unit)
+		}
+		if product.IsBundle && row.Cost != "" {
+			rowError("cost", "of a bundle is derived from its components")
+		}
+		if row.Stock != "" && (product.IsBundle || len(product.Options) > 0 || product.TrackLots || product.TrackSerials) {
+			rowError("stock", "must be received through its lots, serial numbers, variants or components")
+		}
+	}
+
+	if newBarcode {
+		product.Barcodes = append(product.Barcodes, domain.Barcode{
+			Code: row.Barcode,
+			Type: domain.BarcodeStandard,
+		})
+	}
+
+	if row.Name != "" {
+		product.Name = row.Name
+	}
+
+	if row.Category != "" {
+		category, ok := seen.categories[row.Category]
+		if !ok {
+			category, err = cs.categoryRepo.GetCategoryByName(ctx, row.Category)
+			if err != nil && err != domain.ErrDataNotFound {
+				return nil, nil, domain.ErrInternal
+			}
+			seen.categories[row.Category] = category
+		}
+
+		if category == nil {
+			rowError("category", "%s does not exist", row.Category)
+		} else {
+			product.CategoryID = category.ID
+			product.Category = category
+		}
+	}
+
+	if row.Price != "" {
+		price, ok := parseCatalogNumber(row.Price)
+		if !ok {
+			rowError("price", "must be a number not less than 0")
+		} else if product.ID != 0 && price != product.Price && !editPrice {
+			rowError("price", "cannot be changed without the %s permission", domain.ProductsEditPrice)
+		}
+		product.Price = price
+	}
+
+	if row.Cost != "" {
+		cost, ok := parseCatalogNumber(row.Cost)
+		if !ok {
+			rowError("cost", "must be a number not less than 0")
+		}
+		product.Cost = cost
+	}
+
+	if row.Stock != "" {
+		stock, ok := parseCatalogNumber(row.Stock)
+		if !ok {
+			rowError("stock", "must be a number not less than 0")
+		} else if !product.Fractional && stock != math.Trunc(stock) {
+			rowError("stock", "must be a whole number")
+		}
+		product.Stock = stock
+	}
+
+	return product, rowErrors, nil
+}
+
+// ExportCatalog exports every product, including variants and bundles, along with its first standard barcode and current stock
+func (cs *CatalogService) ExportCatalog(ctx context.Context, format domain.CatalogFormat) (*domain.Catalog, error) {
+	var rows []domain.CatalogRow
+
+	categories := make(map[uint64]*domain.Category)
+
+	for skip := uint64(1); ; skip++ {
+		products, err := cs.productRepo.ListProducts(ctx, "", 0, skip, catalogPageSize, false)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+
+		for _, product := range products {
+			row, err := cs.exportRow(ctx, &product, categories)
+			if err != nil {
+				return nil, err
+			}
+
+			rows = append(rows, *row)
+		}
+
+		if len(products) < catalogPageSize {
+			break
+		}
+	}
+
+	catalog, err := cs.codec.EncodeCatalog(format, rows)
+	if err != nil {
+		if err == domain.ErrInvalidCatalog {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return catalog, nil
+}
+
+// exportRow writes a product into a catalog row
+func (cs *CatalogService) exportRow(ctx context.Context, product *domain.Product, categories map[uint64]*domain.Category) (*domain.CatalogRow, error) {
+	category, ok := categories[product.CategoryID]
+	if !ok {
+		var err error
+
+		category, err = cs.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		categories[product.CategoryID] = category
+	}
+
+	if product.IsBundle {
+		err := loadBundle(ctx, cs.productRepo, product)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	barcodes, err := cs.barcodeRepo.ListBarcodes(ctx, product.ID)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	row := &domain.CatalogRow{
+		SKU:      product.SKU.String(),
+		Name:     product.Name,
+		Category: category.Name,
+		Unit:     product.Unit,
+		Price:    formatCatalogNumber(product.Price),
+		Cost:     formatCatalogNumber(product.Cost),
+		Stock:    formatCatalogNumber(product.Stock),
+	}
+
+	for _, barcode := range barcodes {
+		if barcode.Type == domain.BarcodeStandard {
+			row.Barcode = barcode.Code
+			break
+		}
+	}
+
+	return row, nil
+}
+
+// parseCatalogNumber parses the number in a catalog cell, which must not be negative
+func parseCatalogNumber(value string) (float64, bool) {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) || number < 0 {
+		return 0, false
+	}
+
+	return number, true
+}
+
+// formatCatalogNumber formats a number for a catalog cell without trailing zeros
+func formatCatalogNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
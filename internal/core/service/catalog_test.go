@@ -0,0 +1,543 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type importCatalogTestedInput struct {
+	dryRun      bool
+	permissions []domain.Permission
+}
+
+type importCatalogExpectedOutput struct {
+	result *domain.CatalogImport
+	err    error
+}
+
+func TestCatalogService_ImportCatalog(t *testing.T) {
+	ctx := context.Background()
+	userID := gofakeit.Uint64()
+	catalog := &domain.Catalog{
+		Format:  domain.CatalogCSV,
+		Content: []byte(gofakeit.Sentence(10)),
+	}
+
+	category := &domain.Category{
+		ID:   gofakeit.Uint64(),
+		Name: "Beverages",
+	}
+	existingProduct := &domain.Product{
+		ID:         gofakeit.Uint64(),
+		CategoryID: category.ID,
+		SKU:        uuid.New(),
+		Name:       "Green Tea",
+		Stock:      10,
+		Price:      5000,
+		Cost:       3000,
+		Unit:       domain.DefaultUnit,
+	}
+	existingBarcode := &domain.Barcode{
+		ID:        gofakeit.Uint64(),
+		ProductID: existingProduct.ID,
+		Code:      "4006381333931",
+		Type:      domain.BarcodeStandard,
+	}
+	newBarcode := "5901234123457"
+	managePermissions := []domain.Permission{domain.CatalogManage, domain.ProductsEditPrice}
+
+	rows := []domain.CatalogRow{
+		{Row: 2, SKU: existingProduct.SKU.String(), Price: "5500", Stock: "0"},
+		{Row: 3, Barcode: newBarcode, Name: "Black Coffee", Category: "Beverages", Price: "7000", Stock: "24"},
+	}
+
+	getExistingProduct := func() *domain.Product {
+		product := *existingProduct
+		return &product
+	}
+
+	importedProducts := []domain.Product{
+		{
+			ID:         existingProduct.ID,
+			CategoryID: category.ID,
+			SKU:        existingProduct.SKU,
+			Name:       "Green Tea",
+			Stock:      0,
+			Price:      5500,
+			Cost:       3000,
+			Unit:       domain.DefaultUnit,
+		},
+		{
+			CategoryID: category.ID,
+			Name:       "Black Coffee",
+			Stock:      24,
+			Price:      7000,
+			Unit:       domain.DefaultUnit,
+			Category:   category,
+			Barcodes: []domain.Barcode{
+				{Code: newBarcode, Type: domain.BarcodeStandard},
+			},
+		},
+	}
+	createdProduct := importedProducts[1]
+	createdProduct.ID = gofakeit.Uint64()
+	createdProduct.SKU = uuid.New()
+
+	existingCacheKey := util.GenerateCacheKey("product", existingProduct.ID)
+	createdCacheKey := util.GenerateCacheKey("product", createdProduct.ID)
+
+	validRows := func(
+		productRepo *mock.MockProductRepository,
+		categoryRepo *mock.MockCategoryRepository,
+		barcodeRepo *mock.MockBarcodeRepository,
+		codec *mock.MockCatalogCodec,
+	) {
+		codec.EXPECT().
+			DecodeCatalog(gomock.Eq(catalog)).
+			Times(1).
+			Return(rows, nil)
+		productRepo.EXPECT().
+			GetProductBySKU(gomock.Any(), gomock.Eq(existingProduct.SKU)).
+			Times(1).
+			Return(getExistingProduct(), nil)
+		barcodeRepo.EXPECT().
+			GetBarcodeByCode(gomock.Any(), gomock.Eq(newBarcode)).
+			Times(1).
+			Return(nil, domain.ErrDataNotFound)
+		categoryRepo.EXPECT().
+			GetCategoryByName(gomock.Any(), gomock.Eq("Beverages")).
+			Times(1).
+			Return(category, nil)
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			productRepo *mock.MockProductRepository,
+			categoryRepo *mock.MockCategoryRepository,
+			barcodeRepo *mock.MockBarcodeRepository,
+			codec *mock.MockCatalogCodec,
+			cache *mock.MockCacheRepository,
+		)
+		input    importCatalogTestedInput
+		expected importCatalogExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+				cache *mock.MockCacheRepository,
+			) {
+				validRows(productRepo, categoryRepo, barcodeRepo, codec)
+				productRepo.EXPECT().
+					ImportProducts(gomock.Any(), gomock.Eq(importedProducts), gomock.Eq(userID)).
+					Times(1).
+					Return([]domain.Product{importedProducts[0], createdProduct}, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(existingCacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(createdCacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: importCatalogTestedInput{
+				dryRun:      false,
+				permissions: managePermissions,
+			},
+			expected: importCatalogExpectedOutput{
+				result: &domain.CatalogImport{
+					Created:  1,
+					Updated:  1,
+					Products: []domain.Product{importedProducts[0], createdProduct},
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_DryRun",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+				cache *mock.MockCacheRepository,
+			) {
+				validRows(productRepo, categoryRepo, barcodeRepo, codec)
+			},
+			input: importCatalogTestedInput{
+				dryRun:      true,
+				permissions: managePermissions,
+			},
+			expected: importCatalogExpectedOutput{
+				result: &domain.CatalogImport{
+					DryRun:  true,
+					Created: 1,
+					Updated: 1,
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_RowErrors",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+				cache *mock.MockCacheRepository,
+			) {
+				codec.EXPECT().
+					DecodeCatalog(gomock.Eq(catalog)).
+					Times(1).
+					Return([]domain.CatalogRow{
+						{Row: 2, SKU: "not-a-uuid", Name: "Milk", Category: "Dairy", Price: "-1"},
+						{Row: 3, Barcode: existingBarcode.Code, Unit: "kg", Stock: "1.5"},
+						{Row: 4, SKU: existingProduct.SKU.String(), Price: "5500"},
+						{Row: 5, Barcode: "4006381333932", Name: "Water", Category: "Beverages"},
+					}, nil)
+				categoryRepo.EXPECT().
+					GetCategoryByName(gomock.Any(), gomock.Eq("Dairy")).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(existingBarcode.Code)).
+					Times(1).
+					Return(existingBarcode, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(existingProduct.ID)).
+					Times(1).
+					Return(getExistingProduct(), nil)
+				productRepo.EXPECT().
+					GetProductBySKU(gomock.Any(), gomock.Eq(existingProduct.SKU)).
+					Times(1).
+					Return(getExistingProduct(), nil)
+				categoryRepo.EXPECT().
+					GetCategoryByName(gomock.Any(), gomock.Eq("Beverages")).
+					Times(1).
+					Return(category, nil)
+			},
+			input: importCatalogTestedInput{
+				dryRun:      false,
+				permissions: managePermissions,
+			},
+			expected: importCatalogExpectedOutput{
+				result: &domain.CatalogImport{
+					Created: 2,
+					Updated: 2,
+					Errors: []domain.CatalogRowError{
+						{Row: 2, Column: "sku", Message: "must be a UUID"},
+						{Row: 2, Column: "category", Message: "Dairy does not exist"},
+						{Row: 2, Column: "price", Message: "must be a number not less than 0"},
+						{Row: 3, Column: "unit", Message: "cannot be changed from pcs"},
+						{Row: 3, Column: "stock", Message: "must be a whole number"},
+						{Row: 4, Column: "sku", Message: "updates the same product as row 3"},
+						{Row: 5, Column: "barcode", Message: "must be an EAN-13, EAN-8 or UPC-A barcode with a valid check digit"},
+						{Row: 5, Column: "price", Message: "is required for a new product"},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_PriceChangeNotAllowed",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+				cache *mock.MockCacheRepository,
+			) {
+				validRows(productRepo, categoryRepo, barcodeRepo, codec)
+			},
+			input: importCatalogTestedInput{
+				dryRun:      false,
+				permissions: []domain.Permission{domain.CatalogManage},
+			},
+			expected: importCatalogExpectedOutput{
+				result: &domain.CatalogImport{
+					Created: 1,
+					Updated: 1,
+					Errors: []domain.CatalogRowError{
+						{Row: 2, Column: "price", Message: "cannot be changed without the products.edit_price permission"},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Success_BarcodeOfAnotherProduct",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+				cache *mock.MockCacheRepository,
+			) {
+				sku := uuid.New()
+
+				codec.EXPECT().
+					DecodeCatalog(gomock.Eq(catalog)).
+					Times(1).
+					Return([]domain.CatalogRow{
+						{Row: 2, SKU: sku.String(), Barcode: existingBarcode.Code, Name: "Oolong Tea", Category: "Beverages", Price: "6000"},
+					}, nil)
+				productRepo.EXPECT().
+					GetProductBySKU(gomock.Any(), gomock.Eq(sku)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				barcodeRepo.EXPECT().
+					GetBarcodeByCode(gomock.Any(), gomock.Eq(existingBarcode.Code)).
+					Times(1).
+					Return(existingBarcode, nil)
+				categoryRepo.EXPECT().
+					GetCategoryByName(gomock.Any(), gomock.Eq("Beverages")).
+					Times(1).
+					Return(category, nil)
+			},
+			input: importCatalogTestedInput{
+				dryRun:      false,
+				permissions: managePermissions,
+			},
+			expected: importCatalogExpectedOutput{
+				result: &domain.CatalogImport{
+					Created: 1,
+					Errors: []domain.CatalogRowError{
+						{Row: 2, Column: "barcode", Message: "belongs to another product"},
+					},
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidCatalog",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+				cache *mock.MockCacheRepository,
+			) {
+				codec.EXPECT().
+					DecodeCatalog(gomock.Eq(catalog)).
+					Times(1).
+					Return(nil, domain.ErrInvalidCatalog)
+			},
+			input: importCatalogTestedInput{
+				dryRun:      false,
+				permissions: managePermissions,
+			},
+			expected: importCatalogExpectedOutput{
+				result: nil,
+				err:    domain.ErrInvalidCatalog,
+			},
+		},
+		{
+			desc: "Fail_DuplicateData",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+				cache *mock.MockCacheRepository,
+			) {
+				validRows(productRepo, categoryRepo, barcodeRepo, codec)
+				productRepo.EXPECT().
+					ImportProducts(gomock.Any(), gomock.Eq(importedProducts), gomock.Eq(userID)).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: importCatalogTestedInput{
+				dryRun:      false,
+				permissions: managePermissions,
+			},
+			expected: importCatalogExpectedOutput{
+				result: nil,
+				err:    domain.ErrConflictingData,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			barcodeRepo := mock.NewMockBarcodeRepository(ctrl)
+			codec := mock.NewMockCatalogCodec(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(productRepo, categoryRepo, barcodeRepo, codec, cache)
+
+			catalogService := service.NewCatalogService(productRepo, categoryRepo, barcodeRepo, codec, cache)
+
+			payload := &domain.TokenPayload{
+				UserID:      userID,
+				Permissions: tc.input.permissions,
+			}
+
+			result, err := catalogService.ImportCatalog(ctx, catalog, tc.input.dryRun, payload)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.result, result, "Import result mismatch")
+		})
+	}
+}
+
+type exportCatalogExpectedOutput struct {
+	catalog *domain.Catalog
+	err     error
+}
+
+func TestCatalogService_ExportCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	category := &domain.Category{
+		ID:   gofakeit.Uint64(),
+		Name: "Beverages",
+	}
+	products := []domain.Product{
+		{
+			ID:         1,
+			CategoryID: category.ID,
+			SKU:        uuid.New(),
+			Name:       "Green Tea",
+			Stock:      12,
+			Price:      5000,
+			Cost:       3250.5,
+			Unit:       domain.DefaultUnit,
+		},
+		{
+			ID:         2,
+			CategoryID: category.ID,
+			SKU:        uuid.New(),
+			Name:       "Coffee Beans",
+			Stock:      2.75,
+			Price:      150000,
+			Cost:       90000,
+			Unit:       "kg",
+			Fractional: true,
+		},
+	}
+	barcodes := []domain.Barcode{
+		{ID: 1, ProductID: 1, Code: "20123", Type: domain.BarcodeWeight},
+		{ID: 2, ProductID: 1, Code: "4006381333931", Type: domain.BarcodeStandard},
+	}
+	rows := []domain.CatalogRow{
+		{SKU: products[0].SKU.String(), Barcode: "4006381333931", Name: "Green Tea", Category: "Beverages", Unit: "pcs", Price: "5000", Cost: "3250.5", Stock: "12"},
+		{SKU: products[1].SKU.String(), Name: "Coffee Beans", Category: "Beverages", Unit: "kg", Price: "150000", Cost: "90000", Stock: "2.75"},
+	}
+	catalog := &domain.Catalog{
+		Format:  domain.CatalogXLSX,
+		Content: []byte(gofakeit.Sentence(10)),
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			productRepo *mock.MockProductRepository,
+			categoryRepo *mock.MockCategoryRepository,
+			barcodeRepo *mock.MockBarcodeRepository,
+			codec *mock.MockCatalogCodec,
+		)
+		expected exportCatalogExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+			) {
+				productRepo.EXPECT().
+					ListProducts(gomock.Any(), gomock.Eq(""), gomock.Eq(uint64(0)), gomock.Eq(uint64(1)), gomock.Eq(uint64(100)), gomock.Eq(false)).
+					Times(1).
+					Return(products, nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(category.ID)).
+					Times(1).
+					Return(category, nil)
+				barcodeRepo.EXPECT().
+					ListBarcodes(gomock.Any(), gomock.Eq(uint64(1))).
+					Times(1).
+					Return(barcodes, nil)
+				barcodeRepo.EXPECT().
+					ListBarcodes(gomock.Any(), gomock.Eq(uint64(2))).
+					Times(1).
+					Return(nil, nil)
+				codec.EXPECT().
+					EncodeCatalog(gomock.Eq(domain.CatalogXLSX), gomock.Eq(rows)).
+					Times(1).
+					Return(catalog, nil)
+			},
+			expected: exportCatalogExpectedOutput{
+				catalog: catalog,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				codec *mock.MockCatalogCodec,
+			) {
+				productRepo.EXPECT().
+					ListProducts(gomock.Any(), gomock.Eq(""), gomock.Eq(uint64(0)), gomock.Eq(uint64(1)), gomock.Eq(uint64(100)), gomock.Eq(false)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: exportCatalogExpectedOutput{
+				catalog: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			barcodeRepo := mock.NewMockBarcodeRepository(ctrl)
+			codec := mock.NewMockCatalogCodec(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(productRepo, categoryRepo, barcodeRepo, codec)
+
+			catalogService := service.NewCatalogService(productRepo, categoryRepo, barcodeRepo, codec, cache)
+
+			catalog, err := catalogService.ExportCatalog(ctx, domain.CatalogXLSX)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.catalog, catalog, "Catalog mismatch")
+		})
+	}
+}
@@ -26,11 +26,21 @@ func NewCategoryService(repo port.CategoryRepository, cache port.CacheRepository
 	}
 }
 
-// CreateCategory creates a new category
+// CreateCategory creates a new category, optionally as a sub-category of a parent category
 func (cs *CategoryService) CreateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	if category.ParentID != 0 {
+		_, err := cs.repo.GetCategoryByID(ctx, category.ParentID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+	}
+
 	category, err := cs.repo.CreateCategory(ctx, category)
 	if err != nil {
-		if err == domain.ErrConflictingData {
+		if err == domain.ErrConflictingData || err == domain.ErrDataNotFound {
 			return nil, err
 		}
 		return nil, domain.ErrInternal
@@ -125,6 +135,47 @@ func (cs *CategoryService) ListCategories(ctx context.Context, skip, limit uint6
 	return categories, nil
 }
 
+// GetCategoryTree retrieves the top-level categories with their nested sub-categories
+func (cs *CategoryService) GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error) {
+	var tree []domain.CategoryNode
+
+	cacheKey := util.GenerateCacheKey("categories", "tree")
+
+	cachedTree, err := cs.cache.Get(ctx, cacheKey)
+	if err == nil {
+		err := util.Deserialize(cachedTree, &tree)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+
+		return tree, nil
+	}
+
+	categories, err := cs.repo.ListAllCategories(ctx)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	children := make(map[uint64][]domain.Category)
+	for _, category := range categories {
+		children[category.ParentID] = append(children[category.ParentID], category)
+	}
+
+	tree = buildCategoryTree(children, 0)
+
+	treeSerialized, err := util.Serialize(tree)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, treeSerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return tree, nil
+}
+
 // UpdateCategory updates a category
 func (cs *CategoryService) UpdateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
 	existingCategory, err := cs.repo.GetCategoryByID(ctx, category.ID)
@@ -174,6 +225,57 @@ func (cs *CategoryService) UpdateCategory(ctx context.Context, category *domain.
 	return category, nil
 }
 
+// MoveCategory moves a category under a parent category, or to the top level when the parent id is 0,
+// refusing to move it under itself or one of its sub-categories
+func (cs *CategoryService) MoveCategory(ctx context.Context, id, parentID uint64) (*domain.Category, error) {
+	existingCategory, err := cs.repo.GetCategoryByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if existingCategory.ParentID == parentID {
+		return nil, domain.ErrNoUpdatedData
+	}
+
+	if parentID == id {
+		return nil, domain.ErrCategoryCycle
+	}
+
+	category, err := cs.repo.UpdateCategoryParent(ctx, id, parentID)
+	if err != nil {
+		if err == domain.ErrDataNotFound || err == domain.ErrCategoryCycle {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	cacheKey := util.GenerateCacheKey("category", id)
+	categorySerialized, err := util.Serialize(category)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = cs.cache.Set(ctx, cacheKey, categorySerialized, 0)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "categories:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = cs.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return category, nil
+}
+
 // DeleteCategory deletes a category
 func (cs *CategoryService) DeleteCategory(ctx context.Context, id uint64) error {
 	_, err := cs.repo.GetCategoryByID(ctx, id)
@@ -198,3 +300,17 @@ func (cs *CategoryService) DeleteCategory(ctx context.Context, id uint64) error
 
 	return cs.repo.DeleteCategory(ctx, id)
 }
+
+// buildCategoryTree nests the categories under the given parent id with their own sub-categories
+func buildCategoryTree(children map[uint64][]domain.Category, parentID uint64) []domain.CategoryNode {
+	var nodes []domain.CategoryNode
+
+	for _, category := range children[parentID] {
+		nodes = append(nodes, domain.CategoryNode{
+			Category: category,
+			Children: buildCategoryTree(children, category.ID),
+		})
+	}
+
+	return nodes
+}
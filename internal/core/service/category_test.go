@@ -1060,3 +1060,306 @@ func TestCategoryService_DeleteCategory(t *testing.T) {
 		})
 	}
 }
+
+type getCategoryTreeExpectedOutput struct {
+	tree []domain.CategoryNode
+	err  error
+}
+
+func TestCategoryService_GetCategoryTree(t *testing.T) {
+	ctx := context.Background()
+
+	foods := domain.Category{ID: 1, Name: "Foods"}
+	beverages := domain.Category{ID: 2, Name: "Beverages"}
+	snacks := domain.Category{ID: 3, ParentID: foods.ID, Name: "Snacks"}
+	chips := domain.Category{ID: 4, ParentID: snacks.ID, Name: "Chips"}
+	categories := []domain.Category{beverages, chips, foods, snacks}
+
+	tree := []domain.CategoryNode{
+		{Category: beverages},
+		{
+			Category: foods,
+			Children: []domain.CategoryNode{
+				{
+					Category: snacks,
+					Children: []domain.CategoryNode{
+						{Category: chips},
+					},
+				},
+			},
+		},
+	}
+	treeSerialized, _ := util.Serialize(tree)
+
+	cacheKey := util.GenerateCacheKey("categories", "tree")
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			categoryRepo *mock.MockCategoryRepository,
+			cache *mock.MockCacheRepository,
+		)
+		expected getCategoryTreeExpectedOutput
+	}{
+		{
+			desc: "Success_FromCache",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(treeSerialized, nil)
+			},
+			expected: getCategoryTreeExpectedOutput{
+				tree: tree,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Success_FromDB",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				categoryRepo.EXPECT().
+					ListAllCategories(gomock.Any()).
+					Times(1).
+					Return(categories, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(treeSerialized), gomock.Eq(time.Duration(0))).
+					Times(1).
+					Return(nil)
+			},
+			expected: getCategoryTreeExpectedOutput{
+				tree: tree,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				categoryRepo.EXPECT().
+					ListAllCategories(gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: getCategoryTreeExpectedOutput{
+				tree: nil,
+				err:  domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(categoryRepo, cache)
+
+			categoryService := service.NewCategoryService(categoryRepo, cache)
+
+			tree, err := categoryService.GetCategoryTree(ctx)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.tree, tree, "Category tree mismatch")
+		})
+	}
+}
+
+type moveCategoryTestedInput struct {
+	id       uint64
+	parentID uint64
+}
+
+type moveCategoryExpectedOutput struct {
+	category *domain.Category
+	err      error
+}
+
+func TestCategoryService_MoveCategory(t *testing.T) {
+	ctx := context.Background()
+
+	foods := &domain.Category{ID: 1, Name: "Foods"}
+	snacks := &domain.Category{ID: 2, ParentID: foods.ID, Name: "Snacks"}
+	chips := &domain.Category{ID: 3, ParentID: snacks.ID, Name: "Chips"}
+	beverages := &domain.Category{ID: 4, Name: "Beverages"}
+	movedSnacks := &domain.Category{ID: snacks.ID, ParentID: beverages.ID, Name: snacks.Name}
+	movedSnacksSerialized, _ := util.Serialize(movedSnacks)
+
+	cacheKey := util.GenerateCacheKey("category", snacks.ID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			categoryRepo *mock.MockCategoryRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    moveCategoryTestedInput
+		expected moveCategoryExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(snacks.ID)).
+					Times(1).
+					Return(snacks, nil)
+				categoryRepo.EXPECT().
+					UpdateCategoryParent(gomock.Any(), gomock.Eq(snacks.ID), gomock.Eq(beverages.ID)).
+					Times(1).
+					Return(movedSnacks, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(movedSnacksSerialized), gomock.Eq(time.Duration(0))).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("categories:*")).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: moveCategoryTestedInput{
+				id:       snacks.ID,
+				parentID: beverages.ID,
+			},
+			expected: moveCategoryExpectedOutput{
+				category: movedSnacks,
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_MovedUnderItself",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(snacks.ID)).
+					Times(1).
+					Return(snacks, nil)
+			},
+			input: moveCategoryTestedInput{
+				id:       snacks.ID,
+				parentID: snacks.ID,
+			},
+			expected: moveCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrCategoryCycle,
+			},
+		},
+		{
+			desc: "Fail_MovedUnderDescendant",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(foods.ID)).
+					Times(1).
+					Return(foods, nil)
+				categoryRepo.EXPECT().
+					UpdateCategoryParent(gomock.Any(), gomock.Eq(foods.ID), gomock.Eq(chips.ID)).
+					Times(1).
+					Return(nil, domain.ErrCategoryCycle)
+			},
+			input: moveCategoryTestedInput{
+				id:       foods.ID,
+				parentID: chips.ID,
+			},
+			expected: moveCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrCategoryCycle,
+			},
+		},
+		{
+			desc: "Fail_SameParent",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(snacks.ID)).
+					Times(1).
+					Return(snacks, nil)
+			},
+			input: moveCategoryTestedInput{
+				id:       snacks.ID,
+				parentID: foods.ID,
+			},
+			expected: moveCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrNoUpdatedData,
+			},
+		},
+		{
+			desc: "Fail_ParentNotFound",
+			mocks: func(
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(snacks.ID)).
+					Times(1).
+					Return(snacks, nil)
+				categoryRepo.EXPECT().
+					UpdateCategoryParent(gomock.Any(), gomock.Eq(snacks.ID), gomock.Eq(beverages.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: moveCategoryTestedInput{
+				id:       snacks.ID,
+				parentID: beverages.ID,
+			},
+			expected: moveCategoryExpectedOutput{
+				category: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(categoryRepo, cache)
+
+			categoryService := service.NewCategoryService(categoryRepo, cache)
+
+			category, err := categoryService.MoveCategory(ctx, tc.input.id, tc.input.parentID)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.category, category, "Category mismatch")
+		})
+	}
+}
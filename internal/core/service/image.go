@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/google/uuid"
+)
+
+/**
+ * ImageService implements port.ImageService interface
+ * and provides an access to the product and payment repositories,
+ * file storage, image processor and cache service
+ */
+type ImageService struct {
+	productRepo port.ProductRepository
+	paymentRepo port.PaymentRepository
+	storage     port.FileStorage
+	processor   port.ImageProcessor
+	cache       port.CacheRepository
+}
+
+// NewImageService creates a new image service instance
+func NewImageService(productRepo port.ProductRepository, paymentRepo port.PaymentRepository, storage port.FileStorage, processor port.ImageProcessor, cache port.CacheRepository) *ImageService {
+	return &ImageService{
+		productRepo,
+		paymentRepo,
+		storage,
+		processor,
+		cache,
+	}
+}
+
+// UploadProductImage stores an image and its thumbnail as the image of a product, deleting the image it replaces if it was uploaded too
+func (is *ImageService) UploadProductImage(ctx context.Context, productID uint64, content []byte) (*domain.Product, error) {
+	product, err := is.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	image, thumbnail, err := is.storeImage(ctx, fmt.Sprintf("products/%d", productID), content)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedProduct, err := is.productRepo.UpdateProductImage(ctx, productID, is.storage.URL(image.Key), is.storage.URL(thumbnail.Key))
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	is.deleteImages(ctx, product.Image, product.Thumbnail)
+
+	cacheKey := util.GenerateCacheKey("product", productID)
+
+	err = is.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	if product.ParentID != 0 {
+		parentCacheKey := util.GenerateCacheKey("product", product.ParentID)
+
+		err = is.cache.Delete(ctx, parentCacheKey)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+	}
+
+	err = is.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return updatedProduct, nil
+}
+
+// UploadPaymentLogo stores an image and its thumbnail as the logo of a payment, deleting the logo it replaces if it was uploaded too
+func (is *ImageService) UploadPaymentLogo(ctx context.Context, paymentID uint64, content []byte) (*domain.Payment, error) {
+	payment, err := is.paymentRepo.GetPaymentByID(ctx, paymentID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	image, thumbnail, err := is.storeImage(ctx, fmt.Sprintf("payments/%d", paymentID), content)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPayment, err := is.paymentRepo.UpdatePaymentLogo(ctx, paymentID, is.storage.URL(image.Key), is.storage.URL(thumbnail.Key))
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	is.deleteImages(ctx, payment.Logo, payment.LogoThumbnail)
+
+	cacheKey := util.GenerateCacheKey("payment", paymentID)
+
+	err = is.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = is.cache.DeleteByPrefix(ctx, "payments:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return updatedPayment, nil
+}
+
+// GetImage retrieves a stored image or thumbnail by key
+func (is *ImageService) GetImage(ctx context.Context, key string) (*domain.File, error) {
+	file, err := is.storage.Get(ctx, key)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return file, nil
+}
+
+// storeImage validates an image, generates its thumbnail and stores both under a unique name in the given folder
+func (is *ImageService) storeImage(ctx context.Context, folder string, content []byte) (*domain.File, *domain.File, error) {
+	if len(content) == 0 || len(content) > domain.MaxImageSize {
+		return nil, nil, domain.ErrInvalidImage
+	}
+
+	thumbnail, err := is.processor.Thumbnail(content)
+	if err != nil {
+		if err == domain.ErrInvalidImage {
+			return nil, nil, err
+		}
+		return nil, nil, domain.ErrInternal
+	}
+
+	name := uuid.NewString()
+	extension := domain.ImageExtensions[thumbnail.ContentType]
+
+	image := &domain.File{
+		Key:         fmt.Sprintf("%s/%s.%s", folder, name, extension),
+		ContentType: thumbnail.ContentType,
+		Content:     content,
+	}
+	thumbnail.Key = fmt.Sprintf("%s/%s_thumb.%s", folder, name, extension)
+
+	for _, file := range []*domain.File{image, thumbnail} {
+		err = is.storage.Put(ctx, file)
+		if err != nil {
+			return nil, nil, domain.ErrInternal
+		}
+	}
+
+	return image, thumbnail, nil
+}
+
+// deleteImages deletes the stored files of the given URLs, skipping the ones hosted elsewhere. The files are
+// no longer referenced once they are replaced, so failing to delete them only leaves them behind
+func (is *ImageService) deleteImages(ctx context.Context, urls ...string) {
+	for _, url := range urls {
+		key, ok := is.storage.Key(url)
+		if !ok {
+			continue
+		}
+
+		_ = is.storage.Delete(ctx, key)
+	}
+}
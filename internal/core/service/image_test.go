@@ -0,0 +1,398 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type uploadProductImageTestedInput struct {
+	content []byte
+}
+
+type uploadProductImageExpectedOutput struct {
+	product *domain.Product
+	err     error
+}
+
+func TestImageService_UploadProductImage(t *testing.T) {
+	ctx := context.Background()
+	content := []byte(gofakeit.Sentence(10))
+	thumbnail := &domain.File{
+		ContentType: "image/png",
+		Content:     []byte(gofakeit.Sentence(5)),
+	}
+
+	product := &domain.Product{
+		ID:        gofakeit.Uint64(),
+		ParentID:  gofakeit.Uint64(),
+		Name:      gofakeit.ProductName(),
+		Image:     "http://127.0.0.1:8080/v1/images/products/1/old.png",
+		Thumbnail: "http://127.0.0.1:8080/v1/images/products/1/old_thumb.png",
+	}
+	imageURL := "http://127.0.0.1:8080/v1/images/products/1/new.png"
+	thumbnailURL := "http://127.0.0.1:8080/v1/images/products/1/new_thumb.png"
+	updatedProduct := &domain.Product{
+		ID:        product.ID,
+		ParentID:  product.ParentID,
+		Name:      product.Name,
+		Image:     imageURL,
+		Thumbnail: thumbnailURL,
+	}
+
+	productCacheKey := util.GenerateCacheKey("product", product.ID)
+	parentCacheKey := util.GenerateCacheKey("product", product.ParentID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			productRepo *mock.MockProductRepository,
+			storage *mock.MockFileStorage,
+			processor *mock.MockImageProcessor,
+			cache *mock.MockCacheRepository,
+		)
+		input    uploadProductImageTestedInput
+		expected uploadProductImageExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				storage *mock.MockFileStorage,
+				processor *mock.MockImageProcessor,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				processor.EXPECT().
+					Thumbnail(gomock.Eq(content)).
+					Times(1).
+					Return(thumbnail, nil)
+				storage.EXPECT().
+					Put(gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(nil)
+				gomock.InOrder(
+					storage.EXPECT().
+						URL(gomock.Any()).
+						Times(1).
+						Return(imageURL),
+					storage.EXPECT().
+						URL(gomock.Any()).
+						Times(1).
+						Return(thumbnailURL),
+				)
+				productRepo.EXPECT().
+					UpdateProductImage(gomock.Any(), gomock.Eq(product.ID), gomock.Eq(imageURL), gomock.Eq(thumbnailURL)).
+					Times(1).
+					Return(updatedProduct, nil)
+				storage.EXPECT().
+					Key(gomock.Eq(product.Image)).
+					Times(1).
+					Return("products/1/old.png", true)
+				storage.EXPECT().
+					Key(gomock.Eq(product.Thumbnail)).
+					Times(1).
+					Return("products/1/old_thumb.png", true)
+				storage.EXPECT().
+					Delete(gomock.Any(), gomock.Eq("products/1/old.png")).
+					Times(1).
+					Return(nil)
+				storage.EXPECT().
+					Delete(gomock.Any(), gomock.Eq("products/1/old_thumb.png")).
+					Times(1).
+					Return(domain.ErrDataNotFound)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(productCacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(parentCacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: uploadProductImageTestedInput{
+				content: content,
+			},
+			expected: uploadProductImageExpectedOutput{
+				product: updatedProduct,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				storage *mock.MockFileStorage,
+				processor *mock.MockImageProcessor,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: uploadProductImageTestedInput{
+				content: content,
+			},
+			expected: uploadProductImageExpectedOutput{
+				product: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_EmptyImage",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				storage *mock.MockFileStorage,
+				processor *mock.MockImageProcessor,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: uploadProductImageTestedInput{
+				content: nil,
+			},
+			expected: uploadProductImageExpectedOutput{
+				product: nil,
+				err:     domain.ErrInvalidImage,
+			},
+		},
+		{
+			desc: "Fail_InvalidImage",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				storage *mock.MockFileStorage,
+				processor *mock.MockImageProcessor,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				processor.EXPECT().
+					Thumbnail(gomock.Eq(content)).
+					Times(1).
+					Return(nil, domain.ErrInvalidImage)
+			},
+			input: uploadProductImageTestedInput{
+				content: content,
+			},
+			expected: uploadProductImageExpectedOutput{
+				product: nil,
+				err:     domain.ErrInvalidImage,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				storage *mock.MockFileStorage,
+				processor *mock.MockImageProcessor,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				processor.EXPECT().
+					Thumbnail(gomock.Eq(content)).
+					Times(1).
+					Return(thumbnail, nil)
+				storage.EXPECT().
+					Put(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: uploadProductImageTestedInput{
+				content: content,
+			},
+			expected: uploadProductImageExpectedOutput{
+				product: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			paymentRepo := mock.NewMockPaymentRepository(ctrl)
+			storage := mock.NewMockFileStorage(ctrl)
+			processor := mock.NewMockImageProcessor(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(productRepo, storage, processor, cache)
+
+			imageService := service.NewImageService(productRepo, paymentRepo, storage, processor, cache)
+
+			product, err := imageService.UploadProductImage(ctx, product.ID, tc.input.content)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.product, product, "Product mismatch")
+		})
+	}
+}
+
+type uploadPaymentLogoExpectedOutput struct {
+	payment *domain.Payment
+	err     error
+}
+
+func TestImageService_UploadPaymentLogo(t *testing.T) {
+	ctx := context.Background()
+	content := []byte(gofakeit.Sentence(10))
+	thumbnail := &domain.File{
+		ContentType: "image/jpeg",
+		Content:     []byte(gofakeit.Sentence(5)),
+	}
+
+	payment := &domain.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.Name(),
+		Type: domain.Cash,
+		Logo: "https://example.com/cash.png",
+	}
+	logoURL := "http://127.0.0.1:8080/v1/images/payments/1/new.jpg"
+	thumbnailURL := "http://127.0.0.1:8080/v1/images/payments/1/new_thumb.jpg"
+	updatedPayment := &domain.Payment{
+		ID:            payment.ID,
+		Name:          payment.Name,
+		Type:          payment.Type,
+		Logo:          logoURL,
+		LogoThumbnail: thumbnailURL,
+	}
+
+	paymentCacheKey := util.GenerateCacheKey("payment", payment.ID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			paymentRepo *mock.MockPaymentRepository,
+			storage *mock.MockFileStorage,
+			processor *mock.MockImageProcessor,
+			cache *mock.MockCacheRepository,
+		)
+		expected uploadPaymentLogoExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				storage *mock.MockFileStorage,
+				processor *mock.MockImageProcessor,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(payment.ID)).
+					Times(1).
+					Return(payment, nil)
+				processor.EXPECT().
+					Thumbnail(gomock.Eq(content)).
+					Times(1).
+					Return(thumbnail, nil)
+				storage.EXPECT().
+					Put(gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(nil)
+				gomock.InOrder(
+					storage.EXPECT().
+						URL(gomock.Any()).
+						Times(1).
+						Return(logoURL),
+					storage.EXPECT().
+						URL(gomock.Any()).
+						Times(1).
+						Return(thumbnailURL),
+				)
+				paymentRepo.EXPECT().
+					UpdatePaymentLogo(gomock.Any(), gomock.Eq(payment.ID), gomock.Eq(logoURL), gomock.Eq(thumbnailURL)).
+					Times(1).
+					Return(updatedPayment, nil)
+				storage.EXPECT().
+					Key(gomock.Eq(payment.Logo)).
+					Times(1).
+					Return("", false)
+				storage.EXPECT().
+					Key(gomock.Eq("")).
+					Times(1).
+					Return("", false)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(paymentCacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("payments:*")).
+					Times(1).
+					Return(nil)
+			},
+			expected: uploadPaymentLogoExpectedOutput{
+				payment: updatedPayment,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				paymentRepo *mock.MockPaymentRepository,
+				storage *mock.MockFileStorage,
+				processor *mock.MockImageProcessor,
+				cache *mock.MockCacheRepository,
+			) {
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(payment.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			expected: uploadPaymentLogoExpectedOutput{
+				payment: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			paymentRepo := mock.NewMockPaymentRepository(ctrl)
+			storage := mock.NewMockFileStorage(ctrl)
+			processor := mock.NewMockImageProcessor(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(paymentRepo, storage, processor, cache)
+
+			imageService := service.NewImageService(productRepo, paymentRepo, storage, processor, cache)
+
+			payment, err := imageService.UploadPaymentLogo(ctx, payment.ID, content)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.payment, payment, "Payment mismatch")
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+// maxShelfLabels is the maximum number of shelf labels printed for a category at once
+const maxShelfLabels = 1000
+
+/**
+ * LabelService implements port.LabelService interface
+ * and provides an access to the product and barcode repositories
+ * and label renderer
+ */
+type LabelService struct {
+	productRepo port.ProductRepository
+	barcodeRepo port.BarcodeRepository
+	renderer    port.LabelRenderer
+}
+
+// NewLabelService creates a new label service instance
+func NewLabelService(productRepo port.ProductRepository, barcodeRepo port.BarcodeRepository, renderer port.LabelRenderer) *LabelService {
+	return &LabelService{
+		productRepo,
+		barcodeRepo,
+		renderer,
+	}
+}
+
+// GetBarcodeLabel renders the barcode of a product as an SVG or PNG image
+func (ls *LabelService) GetBarcodeLabel(ctx context.Context, productID uint64, symbology domain.BarcodeSymbology, format domain.LabelFormat) (*domain.Label, error) {
+	product, err := ls.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	code, err := ls.labelCode(ctx, product, symbology)
+	if err != nil {
+		return nil, err
+	}
+
+	label, err := ls.renderer.RenderBarcode(symbology, code, format)
+	if err != nil {
+		return nil, domain.ErrLabelRendering
+	}
+
+	return label, nil
+}
+
+// GetShelfLabels renders a PDF sheet of shelf labels for the given products, or for the products of a category.
+// Parent products are skipped, as only their variants are sold
+func (ls *LabelService) GetShelfLabels(ctx context.Context, productIDs []uint64, categoryID uint64, symbology domain.BarcodeSymbology) (*domain.Label, error) {
+	var products []domain.Product
+
+	if len(productIDs) > 0 {
+		for _, productID := range productIDs {
+			product, err := ls.productRepo.GetProductByID(ctx, productID)
+			if err != nil {
+				if err == domain.ErrDataNotFound {
+					return nil, err
+				}
+				return nil, domain.ErrInternal
+			}
+
+			products = append(products, *product)
+		}
+	} else {
+		var err error
+
+		products, err = ls.productRepo.ListProducts(ctx, "", categoryID, 1, maxShelfLabels, false)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+	}
+
+	var labels []domain.ShelfLabel
+
+	for _, product := range products {
+		if len(product.Options) > 0 {
+			continue
+		}
+
+		code, err := ls.labelCode(ctx, &product, symbology)
+		if err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, domain.ShelfLabel{
+			Name:      product.Name,
+			Price:     product.Price,
+			Unit:      product.Unit,
+			Symbology: symbology,
+			Code:      code,
+		})
+	}
+
+	if len(labels) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	label, err := ls.renderer.RenderShelfLabels(labels)
+	if err != nil {
+		return nil, domain.ErrLabelRendering
+	}
+
+	return label, nil
+}
+
+// labelCode returns the code printed on the label of a product. Code128 labels encode the SKU,
+// while EAN-13 labels use the manufacturer or generated in-store barcode of the product
+func (ls *LabelService) labelCode(ctx context.Context, product *domain.Product, symbology domain.BarcodeSymbology) (string, error) {
+	if symbology == domain.SymbologyCode128 {
+		return product.SKU.String(), nil
+	}
+
+	barcodes, err := ls.barcodeRepo.ListBarcodes(ctx, product.ID)
+	if err != nil {
+		return "", domain.ErrInternal
+	}
+
+	for _, barcode := range barcodes {
+		if barcode.Type != domain.BarcodeStandard {
+			continue
+		}
+
+		switch len(barcode.Code) {
+		case 13:
+			return barcode.Code, nil
+		case 12:
+			return "0" + barcode.Code, nil
+		}
+	}
+
+	return "", domain.ErrBarcodeRequired
+}
@@ -0,0 +1,374 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type getBarcodeLabelTestedInput struct {
+	productID uint64
+	symbology domain.BarcodeSymbology
+	format    domain.LabelFormat
+}
+
+type getBarcodeLabelExpectedOutput struct {
+	label *domain.Label
+	err   error
+}
+
+func TestLabelService_GetBarcodeLabel(t *testing.T) {
+	ctx := context.Background()
+	product := &domain.Product{
+		ID:   gofakeit.Uint64(),
+		SKU:  uuid.New(),
+		Name: gofakeit.ProductName(),
+	}
+
+	manufacturerBarcodes := []domain.Barcode{
+		{ProductID: product.ID, Code: "2100123", Type: domain.BarcodeWeight},
+		{ProductID: product.ID, Code: "8991234567891", Type: domain.BarcodeStandard},
+	}
+
+	label := &domain.Label{
+		Format:  domain.LabelSVG,
+		Content: []byte("<svg></svg>"),
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			productRepo *mock.MockProductRepository,
+			barcodeRepo *mock.MockBarcodeRepository,
+			renderer *mock.MockLabelRenderer,
+		)
+		input    getBarcodeLabelTestedInput
+		expected getBarcodeLabelExpectedOutput
+	}{
+		{
+			desc: "Success_Code128",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				renderer.EXPECT().
+					RenderBarcode(gomock.Eq(domain.SymbologyCode128), gomock.Eq(product.SKU.String()), gomock.Eq(domain.LabelSVG)).
+					Times(1).
+					Return(label, nil)
+			},
+			input: getBarcodeLabelTestedInput{
+				productID: product.ID,
+				symbology: domain.SymbologyCode128,
+				format:    domain.LabelSVG,
+			},
+			expected: getBarcodeLabelExpectedOutput{
+				label: label,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Success_EAN13Manufacturer",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				barcodeRepo.EXPECT().
+					ListBarcodes(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(manufacturerBarcodes, nil)
+				renderer.EXPECT().
+					RenderBarcode(gomock.Eq(domain.SymbologyEAN13), gomock.Eq("8991234567891"), gomock.Eq(domain.LabelSVG)).
+					Times(1).
+					Return(label, nil)
+			},
+			input: getBarcodeLabelTestedInput{
+				productID: product.ID,
+				symbology: domain.SymbologyEAN13,
+				format:    domain.LabelSVG,
+			},
+			expected: getBarcodeLabelExpectedOutput{
+				label: label,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_EAN13WithoutBarcode",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				barcodeRepo.EXPECT().
+					ListBarcodes(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(manufacturerBarcodes[:1], nil)
+			},
+			input: getBarcodeLabelTestedInput{
+				productID: product.ID,
+				symbology: domain.SymbologyEAN13,
+				format:    domain.LabelSVG,
+			},
+			expected: getBarcodeLabelExpectedOutput{
+				label: nil,
+				err:   domain.ErrBarcodeRequired,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: getBarcodeLabelTestedInput{
+				productID: product.ID,
+				symbology: domain.SymbologyCode128,
+				format:    domain.LabelSVG,
+			},
+			expected: getBarcodeLabelExpectedOutput{
+				label: nil,
+				err:   domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_Rendering",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				barcodeRepo *mock.MockBarcodeRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				renderer.EXPECT().
+					RenderBarcode(gomock.Eq(domain.SymbologyCode128), gomock.Eq(product.SKU.String()), gomock.Eq(domain.LabelPNG)).
+					Times(1).
+					Return(nil, domain.ErrLabelRendering)
+			},
+			input: getBarcodeLabelTestedInput{
+				productID: product.ID,
+				symbology: domain.SymbologyCode128,
+				format:    domain.LabelPNG,
+			},
+			expected: getBarcodeLabelExpectedOutput{
+				label: nil,
+				err:   domain.ErrLabelRendering,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			barcodeRepo := mock.NewMockBarcodeRepository(ctrl)
+			renderer := mock.NewMockLabelRenderer(ctrl)
+
+			tc.mocks(productRepo, barcodeRepo, renderer)
+
+			labelService := service.NewLabelService(productRepo, barcodeRepo, renderer)
+
+			label, err := labelService.GetBarcodeLabel(ctx, tc.input.productID, tc.input.symbology, tc.input.format)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.label, label, "Label mismatch")
+		})
+	}
+}
+
+type getShelfLabelsTestedInput struct {
+	productIDs []uint64
+	categoryID uint64
+	symbology  domain.BarcodeSymbology
+}
+
+type getShelfLabelsExpectedOutput struct {
+	label *domain.Label
+	err   error
+}
+
+func TestLabelService_GetShelfLabels(t *testing.T) {
+	ctx := context.Background()
+	categoryID := gofakeit.Uint64()
+	product := domain.Product{
+		ID:         gofakeit.Uint64(),
+		CategoryID: categoryID,
+		SKU:        uuid.New(),
+		Name:       gofakeit.ProductName(),
+		Price:      gofakeit.Price(1000, 100000),
+		Unit:       domain.DefaultUnit,
+	}
+	parent := domain.Product{
+		ID:         gofakeit.Uint64(),
+		CategoryID: categoryID,
+		SKU:        uuid.New(),
+		Name:       gofakeit.ProductName(),
+		Options: []domain.ProductOption{
+			{Name: "Size", Values: []string{"S", "M"}},
+		},
+	}
+
+	shelfLabels := []domain.ShelfLabel{
+		{
+			Name:      product.Name,
+			Price:     product.Price,
+			Unit:      product.Unit,
+			Symbology: domain.SymbologyCode128,
+			Code:      product.SKU.String(),
+		},
+	}
+	label := &domain.Label{
+		Format:  domain.LabelPDF,
+		Content: []byte("%PDF-1.3"),
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			productRepo *mock.MockProductRepository,
+			renderer *mock.MockLabelRenderer,
+		)
+		input    getShelfLabelsTestedInput
+		expected getShelfLabelsExpectedOutput
+	}{
+		{
+			desc: "Success_Products",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(&product, nil)
+				renderer.EXPECT().
+					RenderShelfLabels(gomock.Eq(shelfLabels)).
+					Times(1).
+					Return(label, nil)
+			},
+			input: getShelfLabelsTestedInput{
+				productIDs: []uint64{product.ID},
+				symbology:  domain.SymbologyCode128,
+			},
+			expected: getShelfLabelsExpectedOutput{
+				label: label,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Success_CategorySkipsParent",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					ListProducts(gomock.Any(), gomock.Eq(""), gomock.Eq(categoryID), gomock.Eq(uint64(1)), gomock.Any(), gomock.Eq(false)).
+					Times(1).
+					Return([]domain.Product{parent, product}, nil)
+				renderer.EXPECT().
+					RenderShelfLabels(gomock.Eq(shelfLabels)).
+					Times(1).
+					Return(label, nil)
+			},
+			input: getShelfLabelsTestedInput{
+				categoryID: categoryID,
+				symbology:  domain.SymbologyCode128,
+			},
+			expected: getShelfLabelsExpectedOutput{
+				label: label,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_NoProducts",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					ListProducts(gomock.Any(), gomock.Eq(""), gomock.Eq(categoryID), gomock.Eq(uint64(1)), gomock.Any(), gomock.Eq(false)).
+					Times(1).
+					Return([]domain.Product{parent}, nil)
+			},
+			input: getShelfLabelsTestedInput{
+				categoryID: categoryID,
+				symbology:  domain.SymbologyCode128,
+			},
+			expected: getShelfLabelsExpectedOutput{
+				label: nil,
+				err:   domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_NotFoundGetProduct",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				renderer *mock.MockLabelRenderer,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: getShelfLabelsTestedInput{
+				productIDs: []uint64{product.ID},
+				symbology:  domain.SymbologyCode128,
+			},
+			expected: getShelfLabelsExpectedOutput{
+				label: nil,
+				err:   domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			barcodeRepo := mock.NewMockBarcodeRepository(ctrl)
+			renderer := mock.NewMockLabelRenderer(ctrl)
+
+			tc.mocks(productRepo, renderer)
+
+			labelService := service.NewLabelService(productRepo, barcodeRepo, renderer)
+
+			label, err := labelService.GetShelfLabels(ctx, tc.input.productIDs, tc.input.categoryID, tc.input.symbology)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.label, label, "Label mismatch")
+		})
+	}
+}
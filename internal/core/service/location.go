@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+/**
+ * LocationService implements port.LocationService interface
+ * and provides an access to the location and product repositories
+ */
+type LocationService struct {
+	repo        port.LocationRepository
+	productRepo port.ProductRepository
+}
+
+// NewLocationService creates a new location service instance
+func NewLocationService(repo port.LocationRepository, productRepo port.ProductRepository) *LocationService {
+	return &LocationService{
+		repo,
+		productRepo,
+	}
+}
+
+// CreateLocation creates a new location stock can be transferred to
+func (ls *LocationService) CreateLocation(ctx context.Context, location *domain.Location) (*domain.Location, error) {
+	location, err := ls.repo.CreateLocation(ctx, location)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return location, nil
+}
+
+// ListLocations retrieves a list of locations
+func (ls *LocationService) ListLocations(ctx context.Context, skip, limit uint64) ([]domain.Location, error) {
+	locations, err := ls.repo.ListLocations(ctx, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return locations, nil
+}
+
+// ListLocationStocks retrieves a list of the products in stock at a location
+func (ls *LocationService) ListLocationStocks(ctx context.Context, id, skip, limit uint64) ([]domain.LocationStock, error) {
+	location, err := ls.repo.GetLocationByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	stocks, err := ls.repo.ListLocationStocks(ctx, location, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	for i, stock := range stocks {
+		product, err := ls.productRepo.GetProductByID(ctx, stock.ProductID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		stocks[i].Product = product
+	}
+
+	return stocks, nil
+}
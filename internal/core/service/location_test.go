@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type listLocationStocksExpectedOutput struct {
+	stocks []domain.LocationStock
+	err    error
+}
+
+func TestLocationService_ListLocationStocks(t *testing.T) {
+	ctx := context.Background()
+	skip, limit := uint64(1), uint64(10)
+	location := &domain.Location{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.City(),
+	}
+	product := &domain.Product{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.ProductName(),
+	}
+
+	stocks := []domain.LocationStock{
+		{
+			LocationID: location.ID,
+			ProductID:  product.ID,
+			Quantity:   gofakeit.Float64Range(1, 100),
+		},
+	}
+	loadedStocks := []domain.LocationStock{stocks[0]}
+	loadedStocks[0].Product = product
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			locationRepo *mock.MockLocationRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		expected listLocationStocksExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+				locationRepo.EXPECT().
+					ListLocationStocks(gomock.Any(), gomock.Eq(location), gomock.Eq(skip), gomock.Eq(limit)).
+					Times(1).
+					Return([]domain.LocationStock{stocks[0]}, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+			},
+			expected: listLocationStocksExpectedOutput{
+				stocks: loadedStocks,
+				err:    nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			expected: listLocationStocksExpectedOutput{
+				stocks: nil,
+				err:    domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(location.ID)).
+					Times(1).
+					Return(location, nil)
+				locationRepo.EXPECT().
+					ListLocationStocks(gomock.Any(), gomock.Eq(location), gomock.Eq(skip), gomock.Eq(limit)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: listLocationStocksExpectedOutput{
+				stocks: nil,
+				err:    domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			locationRepo := mock.NewMockLocationRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+
+			tc.mocks(locationRepo, productRepo)
+
+			locationService := service.NewLocationService(locationRepo, productRepo)
+
+			stocks, err := locationService.ListLocationStocks(ctx, location.ID, skip, limit)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.stocks, stocks, "Stocks mismatch")
+		})
+	}
+}
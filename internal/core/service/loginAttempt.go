@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+const (
+	// loginFailureWindow is how long failed login attempts are counted for
+	loginFailureWindow = 15 * time.Minute
+	// loginDelayThreshold is the number of failed attempts after which each failure delays the next attempt
+	loginDelayThreshold = 3
+	// loginBaseDelay is the first delay, doubled on each further failure up to loginMaxDelay
+	loginBaseDelay = time.Second
+	// loginMaxDelay is the longest delay between attempts
+	loginMaxDelay = time.Minute
+	// accountLockoutThreshold is the number of failed attempts after which an account is locked out
+	accountLockoutThreshold = 10
+	// ipLockoutThreshold is the number of failed attempts after which a client IP address is locked out,
+	// higher than for an account as several users may share an address
+	ipLockoutThreshold = 50
+	// loginLockoutDuration is how long an account or client IP address is locked out for
+	loginLockoutDuration = 15 * time.Minute
+)
+
+// emailLoginSubject identifies the account of an email in the login attempt counters
+func emailLoginSubject(email string) string {
+	return "email:" + strings.ToLower(email)
+}
+
+// pinLoginSubject identifies the PIN of a user in the login attempt counters
+func pinLoginSubject(userID uint64) string {
+	return fmt.Sprintf("pin:%d", userID)
+}
+
+// passwordLoginSubject identifies the current password of a user in the login attempt counters,
+// so that changing the password cannot be used to guess it
+func passwordLoginSubject(userID uint64) string {
+	return fmt.Sprintf("password:%d", userID)
+}
+
+// ipLoginSubject identifies a client IP address in the login attempt counters
+func ipLoginSubject(ipAddress string) string {
+	return "ip:" + ipAddress
+}
+
+// checkLoginAttempts rejects a login attempt while the account or client IP address is delayed or locked out,
+// or when it cannot be checked
+func checkLoginAttempts(ctx context.Context, cache port.CacheRepository, subject, ipAddress string) error {
+	for _, s := range []string{subject, ipLoginSubject(ipAddress)} {
+		for _, prefix := range []string{"login_lockout", "login_delay"} {
+			_, err := cache.Get(ctx, util.GenerateCacheKey(prefix, s))
+			if err == nil {
+				return domain.ErrTooManyLoginAttempts
+			}
+
+			// An unreachable cache must not lift the lockout
+			if err != domain.ErrCacheMiss {
+				return domain.ErrInternal
+			}
+		}
+	}
+
+	return nil
+}
+
+// loginFailed records a failed login attempt and returns the error of the attempt, unless recording it failed
+func (as *AuthService) loginFailed(ctx context.Context, subject string, userID uint64, ipAddress string, loginErr error) error {
+	err := recordLoginFailure(ctx, as.cache, as.audit, subject, userID, ipAddress)
+	if err != nil {
+		return err
+	}
+
+	return loginErr
+}
+
+// recordLoginFailure counts a failed login attempt for the account and client IP address,
+// delaying the next attempt or locking them out once they reach the thresholds
+func recordLoginFailure(ctx context.Context, cache port.CacheRepository, audit port.AuditLogger, subject string, userID uint64, ipAddress string) error {
+	err := countLoginFailure(ctx, cache, audit, subject, accountLockoutThreshold, userID, ipAddress)
+	if err != nil {
+		return err
+	}
+
+	return countLoginFailure(ctx, cache, audit, ipLoginSubject(ipAddress), ipLockoutThreshold, userID, ipAddress)
+}
+
+// countLoginFailure counts a failed login attempt for a subject, delaying or locking it out depending on the count
+func countLoginFailure(ctx context.Context, cache port.CacheRepository, audit port.AuditLogger, subject string, lockoutThreshold int64, userID uint64, ipAddress string) error {
+	failures, err := cache.Increment(ctx, util.GenerateCacheKey("login_failures", subject), loginFailureWindow)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	if failures >= lockoutThreshold {
+		err = cache.Set(ctx, util.GenerateCacheKey("login_lockout", subject), []byte("1"), loginLockoutDuration)
+		if err != nil {
+			return domain.ErrInternal
+		}
+
+		err = cache.Delete(ctx, util.GenerateCacheKey("login_failures", subject))
+		if err != nil {
+			return domain.ErrInternal
+		}
+
+		audit.Record(ctx, &domain.AuditEvent{
+			Type:       domain.AuditLoginLockedOut,
+			UserID:     userID,
+			Subject:    subject,
+			IPAddress:  ipAddress,
+			OccurredAt: time.Now(),
+		})
+
+		return nil
+	}
+
+	if failures >= loginDelayThreshold {
+		delay := loginMaxDelay
+		if shift := failures - loginDelayThreshold; shift < 6 {
+			delay = min(loginBaseDelay<<shift, loginMaxDelay)
+		}
+
+		err = cache.Set(ctx, util.GenerateCacheKey("login_delay", subject), []byte("1"), delay)
+		if err != nil {
+			return domain.ErrInternal
+		}
+	}
+
+	return nil
+}
+
+// resetLoginFailures forgets the failed login attempts of an account after a successful login.
+// Those of the client IP address are kept, so logging in to one account does not allow guessing others
+func resetLoginFailures(ctx context.Context, cache port.CacheRepository, subject string) error {
+	for _, prefix := range []string{"login_failures", "login_delay"} {
+		err := cache.Delete(ctx, util.GenerateCacheKey(prefix, subject))
+		if err != nil {
+			return domain.ErrInternal
+		}
+	}
+
+	return nil
+}
+
+// UnlockLogin lifts the lockout and forgets the failed login attempts of a user's email, PIN and current password
+func (as *AuthService) UnlockLogin(ctx context.Context, userID, actorID uint64) error {
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	for _, subject := range []string{emailLoginSubject(user.Email), pinLoginSubject(user.ID), passwordLoginSubject(user.ID)} {
+		for _, prefix := range []string{"login_failures", "login_delay", "login_lockout"} {
+			err := as.cache.Delete(ctx, util.GenerateCacheKey(prefix, subject))
+			if err != nil {
+				return domain.ErrInternal
+			}
+		}
+	}
+
+	as.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditLoginUnlocked,
+		ActorID:    actorID,
+		UserID:     user.ID,
+		Subject:    emailLoginSubject(user.Email),
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
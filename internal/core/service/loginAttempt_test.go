@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthService_LoginAttempts(t *testing.T) {
+	ctx := context.Background()
+	email := "Cashier@Example.com"
+	ipAddress := "192.0.2.10"
+	device := domain.Device{
+		IPAddress: ipAddress,
+	}
+	errMiss := domain.ErrCacheMiss
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			cache *mock.MockCacheRepository,
+			audit *mock.MockAuditLogger,
+		)
+		expected error
+	}{
+		{
+			desc: "Fail_AccountLockedOut",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq("login_lockout:email:cashier@example.com")).
+					Times(1).
+					Return([]byte("1"), nil)
+			},
+			expected: domain.ErrTooManyLoginAttempts,
+		},
+		{
+			desc: "Fail_IPAddressDelayed",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq("login_delay:ip:192.0.2.10")).
+					Times(1).
+					Return([]byte("1"), nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					AnyTimes().
+					Return(nil, errMiss)
+			},
+			expected: domain.ErrTooManyLoginAttempts,
+		},
+		{
+			desc: "Fail_CacheUnavailable",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq("login_lockout:email:cashier@example.com")).
+					Times(1).
+					Return(nil, errors.New("dial tcp 127.0.0.1:6379: connect: connection refused"))
+			},
+			expected: domain.ErrInternal,
+		},
+		{
+			desc: "Fail_DelayedAfterFailures",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Times(4).
+					Return(nil, errMiss)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:email:cashier@example.com"), gomock.Any()).
+					Times(1).
+					Return(int64(4), nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq("login_delay:email:cashier@example.com"), gomock.Any(), gomock.Eq(2*time.Second)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:ip:192.0.2.10"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			expected: domain.ErrInvalidCredentials,
+		},
+		{
+			desc: "Fail_LockedOutAfterFailures",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Times(4).
+					Return(nil, errMiss)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:email:cashier@example.com"), gomock.Any()).
+					Times(1).
+					Return(int64(10), nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq("login_lockout:email:cashier@example.com"), gomock.Any(), gomock.Eq(15*time.Minute)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq("login_failures:email:cashier@example.com")).
+					Times(1).
+					Return(nil)
+				audit.EXPECT().
+					Record(gomock.Any(), gomock.Cond(func(x any) bool {
+						event := x.(*domain.AuditEvent)
+						return event.Type == domain.AuditLoginLockedOut &&
+							event.Subject == "email:cashier@example.com" &&
+							event.IPAddress == ipAddress
+					})).
+					Times(1)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:ip:192.0.2.10"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			expected: domain.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			audit := mock.NewMockAuditLogger(ctrl)
+
+			tc.mocks(userRepo, cache, audit)
+
+			authService := service.NewAuthService(
+				userRepo,
+				mock.NewMockRefreshTokenRepository(ctrl),
+				mock.NewMockTerminalRepository(ctrl),
+				mock.NewMockRoleRepository(ctrl),
+				mock.NewMockTwoFactorRepository(ctrl),
+				mock.NewMockTokenService(ctrl),
+				mock.NewMockTOTPService(ctrl),
+				cache,
+				audit,
+				false,
+			)
+
+			_, err := authService.Login(ctx, email, "password", device)
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_UnlockLogin(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := &domain.User{
+		ID:    7,
+		Email: "cashier@example.com",
+	}
+	var adminID uint64 = 1
+
+	userRepo := mock.NewMockUserRepository(ctrl)
+	cache := mock.NewMockCacheRepository(ctrl)
+	audit := mock.NewMockAuditLogger(ctrl)
+
+	userRepo.EXPECT().
+		GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+		Times(1).
+		Return(user, nil)
+	for _, subject := range []string{"email:cashier@example.com", "pin:7", "password:7"} {
+		for _, prefix := range []string{"login_failures", "login_delay", "login_lockout"} {
+			cache.EXPECT().
+				Delete(gomock.Any(), gomock.Eq(prefix+":"+subject)).
+				Times(1).
+				Return(nil)
+		}
+	}
+	audit.EXPECT().
+		Record(gomock.Any(), gomock.Cond(func(x any) bool {
+			event := x.(*domain.AuditEvent)
+			return event.Type == domain.AuditLoginUnlocked && event.ActorID == adminID && event.UserID == user.ID
+		})).
+		Times(1)
+
+	authService := service.NewAuthService(
+		userRepo,
+		mock.NewMockRefreshTokenRepository(ctrl),
+		mock.NewMockTerminalRepository(ctrl),
+		mock.NewMockRoleRepository(ctrl),
+		mock.NewMockTwoFactorRepository(ctrl),
+		mock.NewMockTokenService(ctrl),
+		mock.NewMockTOTPService(ctrl),
+		cache,
+		audit,
+		false,
+	)
+
+	err := authService.UnlockLogin(ctx, user.ID, adminID)
+	if err != nil {
+		t.Errorf("expected to get no error; got %q", err)
+	}
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+/**
+ * LotService implements port.LotService interface
+ * and provides an access to the lot and product repositories
+ * and cache service
+ */
+type LotService struct {
+	lotRepo     port.LotRepository
+	productRepo port.ProductRepository
+	cache       port.CacheRepository
+}
+
+// NewLotService creates a new lot service instance
+func NewLotService(lotRepo port.LotRepository, productRepo port.ProductRepository, cache port.CacheRepository) *LotService {
+	return &LotService{
+		lotRepo,
+		productRepo,
+		cache,
+	}
+}
+
+// CreateLot receives a new lot and adds its quantity, converted into the base unit, to the product stock,
+// updating the product average cost with the cost per unit received
+func (ls *LotService) CreateLot(ctx context.Context, lot *domain.Lot, unit string, cost float64) (*domain.Lot, error) {
+	product, err := ls.productRepo.GetProductByID(ctx, lot.ProductID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if !product.TrackLots {
+		return nil, domain.ErrLotNotTracked
+	}
+
+	quantity := lot.Quantity
+
+	lot.Quantity, err = product.BaseQuantity(quantity, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	lot, err = ls.lotRepo.CreateLot(ctx, lot, baseUnitCost(cost, quantity, lot.Quantity))
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	cacheKey := util.GenerateCacheKey("product", product.ID)
+
+	err = ls.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = ls.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return lot, nil
+}
+
+// GetLot retrieves a lot by id
+func (ls *LotService) GetLot(ctx context.Context, id uint64) (*domain.Lot, error) {
+	lot, err := ls.lotRepo.GetLotByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	product, err := ls.productRepo.GetProductByID(ctx, lot.ProductID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	lot.Product = product
+
+	return lot, nil
+}
+
+// ListLots retrieves a list of lots of a product
+func (ls *LotService) ListLots(ctx context.Context, productID, skip, limit uint64) ([]domain.Lot, error) {
+	product, err := ls.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	lots, err := ls.lotRepo.ListLots(ctx, productID, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	for i := range lots {
+		lots[i].Product = product
+	}
+
+	return lots, nil
+}
+
+// ListExpiringLots retrieves a list of lots with remaining quantity that expire within the given number of days,
+// including lots that have already expired
+func (ls *LotService) ListExpiringLots(ctx context.Context, days, skip, limit uint64) ([]domain.Lot, error) {
+	before := time.Now().AddDate(0, 0, int(days))
+
+	lots, err := ls.lotRepo.ListExpiringLots(ctx, before, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	for i, lot := range lots {
+		product, err := ls.productRepo.GetProductByID(ctx, lot.ProductID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		lots[i].Product = product
+	}
+
+	return lots, nil
+}
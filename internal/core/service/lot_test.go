@@ -0,0 +1,461 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createLotTestedInput struct {
+	lot  *domain.Lot
+	unit string
+	cost float64
+}
+
+type createLotExpectedOutput struct {
+	lot *domain.Lot
+	err error
+}
+
+func TestLotService_CreateLot(t *testing.T) {
+	ctx := context.Background()
+	productID := gofakeit.Uint64()
+	product := &domain.Product{
+		ID:        productID,
+		Name:      gofakeit.ProductName(),
+		TrackLots: true,
+		Unit:      domain.DefaultUnit,
+		Units: []domain.ProductUnit{
+			{Name: "carton", Factor: 12},
+		},
+	}
+	untrackedProduct := &domain.Product{
+		ID:   productID,
+		Name: product.Name,
+	}
+
+	lotNumber := gofakeit.LetterN(10)
+	lotExpiresAt := gofakeit.FutureDate()
+	lotQuantity := float64(gofakeit.Uint16())
+
+	lotInput := &domain.Lot{
+		ProductID: productID,
+		LotNumber: lotNumber,
+		ExpiresAt: lotExpiresAt,
+		Quantity:  lotQuantity,
+	}
+	lotOutput := &domain.Lot{
+		ID:        gofakeit.Uint64(),
+		ProductID: productID,
+		LotNumber: lotNumber,
+		ExpiresAt: lotExpiresAt,
+		Quantity:  lotQuantity,
+		CreatedAt: gofakeit.Date(),
+		UpdatedAt: gofakeit.Date(),
+	}
+
+	cartonLotInput := &domain.Lot{
+		ProductID: productID,
+		LotNumber: lotNumber,
+		ExpiresAt: lotExpiresAt,
+		Quantity:  2,
+	}
+	cartonLotReceived := &domain.Lot{
+		ProductID: productID,
+		LotNumber: lotNumber,
+		ExpiresAt: lotExpiresAt,
+		Quantity:  24,
+	}
+
+	cacheKey := util.GenerateCacheKey("product", productID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			lotRepo *mock.MockLotRepository,
+			productRepo *mock.MockProductRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    createLotTestedInput
+		expected createLotExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				lotRepo.EXPECT().
+					CreateLot(gomock.Any(), gomock.Eq(lotInput), gomock.Eq(float64(0))).
+					Times(1).
+					Return(lotOutput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: createLotTestedInput{
+				lot: lotInput,
+			},
+			expected: createLotExpectedOutput{
+				lot: lotOutput,
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_NotFoundGetProduct",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: createLotTestedInput{
+				lot: lotInput,
+			},
+			expected: createLotExpectedOutput{
+				lot: nil,
+				err: domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_LotNotTracked",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(untrackedProduct, nil)
+			},
+			input: createLotTestedInput{
+				lot: lotInput,
+			},
+			expected: createLotExpectedOutput{
+				lot: nil,
+				err: domain.ErrLotNotTracked,
+			},
+		},
+		{
+			desc: "Success_Unit",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				lotRepo.EXPECT().
+					CreateLot(gomock.Any(), gomock.Eq(cartonLotReceived), gomock.Eq(float64(7000))).
+					Times(1).
+					Return(lotOutput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: createLotTestedInput{
+				lot:  cartonLotInput,
+				unit: "carton",
+				cost: 84000,
+			},
+			expected: createLotExpectedOutput{
+				lot: lotOutput,
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidUnit",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: createLotTestedInput{
+				lot:  cartonLotInput,
+				unit: "pallet",
+			},
+			expected: createLotExpectedOutput{
+				lot: nil,
+				err: domain.ErrInvalidUnit,
+			},
+		},
+		{
+			desc: "Fail_DuplicateData",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				lotRepo.EXPECT().
+					CreateLot(gomock.Any(), gomock.Eq(lotInput), gomock.Eq(float64(0))).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: createLotTestedInput{
+				lot: lotInput,
+			},
+			expected: createLotExpectedOutput{
+				lot: nil,
+				err: domain.ErrConflictingData,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				lotRepo.EXPECT().
+					CreateLot(gomock.Any(), gomock.Eq(lotInput), gomock.Eq(float64(0))).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: createLotTestedInput{
+				lot: lotInput,
+			},
+			expected: createLotExpectedOutput{
+				lot: nil,
+				err: domain.ErrInternal,
+			},
+		},
+		{
+			desc: "Fail_InternalErrorDeleteCache",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				lotRepo.EXPECT().
+					CreateLot(gomock.Any(), gomock.Eq(lotInput), gomock.Eq(float64(0))).
+					Times(1).
+					Return(lotOutput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(domain.ErrInternal)
+			},
+			input: createLotTestedInput{
+				lot: lotInput,
+			},
+			expected: createLotExpectedOutput{
+				lot: nil,
+				err: domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			lotRepo := mock.NewMockLotRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(lotRepo, productRepo, cache)
+
+			lotService := service.NewLotService(lotRepo, productRepo, cache)
+
+			input := *tc.input.lot
+
+			lot, err := lotService.CreateLot(ctx, &input, tc.input.unit, tc.input.cost)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.lot, lot, "Lot mismatch")
+		})
+	}
+}
+
+type listExpiringLotsTestedInput struct {
+	days  uint64
+	skip  uint64
+	limit uint64
+}
+
+type listExpiringLotsExpectedOutput struct {
+	lots []domain.Lot
+	err  error
+}
+
+func TestLotService_ListExpiringLots(t *testing.T) {
+	ctx := context.Background()
+	days := uint64(7)
+	skip := gofakeit.Uint64()
+	limit := gofakeit.Uint64()
+
+	product := &domain.Product{
+		ID:        gofakeit.Uint64(),
+		Name:      gofakeit.ProductName(),
+		TrackLots: true,
+	}
+
+	var lots []domain.Lot
+	var lotsWithProduct []domain.Lot
+
+	for i := 0; i < 3; i++ {
+		lot := domain.Lot{
+			ID:        gofakeit.Uint64(),
+			ProductID: product.ID,
+			LotNumber: gofakeit.LetterN(10),
+			ExpiresAt: time.Now().AddDate(0, 0, i),
+			Quantity:  float64(gofakeit.Uint16()),
+		}
+		lots = append(lots, lot)
+
+		lot.Product = product
+		lotsWithProduct = append(lotsWithProduct, lot)
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			lotRepo *mock.MockLotRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		input    listExpiringLotsTestedInput
+		expected listExpiringLotsExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				lotRepo.EXPECT().
+					ListExpiringLots(gomock.Any(), gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					Times(1).
+					Return(lots, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(len(lots)).
+					Return(product, nil)
+			},
+			input: listExpiringLotsTestedInput{
+				days:  days,
+				skip:  skip,
+				limit: limit,
+			},
+			expected: listExpiringLotsExpectedOutput{
+				lots: lotsWithProduct,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				lotRepo.EXPECT().
+					ListExpiringLots(gomock.Any(), gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: listExpiringLotsTestedInput{
+				days:  days,
+				skip:  skip,
+				limit: limit,
+			},
+			expected: listExpiringLotsExpectedOutput{
+				lots: nil,
+				err:  domain.ErrInternal,
+			},
+		},
+		{
+			desc: "Fail_NotFoundGetProduct",
+			mocks: func(
+				lotRepo *mock.MockLotRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				lotRepo.EXPECT().
+					ListExpiringLots(gomock.Any(), gomock.Any(), gomock.Eq(skip), gomock.Eq(limit)).
+					Times(1).
+					Return(lots, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: listExpiringLotsTestedInput{
+				days:  days,
+				skip:  skip,
+				limit: limit,
+			},
+			expected: listExpiringLotsExpectedOutput{
+				lots: nil,
+				err:  domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			lotRepo := mock.NewMockLotRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(lotRepo, productRepo)
+
+			lotService := service.NewLotService(lotRepo, productRepo, cache)
+
+			lots, err := lotService.ListExpiringLots(ctx, tc.input.days, tc.input.skip, tc.input.limit)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.lots, lots, "Lots mismatch")
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+/**
+ * ModifierService implements port.ModifierService interface
+ * and provides an access to the modifier and product repositories
+ */
+type ModifierService struct {
+	modifierRepo port.ModifierRepository
+	productRepo  port.ProductRepository
+}
+
+// NewModifierService creates a new modifier service instance
+func NewModifierService(modifierRepo port.ModifierRepository, productRepo port.ProductRepository) *ModifierService {
+	return &ModifierService{
+		modifierRepo,
+		productRepo,
+	}
+}
+
+// CreateModifierGroup creates a new modifier group with its modifiers for a product. The group must allow
+// at least one selection, no fewer than its minimum and no more than its modifiers, and the ingredients
+// consumed by its modifiers must be products counted in stock on their own
+func (ms *ModifierService) CreateModifierGroup(ctx context.Context, group *domain.ModifierGroup) (*domain.ModifierGroup, error) {
+	_, err := ms.productRepo.GetProductByID(ctx, group.ProductID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	invalidLimits := group.MaxSelections == 0 ||
+		group.MinSelections > group.MaxSelections ||
+		group.MaxSelections > uint64(len(group.Modifiers))
+	if invalidLimits {
+		return nil, domain.ErrInvalidModifierGroup
+	}
+
+	for i, modifier := range group.Modifiers {
+		if modifier.IngredientID == 0 {
+			group.Modifiers[i].IngredientQuantity = 0
+			continue
+		}
+
+		ingredient, err := ms.productRepo.GetProductByID(ctx, modifier.IngredientID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		if ingredient.TrackSerials || ingredient.IsBundle || len(ingredient.Options) > 0 {
+			return nil, domain.ErrInvalidIngredient
+		}
+	}
+
+	group, err = ms.modifierRepo.CreateModifierGroup(ctx, group)
+	if err != nil {
+		if err == domain.ErrConflictingData || err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return group, nil
+}
+
+// ListModifierGroups retrieves the modifier groups of a product with their modifiers
+func (ms *ModifierService) ListModifierGroups(ctx context.Context, productID uint64) ([]domain.ModifierGroup, error) {
+	_, err := ms.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	groups, err := ms.modifierRepo.ListModifierGroups(ctx, productID)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return groups, nil
+}
+
+// DeleteModifierGroup deletes a modifier group of a product with its modifiers.
+// Orders keep the names and prices of the modifiers chosen on them
+func (ms *ModifierService) DeleteModifierGroup(ctx context.Context, productID, id uint64) error {
+	group, err := ms.modifierRepo.GetModifierGroupByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	if group.ProductID != productID {
+		return domain.ErrDataNotFound
+	}
+
+	err = ms.modifierRepo.DeleteModifierGroup(ctx, id)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// chooseModifiers checks the modifiers chosen on an order product against the modifier groups of its product,
+// returning them with the names and prices they are ordered at, ordered by group
+func chooseModifiers(ctx context.Context, modifierRepo port.ModifierRepository, productID uint64, chosen []domain.OrderModifier) ([]domain.OrderModifier, error) {
+	groups, err := modifierRepo.ListModifierGroups(ctx, productID)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	chosenIDs := make(map[uint64]bool)
+	for _, modifier := range chosen {
+		if chosenIDs[modifier.ModifierID] {
+			return nil, domain.ErrInvalidModifiers
+		}
+		chosenIDs[modifier.ModifierID] = true
+	}
+
+	var modifiers []domain.OrderModifier
+
+	for _, group := range groups {
+		var selections uint64
+
+		for _, modifier := range group.Modifiers {
+			if !chosenIDs[modifier.ID] {
+				continue
+			}
+
+			selections++
+			delete(chosenIDs, modifier.ID)
+
+			modifiers = append(modifiers, domain.OrderModifier{
+				ModifierID:         modifier.ID,
+				GroupName:          group.Name,
+				Name:               modifier.Name,
+				Price:              modifier.Price,
+				IngredientID:       modifier.IngredientID,
+				IngredientQuantity: modifier.IngredientQuantity,
+			})
+		}
+
+		if selections < group.MinSelections || selections > group.MaxSelections {
+			return nil, domain.ErrInvalidModifiers
+		}
+	}
+
+	if len(chosenIDs) > 0 {
+		return nil, domain.ErrInvalidModifiers
+	}
+
+	return modifiers, nil
+}
@@ -0,0 +1,312 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createModifierGroupTestedInput struct {
+	group *domain.ModifierGroup
+}
+
+type createModifierGroupExpectedOutput struct {
+	group *domain.ModifierGroup
+	err   error
+}
+
+func TestModifierService_CreateModifierGroup(t *testing.T) {
+	ctx := context.Background()
+
+	product := &domain.Product{
+		ID:    gofakeit.Uint64(),
+		Name:  "Latte",
+		Unit:  domain.DefaultUnit,
+		Price: 30000,
+	}
+	ingredient := &domain.Product{
+		ID:         gofakeit.Uint64(),
+		Name:       "Oat Milk",
+		Unit:       "l",
+		Fractional: true,
+	}
+	serialIngredient := &domain.Product{
+		ID:           gofakeit.Uint64(),
+		Name:         "Espresso Machine",
+		Unit:         domain.DefaultUnit,
+		TrackSerials: true,
+	}
+
+	newGroup := func(min, max uint64, ingredientID uint64) *domain.ModifierGroup {
+		return &domain.ModifierGroup{
+			ProductID:     product.ID,
+			Name:          "Milk",
+			MinSelections: min,
+			MaxSelections: max,
+			Modifiers: []domain.Modifier{
+				{Name: "Oat milk", Price: 5000, IngredientID: ingredientID, IngredientQuantity: 0.2},
+				{Name: "Whole milk", Price: 0},
+			},
+		}
+	}
+	createdGroup := newGroup(1, 1, ingredient.ID)
+	createdGroup.ID = gofakeit.Uint64()
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			modifierRepo *mock.MockModifierRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		input    createModifierGroupTestedInput
+		expected createModifierGroupExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(ingredient.ID)).
+					Times(1).
+					Return(ingredient, nil)
+				modifierRepo.EXPECT().
+					CreateModifierGroup(gomock.Any(), gomock.Eq(newGroup(1, 1, ingredient.ID))).
+					Times(1).
+					Return(createdGroup, nil)
+			},
+			input: createModifierGroupTestedInput{
+				group: newGroup(1, 1, ingredient.ID),
+			},
+			expected: createModifierGroupExpectedOutput{
+				group: createdGroup,
+				err:   nil,
+			},
+		},
+		{
+			desc: "Fail_ProductNotFound",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: createModifierGroupTestedInput{
+				group: newGroup(1, 1, 0),
+			},
+			expected: createModifierGroupExpectedOutput{
+				group: nil,
+				err:   domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_MinAboveMax",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: createModifierGroupTestedInput{
+				group: newGroup(2, 1, 0),
+			},
+			expected: createModifierGroupExpectedOutput{
+				group: nil,
+				err:   domain.ErrInvalidModifierGroup,
+			},
+		},
+		{
+			desc: "Fail_MaxAboveModifiers",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: createModifierGroupTestedInput{
+				group: newGroup(0, 3, 0),
+			},
+			expected: createModifierGroupExpectedOutput{
+				group: nil,
+				err:   domain.ErrInvalidModifierGroup,
+			},
+		},
+		{
+			desc: "Fail_SerialTrackedIngredient",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(serialIngredient.ID)).
+					Times(1).
+					Return(serialIngredient, nil)
+			},
+			input: createModifierGroupTestedInput{
+				group: newGroup(0, 2, serialIngredient.ID),
+			},
+			expected: createModifierGroupExpectedOutput{
+				group: nil,
+				err:   domain.ErrInvalidIngredient,
+			},
+		},
+		{
+			desc: "Fail_DuplicateName",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				modifierRepo.EXPECT().
+					CreateModifierGroup(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: createModifierGroupTestedInput{
+				group: newGroup(0, 2, 0),
+			},
+			expected: createModifierGroupExpectedOutput{
+				group: nil,
+				err:   domain.ErrConflictingData,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			modifierRepo := mock.NewMockModifierRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+
+			tc.mocks(modifierRepo, productRepo)
+
+			modifierService := service.NewModifierService(modifierRepo, productRepo)
+
+			group, err := modifierService.CreateModifierGroup(ctx, tc.input.group)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.group, group, "Modifier group mismatch")
+		})
+	}
+}
+
+type deleteModifierGroupTestedInput struct {
+	productID uint64
+	id        uint64
+}
+
+type deleteModifierGroupExpectedOutput struct {
+	err error
+}
+
+func TestModifierService_DeleteModifierGroup(t *testing.T) {
+	ctx := context.Background()
+
+	group := &domain.ModifierGroup{
+		ID:            gofakeit.Uint64(),
+		ProductID:     gofakeit.Uint64(),
+		Name:          "Milk",
+		MaxSelections: 1,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			modifierRepo *mock.MockModifierRepository,
+		)
+		input    deleteModifierGroupTestedInput
+		expected deleteModifierGroupExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+			) {
+				modifierRepo.EXPECT().
+					GetModifierGroupByID(gomock.Any(), gomock.Eq(group.ID)).
+					Times(1).
+					Return(group, nil)
+				modifierRepo.EXPECT().
+					DeleteModifierGroup(gomock.Any(), gomock.Eq(group.ID)).
+					Times(1).
+					Return(nil)
+			},
+			input: deleteModifierGroupTestedInput{
+				productID: group.ProductID,
+				id:        group.ID,
+			},
+			expected: deleteModifierGroupExpectedOutput{
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_OtherProduct",
+			mocks: func(
+				modifierRepo *mock.MockModifierRepository,
+			) {
+				modifierRepo.EXPECT().
+					GetModifierGroupByID(gomock.Any(), gomock.Eq(group.ID)).
+					Times(1).
+					Return(group, nil)
+			},
+			input: deleteModifierGroupTestedInput{
+				productID: group.ProductID + 1,
+				id:        group.ID,
+			},
+			expected: deleteModifierGroupExpectedOutput{
+				err: domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			modifierRepo := mock.NewMockModifierRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+
+			tc.mocks(modifierRepo)
+
+			modifierService := service.NewModifierService(modifierRepo, productRepo)
+
+			err := modifierService.DeleteModifierGroup(ctx, tc.input.productID, tc.input.id)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+		})
+	}
+}
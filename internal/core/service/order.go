@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port"
@@ -11,33 +12,50 @@ import (
 /**
  * OrderService implements port.OrderService, port.ProductService,
  * port.UserService and port.PaymentService interfaces and provides
- * an access to the order, product, user and payment repositories
- * and cache service
+ * an access to the order, product, user, payment, price list and
+ * modifier repositories, ticket renderer and cache service.
+ * Orders are priced in the time zone of the store
  */
 type OrderService struct {
-	orderRepo    port.OrderRepository
-	productRepo  port.ProductRepository
-	categoryRepo port.CategoryRepository
-	userRepo     port.UserRepository
-	paymentRepo  port.PaymentRepository
-	cache        port.CacheRepository
+	orderRepo     port.OrderRepository
+	productRepo   port.ProductRepository
+	categoryRepo  port.CategoryRepository
+	userRepo      port.UserRepository
+	paymentRepo   port.PaymentRepository
+	priceListRepo port.PriceListRepository
+	modifierRepo  port.ModifierRepository
+	renderer      port.TicketRenderer
+	cache         port.CacheRepository
+	location      *time.Location
 }
 
 // NewOrderService creates a new order service instance
-func NewOrderService(orderRepo port.OrderRepository, productRepo port.ProductRepository, categoryRepo port.CategoryRepository, userRepo port.UserRepository, paymentRepo port.PaymentRepository, cache port.CacheRepository) *OrderService {
+func NewOrderService(orderRepo port.OrderRepository, productRepo port.ProductRepository, categoryRepo port.CategoryRepository, userRepo port.UserRepository, paymentRepo port.PaymentRepository, priceListRepo port.PriceListRepository, modifierRepo port.ModifierRepository, renderer port.TicketRenderer, cache port.CacheRepository, location *time.Location) *OrderService {
 	return &OrderService{
 		orderRepo,
 		productRepo,
 		categoryRepo,
 		userRepo,
 		paymentRepo,
+		priceListRepo,
+		modifierRepo,
+		renderer,
 		cache,
+		location,
 	}
 }
 
-// CreateOrder creates a new order
+// CreateOrder creates a new order, pricing each product with the price list that applies
+// to the customer group of the order at the time of the order, if any, plus the modifiers chosen on it
 func (os *OrderService) CreateOrder(ctx context.Context, order *domain.Order) (*domain.Order, error) {
 	var totalPrice float64
+
+	if order.CustomerGroup == "" {
+		order.CustomerGroup = domain.CustomerRetail
+	}
+
+	now := time.Now()
+
 	for i, orderProduct := range order.Products {
 		product, err := os.productRepo.GetProductByID(ctx, orderProduct.ProductID)
 		if err != nil {
@@ -47,11 +65,60 @@ func (os *OrderService) CreateOrder(ctx context.Context, order *domain.Order) (*
 			return nil, domain.ErrInternal
 		}
 
-		if product.Stock < orderProduct.Quantity {
+		if len(product.Options) > 0 {
+			return nil, domain.ErrVariantRequired
+		}
+
+		if product.IsBundle {
+			err = loadBundle(ctx, os.productRepo, product)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		baseQuantity, err := product.BaseQuantity(orderProduct.Quantity, orderProduct.Unit)
+		if err != nil {
+			return nil, err
+		}
+
+		if product.Stock < baseQuantity {
 			return nil, domain.ErrInsufficientStock
 		}
 
-		order.Products[i].TotalPrice = product.Price * float64(orderProduct.Quantity)
+		if !product.TrackSerials && len(orderProduct.SerialNumbers) > 0 {
+			return nil, domain.ErrSerialNotTracked
+		}
+
+		if product.TrackSerials {
+			mismatch := float64(len(orderProduct.SerialNumbers)) != baseQuantity ||
+				hasDuplicates(orderProduct.SerialNumbers)
+			if mismatch {
+				return nil, domain.ErrSerialNumberMismatch
+			}
+		}
+
+		if orderProduct.Unit == "" {
+			order.Products[i].Unit = product.Unit
+		}
+
+		unitPrice, priceList, err := resolvePrice(ctx, os.priceListRepo, product, order.CustomerGroup, now.In(os.location))
+		if err != nil {
+			return nil, err
+		}
+
+		if priceList != nil {
+			order.Products[i].PriceListID = priceList.ID
+		}
+
+		modifiers, err := chooseModifiers(ctx, os.modifierRepo, product.ID, orderProduct.Modifiers)
+		if err != nil {
+			return nil, err
+		}
+
+		order.Products[i].Modifiers = modifiers
+		order.Products[i].BaseQuantity = baseQuantity
+		order.Products[i].UnitPrice = unitPrice
+		order.Products[i].TotalPrice = (unitPrice + order.Products[i].ModifiersPrice()) * baseQuantity
 		totalPrice += order.Products[i].TotalPrice
 	}
 
@@ -64,6 +131,9 @@ func (os *OrderService) CreateOrder(ctx context.Context, order *domain.Order) (*
 
 	order, err := os.orderRepo.CreateOrder(ctx, order)
 	if err != nil {
+		if err == domain.ErrInsufficientStock || err == domain.ErrExpiredStock || err == domain.ErrSerialNotInStock {
+			return nil, err
+		}
 		return nil, domain.ErrInternal
 	}
 
@@ -278,3 +348,33 @@ func (os *OrderService) ListOrders(ctx context.Context, skip, limit uint64) ([]d
 
 	return orders, nil
 }
+
+// GetReceipt renders the customer receipt of an order by id
+func (os *OrderService) GetReceipt(ctx context.Context, id uint64) (*domain.Ticket, error) {
+	order, err := os.GetOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := os.renderer.RenderReceipt(order)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return ticket, nil
+}
+
+// GetKitchenTicket renders the kitchen ticket of an order by id
+func (os *OrderService) GetKitchenTicket(ctx context.Context, id uint64) (*domain.Ticket, error) {
+	order, err := os.GetOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := os.renderer.RenderKitchenTicket(order)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return ticket, nil
+}
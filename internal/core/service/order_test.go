@@ -0,0 +1,278 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createOrderTestedInput struct {
+	modifierIDs []uint64
+}
+
+type createOrderExpectedOutput struct {
+	totalPrice float64
+	modifiers  []domain.OrderModifier
+	err        error
+}
+
+func TestOrderService_CreateOrder(t *testing.T) {
+	ctx := context.Background()
+
+	category := &domain.Category{
+		ID:   gofakeit.Uint64(),
+		Name: "Coffee",
+	}
+	product := &domain.Product{
+		ID:         gofakeit.Uint64(),
+		CategoryID: category.ID,
+		Name:       "Latte",
+		Unit:       domain.DefaultUnit,
+		Price:      30000,
+		Stock:      100,
+	}
+	user := &domain.User{
+		ID:   gofakeit.Uint64(),
+		Name: gofakeit.Name(),
+	}
+	payment := &domain.Payment{
+		ID:   gofakeit.Uint64(),
+		Name: "Tunai",
+		Type: domain.Cash,
+	}
+
+	oatMilk := domain.Modifier{ID: 1, Name: "Oat milk", Price: 5000, IngredientID: gofakeit.Uint64(), IngredientQuantity: 0.2}
+	wholeMilk := domain.Modifier{ID: 2, Name: "Whole milk"}
+	extraShot := domain.Modifier{ID: 3, Name: "Extra shot", Price: 8000}
+	lessSugar := domain.Modifier{ID: 4, Name: "Less sugar"}
+	groups := []domain.ModifierGroup{
+		{ID: 1, ProductID: product.ID, Name: "Milk", MinSelections: 1, MaxSelections: 1, Modifiers: []domain.Modifier{oatMilk, wholeMilk}},
+		{ID: 2, ProductID: product.ID, Name: "Extras", MinSelections: 0, MaxSelections: 2, Modifiers: []domain.Modifier{extraShot, lessSugar}},
+	}
+
+	validOrder := func(
+		productRepo *mock.MockProductRepository,
+		priceListRepo *mock.MockPriceListRepository,
+		modifierRepo *mock.MockModifierRepository,
+	) {
+		productRepo.EXPECT().
+			GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+			AnyTimes().
+			Return(product, nil)
+		priceListRepo.EXPECT().
+			ListPriceListsByProduct(gomock.Any(), gomock.Eq(product.ID)).
+			Times(1).
+			Return(nil, nil)
+		modifierRepo.EXPECT().
+			ListModifierGroups(gomock.Any(), gomock.Eq(product.ID)).
+			Times(1).
+			Return(groups, nil)
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			orderRepo *mock.MockOrderRepository,
+			productRepo *mock.MockProductRepository,
+			categoryRepo *mock.MockCategoryRepository,
+			userRepo *mock.MockUserRepository,
+			paymentRepo *mock.MockPaymentRepository,
+			priceListRepo *mock.MockPriceListRepository,
+			modifierRepo *mock.MockModifierRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    createOrderTestedInput
+		expected createOrderExpectedOutput
+	}{
+		{
+			desc: "Success_WithModifiers",
+			mocks: func(
+				orderRepo *mock.MockOrderRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				userRepo *mock.MockUserRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				priceListRepo *mock.MockPriceListRepository,
+				modifierRepo *mock.MockModifierRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				validOrder(productRepo, priceListRepo, modifierRepo)
+				orderRepo.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, order *domain.Order) (*domain.Order, error) {
+						return order, nil
+					})
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				paymentRepo.EXPECT().
+					GetPaymentByID(gomock.Any(), gomock.Eq(payment.ID)).
+					Times(1).
+					Return(payment, nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(category.ID)).
+					Times(1).
+					Return(category, nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("orders:*")).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			input: createOrderTestedInput{
+				modifierIDs: []uint64{extraShot.ID, oatMilk.ID},
+			},
+			expected: createOrderExpectedOutput{
+				totalPrice: 2 * (30000 + 5000 + 8000),
+				modifiers: []domain.OrderModifier{
+					{ModifierID: oatMilk.ID, GroupName: "Milk", Name: oatMilk.Name, Price: oatMilk.Price, IngredientID: oatMilk.IngredientID, IngredientQuantity: oatMilk.IngredientQuantity},
+					{ModifierID: extraShot.ID, GroupName: "Extras", Name: extraShot.Name, Price: extraShot.Price},
+				},
+				err: nil,
+			},
+		},
+		{
+			desc: "Fail_RequiredGroupMissing",
+			mocks: func(
+				orderRepo *mock.MockOrderRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				userRepo *mock.MockUserRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				priceListRepo *mock.MockPriceListRepository,
+				modifierRepo *mock.MockModifierRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				validOrder(productRepo, priceListRepo, modifierRepo)
+			},
+			input: createOrderTestedInput{
+				modifierIDs: []uint64{extraShot.ID},
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInvalidModifiers,
+			},
+		},
+		{
+			desc: "Fail_TooManySelections",
+			mocks: func(
+				orderRepo *mock.MockOrderRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				userRepo *mock.MockUserRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				priceListRepo *mock.MockPriceListRepository,
+				modifierRepo *mock.MockModifierRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				validOrder(productRepo, priceListRepo, modifierRepo)
+			},
+			input: createOrderTestedInput{
+				modifierIDs: []uint64{oatMilk.ID, wholeMilk.ID},
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInvalidModifiers,
+			},
+		},
+		{
+			desc: "Fail_UnknownModifier",
+			mocks: func(
+				orderRepo *mock.MockOrderRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				userRepo *mock.MockUserRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				priceListRepo *mock.MockPriceListRepository,
+				modifierRepo *mock.MockModifierRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				validOrder(productRepo, priceListRepo, modifierRepo)
+			},
+			input: createOrderTestedInput{
+				modifierIDs: []uint64{wholeMilk.ID, 99},
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInvalidModifiers,
+			},
+		},
+		{
+			desc: "Fail_DuplicateModifier",
+			mocks: func(
+				orderRepo *mock.MockOrderRepository,
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				userRepo *mock.MockUserRepository,
+				paymentRepo *mock.MockPaymentRepository,
+				priceListRepo *mock.MockPriceListRepository,
+				modifierRepo *mock.MockModifierRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				validOrder(productRepo, priceListRepo, modifierRepo)
+			},
+			input: createOrderTestedInput{
+				modifierIDs: []uint64{wholeMilk.ID, extraShot.ID, extraShot.ID},
+			},
+			expected: createOrderExpectedOutput{
+				err: domain.ErrInvalidModifiers,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			orderRepo := mock.NewMockOrderRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			userRepo := mock.NewMockUserRepository(ctrl)
+			paymentRepo := mock.NewMockPaymentRepository(ctrl)
+			priceListRepo := mock.NewMockPriceListRepository(ctrl)
+			modifierRepo := mock.NewMockModifierRepository(ctrl)
+			renderer := mock.NewMockTicketRenderer(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(orderRepo, productRepo, categoryRepo, userRepo, paymentRepo, priceListRepo, modifierRepo, cache)
+
+			orderService := service.NewOrderService(orderRepo, productRepo, categoryRepo, userRepo, paymentRepo, priceListRepo, modifierRepo, renderer, cache, time.UTC)
+
+			var modifiers []domain.OrderModifier
+			for _, modifierID := range tc.input.modifierIDs {
+				modifiers = append(modifiers, domain.OrderModifier{ModifierID: modifierID})
+			}
+
+			order := &domain.Order{
+				UserID:       user.ID,
+				PaymentID:    payment.ID,
+				CustomerName: gofakeit.Name(),
+				TotalPaid:    100000,
+				Products: []domain.OrderProduct{
+					{ProductID: product.ID, Quantity: 2, Modifiers: modifiers},
+				},
+			}
+
+			result, err := orderService.CreateOrder(ctx, order)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+
+			if tc.expected.err == nil {
+				assert.Equal(t, tc.expected.totalPrice, result.TotalPrice, "Total price mismatch")
+				assert.Equal(t, tc.expected.modifiers, result.Products[0].Modifiers, "Modifiers mismatch")
+			}
+		})
+	}
+}
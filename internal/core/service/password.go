@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+const (
+	// passwordResetInterval is how long a password reset for an email is not sent again after one is requested
+	passwordResetInterval = time.Minute
+	// passwordResetTimeout is how long sending a password reset may take, so that a hung mail server
+	// cannot pile up the goroutines sending them
+	passwordResetTimeout = time.Minute
+)
+
+/**
+ * PasswordService implements port.PasswordService interface
+ * and provides an access to the user, password reset and refresh token repositories,
+ * token service, notifier, cache service and audit logger
+ */
+type PasswordService struct {
+	repo        port.UserRepository
+	resetRepo   port.PasswordResetRepository
+	refreshRepo port.RefreshTokenRepository
+	ts          port.TokenService
+	notifier    port.Notifier
+	cache       port.CacheRepository
+	audit       port.AuditLogger
+}
+
+// NewPasswordService creates a new password service instance
+func NewPasswordService(repo port.UserRepository, resetRepo port.PasswordResetRepository, refreshRepo port.RefreshTokenRepository, ts port.TokenService, notifier port.Notifier, cache port.CacheRepository, audit port.AuditLogger) *PasswordService {
+	return &PasswordService{
+		repo,
+		resetRepo,
+		refreshRepo,
+		ts,
+		notifier,
+		cache,
+		audit,
+	}
+}
+
+// ChangePassword replaces the password of a user if the current password is correct,
+// revoking the user's tokens and pending password resets.
+// Incorrect current passwords count as failed login attempts for the user and the client IP address,
+// so that a stolen access token cannot be used to guess the password
+func (ps *PasswordService) ChangePassword(ctx context.Context, userID uint64, currentPassword, newPassword, ipAddress string) error {
+	subject := passwordLoginSubject(userID)
+
+	err := checkLoginAttempts(ctx, ps.cache, subject, ipAddress)
+	if err != nil {
+		return err
+	}
+
+	user, err := ps.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	err = util.ComparePassword(currentPassword, user.Password)
+	if err != nil {
+		err = recordLoginFailure(ctx, ps.cache, ps.audit, subject, userID, ipAddress)
+		if err != nil {
+			return err
+		}
+		return domain.ErrIncorrectPassword
+	}
+
+	err = resetLoginFailures(ctx, ps.cache, subject)
+	if err != nil {
+		return err
+	}
+
+	err = ps.setPassword(ctx, userID, newPassword)
+	if err != nil {
+		return err
+	}
+
+	ps.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditPasswordChanged,
+		ActorID:    userID,
+		UserID:     userID,
+		Subject:    user.Email,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
+
+// RequestPasswordReset sends a single-use password reset token to the user with the email.
+// It succeeds without sending anything if there is no such user or a reset was requested for the email recently,
+// and sends the token after returning, so that neither its result nor its duration reveals which emails are registered
+func (ps *PasswordService) RequestPasswordReset(ctx context.Context, email string) error {
+	cacheKey := util.GenerateCacheKey("password_reset", strings.ToLower(email))
+	requests, err := ps.cache.Increment(ctx, cacheKey, passwordResetInterval)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	if requests > 1 {
+		return nil
+	}
+
+	go func() {
+		// The request, and its context, may be done before the token is sent
+		ctx, cancel := context.WithTimeout(context.Background(), passwordResetTimeout)
+		defer cancel()
+
+		err := ps.sendPasswordReset(ctx, email)
+		if err != nil {
+			slog.Error("Error sending password reset", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// sendPasswordReset creates a password reset token for the user with the email, if there is one, and sends it to the user
+func (ps *PasswordService) sendPasswordReset(ctx context.Context, email string) error {
+	user, err := ps.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil
+		}
+		return err
+	}
+
+	token, expiresAt, err := ps.ts.CreatePasswordResetToken()
+	if err != nil {
+		return err
+	}
+
+	resetToken, err := ps.resetRepo.CreatePasswordResetToken(ctx, &domain.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: util.HashToken(token),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	resetToken.Token = token
+
+	err = ps.notifier.SendPasswordReset(ctx, user, resetToken)
+	if err != nil {
+		return err
+	}
+
+	ps.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditPasswordResetRequested,
+		UserID:     user.ID,
+		Subject:    user.Email,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
+
+// ResetPassword replaces the password of the user a password reset token was sent to, using up the token
+// and revoking the user's tokens and other pending password resets
+func (ps *PasswordService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	resetToken, err := ps.resetRepo.GetPasswordResetTokenByHash(ctx, util.HashToken(token))
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return domain.ErrInvalidResetToken
+		}
+		return domain.ErrInternal
+	}
+
+	now := time.Now()
+
+	if !resetToken.IsActive(now) {
+		return domain.ErrInvalidResetToken
+	}
+
+	err = ps.resetRepo.UsePasswordResetToken(ctx, resetToken.ID, now)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			// Used by a concurrent request since it was read
+			return domain.ErrInvalidResetToken
+		}
+		return domain.ErrInternal
+	}
+
+	err = ps.setPassword(ctx, resetToken.UserID, newPassword)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return domain.ErrInvalidResetToken
+		}
+		return err
+	}
+
+	ps.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditPasswordReset,
+		UserID:     resetToken.UserID,
+		OccurredAt: now,
+	})
+
+	return nil
+}
+
+// setPassword hashes and sets the password of a user, then revokes the user's tokens and pending password resets
+func (ps *PasswordService) setPassword(ctx context.Context, userID uint64, password string) error {
+	hashedPassword, err := util.HashPassword(password)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	_, err = ps.repo.UpdateUser(ctx, &domain.User{
+		ID:       userID,
+		Password: hashedPassword,
+	})
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	err = ps.cache.Delete(ctx, util.GenerateCacheKey("user", userID))
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	err = ps.cache.DeleteByPrefix(ctx, "users:*")
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	err = ps.resetRepo.InvalidateUserPasswordResetTokens(ctx, userID, time.Now())
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return revokeUserTokens(ctx, ps.refreshRepo, ps.cache, userID)
+}
@@ -0,0 +1,629 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"go.uber.org/mock/gomock"
+)
+
+// expectPasswordSet mocks setting the password of a user and revoking the user's tokens and pending password resets
+func expectPasswordSet(userID uint64, userRepo *mock.MockUserRepository, resetRepo *mock.MockPasswordResetRepository, refreshRepo *mock.MockRefreshTokenRepository, cache *mock.MockCacheRepository) {
+	userRepo.EXPECT().
+		UpdateUser(gomock.Any(), gomock.Cond(func(x any) bool {
+			user := x.(*domain.User)
+			return user.ID == userID && user.Password != "" && user.Name == "" && user.Role == ""
+		})).
+		Times(1).
+		Return(&domain.User{ID: userID}, nil)
+	cache.EXPECT().
+		Delete(gomock.Any(), gomock.Eq(util.GenerateCacheKey("user", userID))).
+		Times(1).
+		Return(nil)
+	cache.EXPECT().
+		DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+		Times(1).
+		Return(nil)
+	resetRepo.EXPECT().
+		InvalidateUserPasswordResetTokens(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+		Times(1).
+		Return(nil)
+	cache.EXPECT().
+		Set(gomock.Any(), gomock.Eq(util.GenerateCacheKey("revoked_user", userID)), gomock.Any(), gomock.Eq(time.Duration(0))).
+		Times(1).
+		Return(nil)
+	refreshRepo.EXPECT().
+		RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+		Times(1).
+		Return(nil)
+}
+
+func TestPasswordService_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+
+	hashedPassword, err := util.HashPassword("12345678")
+	if err != nil {
+		t.Fatalf("expected to hash the password; got %q", err)
+	}
+
+	user := &domain.User{
+		ID:       7,
+		Email:    "cashier@example.com",
+		Password: hashedPassword,
+	}
+	ipAddress := "192.0.2.10"
+	errMiss := domain.ErrCacheMiss
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			resetRepo *mock.MockPasswordResetRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			cache *mock.MockCacheRepository,
+			audit *mock.MockAuditLogger,
+		)
+		currentPassword string
+		expected        error
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					AnyTimes().
+					Return(nil, errMiss)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				for _, prefix := range []string{"login_failures", "login_delay"} {
+					cache.EXPECT().
+						Delete(gomock.Any(), gomock.Eq(prefix+":password:7")).
+						Times(1).
+						Return(nil)
+				}
+				expectPasswordSet(user.ID, userRepo, resetRepo, refreshRepo, cache)
+				audit.EXPECT().
+					Record(gomock.Any(), gomock.Cond(func(x any) bool {
+						event := x.(*domain.AuditEvent)
+						return event.Type == domain.AuditPasswordChanged && event.UserID == user.ID
+					})).
+					Times(1)
+			},
+			currentPassword: "12345678",
+			expected:        nil,
+		},
+		{
+			desc: "Fail_IncorrectPassword",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					AnyTimes().
+					Return(nil, errMiss)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:password:7"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:ip:192.0.2.10"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+			},
+			currentPassword: "87654321",
+			expected:        domain.ErrIncorrectPassword,
+		},
+		{
+			desc: "Fail_IncorrectPasswordDelayed",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					AnyTimes().
+					Return(nil, errMiss)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(user, nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:password:7"), gomock.Any()).
+					Times(1).
+					Return(int64(3), nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq("login_delay:password:7"), gomock.Any(), gomock.Eq(time.Second)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("login_failures:ip:192.0.2.10"), gomock.Any()).
+					Times(1).
+					Return(int64(3), nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq("login_delay:ip:192.0.2.10"), gomock.Any(), gomock.Eq(time.Second)).
+					Times(1).
+					Return(nil)
+			},
+			currentPassword: "87654321",
+			expected:        domain.ErrIncorrectPassword,
+		},
+		{
+			desc: "Fail_LockedOut",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq("login_lockout:password:7")).
+					Times(1).
+					Return([]byte("1"), nil)
+			},
+			currentPassword: "12345678",
+			expected:        domain.ErrTooManyLoginAttempts,
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					AnyTimes().
+					Return(nil, errMiss)
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			currentPassword: "12345678",
+			expected:        domain.ErrDataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			resetRepo := mock.NewMockPasswordResetRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			audit := mock.NewMockAuditLogger(ctrl)
+
+			tc.mocks(userRepo, resetRepo, refreshRepo, cache, audit)
+
+			passwordService := service.NewPasswordService(
+				userRepo,
+				resetRepo,
+				refreshRepo,
+				mock.NewMockTokenService(ctrl),
+				mock.NewMockNotifier(ctrl),
+				cache,
+				audit,
+			)
+
+			err := passwordService.ChangePassword(ctx, user.ID, tc.currentPassword, "87654321", ipAddress)
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestPasswordService_RequestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	email := "Cashier@Example.com"
+	cacheKey := "password_reset:cashier@example.com"
+	user := &domain.User{
+		ID:    7,
+		Email: "cashier@example.com",
+	}
+	token := "reset-token"
+	expiresAt := time.Now().Add(30 * time.Minute)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			resetRepo *mock.MockPasswordResetRepository,
+			tokenService *mock.MockTokenService,
+			notifier *mock.MockNotifier,
+			cache *mock.MockCacheRepository,
+			audit *mock.MockAuditLogger,
+			sent func(),
+		)
+		sends    bool
+		expected error
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				tokenService *mock.MockTokenService,
+				notifier *mock.MockNotifier,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+				sent func(),
+			) {
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(time.Minute)).
+					Times(1).
+					Return(int64(1), nil)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(user, nil)
+				tokenService.EXPECT().
+					CreatePasswordResetToken().
+					Times(1).
+					Return(token, expiresAt, nil)
+				resetRepo.EXPECT().
+					CreatePasswordResetToken(gomock.Any(), gomock.Eq(&domain.PasswordResetToken{
+						UserID:    user.ID,
+						TokenHash: util.HashToken(token),
+						ExpiresAt: expiresAt,
+					})).
+					Times(1).
+					DoAndReturn(func(_ context.Context, resetToken *domain.PasswordResetToken) (*domain.PasswordResetToken, error) {
+						resetToken.ID = 1
+						return resetToken, nil
+					})
+				notifier.EXPECT().
+					SendPasswordReset(gomock.Any(), gomock.Eq(user), gomock.Cond(func(x any) bool {
+						return x.(*domain.PasswordResetToken).Token == token
+					})).
+					Times(1).
+					Return(nil)
+				audit.EXPECT().
+					Record(gomock.Any(), gomock.Cond(func(x any) bool {
+						event := x.(*domain.AuditEvent)
+						return event.Type == domain.AuditPasswordResetRequested && event.UserID == user.ID
+					})).
+					Times(1).
+					Do(func(context.Context, *domain.AuditEvent) {
+						sent()
+					})
+			},
+			sends:    true,
+			expected: nil,
+		},
+		{
+			desc: "Success_UnknownEmail",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				tokenService *mock.MockTokenService,
+				notifier *mock.MockNotifier,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+				sent func(),
+			) {
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(cacheKey), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					DoAndReturn(func(context.Context, string) (*domain.User, error) {
+						sent()
+						return nil, domain.ErrDataNotFound
+					})
+			},
+			sends:    true,
+			expected: nil,
+		},
+		{
+			desc: "Success_Throttled",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				tokenService *mock.MockTokenService,
+				notifier *mock.MockNotifier,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+				sent func(),
+			) {
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(cacheKey), gomock.Any()).
+					Times(1).
+					Return(int64(2), nil)
+			},
+			expected: nil,
+		},
+		{
+			desc: "Success_NotificationError",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				tokenService *mock.MockTokenService,
+				notifier *mock.MockNotifier,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+				sent func(),
+			) {
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(cacheKey), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(user, nil)
+				tokenService.EXPECT().
+					CreatePasswordResetToken().
+					Times(1).
+					Return(token, expiresAt, nil)
+				resetRepo.EXPECT().
+					CreatePasswordResetToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, resetToken *domain.PasswordResetToken) (*domain.PasswordResetToken, error) {
+						return resetToken, nil
+					})
+				notifier.EXPECT().
+					SendPasswordReset(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(context.Context, *domain.User, *domain.PasswordResetToken) error {
+						sent()
+						return errors.New("dial tcp: connection refused")
+					})
+			},
+			sends:    true,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			resetRepo := mock.NewMockPasswordResetRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			notifier := mock.NewMockNotifier(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			audit := mock.NewMockAuditLogger(ctrl)
+
+			done := make(chan struct{})
+			tc.mocks(userRepo, resetRepo, tokenService, notifier, cache, audit, func() {
+				close(done)
+			})
+
+			passwordService := service.NewPasswordService(
+				userRepo,
+				resetRepo,
+				mock.NewMockRefreshTokenRepository(ctrl),
+				tokenService,
+				notifier,
+				cache,
+				audit,
+			)
+
+			err := passwordService.RequestPasswordReset(ctx, email)
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+
+			// The token is sent after returning
+			if tc.sends {
+				select {
+				case <-done:
+				case <-time.After(time.Second):
+					t.Errorf("[case: %s] expected the password reset to be sent", tc.desc)
+				}
+			}
+		})
+	}
+}
+
+func TestPasswordService_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	token := "reset-token"
+	tokenHash := util.HashToken(token)
+	usedAt := time.Now().Add(-time.Minute)
+
+	activeToken := &domain.PasswordResetToken{
+		ID:        1,
+		UserID:    7,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(30 * time.Minute),
+	}
+	expiredToken := &domain.PasswordResetToken{
+		ID:        1,
+		UserID:    7,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	usedToken := &domain.PasswordResetToken{
+		ID:        1,
+		UserID:    7,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(30 * time.Minute),
+		UsedAt:    &usedAt,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			resetRepo *mock.MockPasswordResetRepository,
+			refreshRepo *mock.MockRefreshTokenRepository,
+			cache *mock.MockCacheRepository,
+			audit *mock.MockAuditLogger,
+		)
+		expected error
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				resetRepo.EXPECT().
+					GetPasswordResetTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(activeToken, nil)
+				resetRepo.EXPECT().
+					UsePasswordResetToken(gomock.Any(), gomock.Eq(activeToken.ID), gomock.Any()).
+					Times(1).
+					Return(nil)
+				expectPasswordSet(activeToken.UserID, userRepo, resetRepo, refreshRepo, cache)
+				audit.EXPECT().
+					Record(gomock.Any(), gomock.Cond(func(x any) bool {
+						event := x.(*domain.AuditEvent)
+						return event.Type == domain.AuditPasswordReset && event.UserID == activeToken.UserID
+					})).
+					Times(1)
+			},
+			expected: nil,
+		},
+		{
+			desc: "Fail_UnknownToken",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				resetRepo.EXPECT().
+					GetPasswordResetTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			expected: domain.ErrInvalidResetToken,
+		},
+		{
+			desc: "Fail_ExpiredToken",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				resetRepo.EXPECT().
+					GetPasswordResetTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(expiredToken, nil)
+			},
+			expected: domain.ErrInvalidResetToken,
+		},
+		{
+			desc: "Fail_UsedToken",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				resetRepo.EXPECT().
+					GetPasswordResetTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(usedToken, nil)
+			},
+			expected: domain.ErrInvalidResetToken,
+		},
+		{
+			desc: "Fail_UsedConcurrently",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				resetRepo *mock.MockPasswordResetRepository,
+				refreshRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				resetRepo.EXPECT().
+					GetPasswordResetTokenByHash(gomock.Any(), gomock.Eq(tokenHash)).
+					Times(1).
+					Return(activeToken, nil)
+				resetRepo.EXPECT().
+					UsePasswordResetToken(gomock.Any(), gomock.Eq(activeToken.ID), gomock.Any()).
+					Times(1).
+					Return(domain.ErrDataNotFound)
+			},
+			expected: domain.ErrInvalidResetToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			resetRepo := mock.NewMockPasswordResetRepository(ctrl)
+			refreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			audit := mock.NewMockAuditLogger(ctrl)
+
+			tc.mocks(userRepo, resetRepo, refreshRepo, cache, audit)
+
+			passwordService := service.NewPasswordService(
+				userRepo,
+				resetRepo,
+				refreshRepo,
+				mock.NewMockTokenService(ctrl),
+				mock.NewMockNotifier(ctrl),
+				cache,
+				audit,
+			)
+
+			err := passwordService.ResetPassword(ctx, token, "87654321")
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+/**
+ * PriceService implements port.PriceService interface
+ * and provides an access to the price and product repositories
+ * and cache service
+ */
+type PriceService struct {
+	priceRepo   port.PriceRepository
+	productRepo port.ProductRepository
+	cache       port.CacheRepository
+}
+
+// NewPriceService creates a new price service instance
+func NewPriceService(priceRepo port.PriceRepository, productRepo port.ProductRepository, cache port.CacheRepository) *PriceService {
+	return &PriceService{
+		priceRepo,
+		productRepo,
+		cache,
+	}
+}
+
+// ListPriceHistory retrieves the price history of a product with pagination
+func (ps *PriceService) ListPriceHistory(ctx context.Context, productID, skip, limit uint64) ([]domain.PriceChange, error) {
+	_, err := ps.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	priceChanges, err := ps.priceRepo.ListPriceChanges(ctx, productID, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return priceChanges, nil
+}
+
+// SchedulePrice schedules a future price of a product, applied once its effective time is reached
+func (ps *PriceService) SchedulePrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) (*domain.ScheduledPrice, error) {
+	if !scheduledPrice.EffectiveAt.After(time.Now()) {
+		return nil, domain.ErrInvalidSchedule
+	}
+
+	_, err := ps.productRepo.GetProductByID(ctx, scheduledPrice.ProductID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	scheduledPrice, err = ps.priceRepo.CreateScheduledPrice(ctx, scheduledPrice)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return scheduledPrice, nil
+}
+
+// ListScheduledPrices retrieves the scheduled prices of a product, optionally only the ones not applied yet
+func (ps *PriceService) ListScheduledPrices(ctx context.Context, productID uint64, pending bool) ([]domain.ScheduledPrice, error) {
+	_, err := ps.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	scheduledPrices, err := ps.priceRepo.ListScheduledPrices(ctx, productID, pending)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return scheduledPrices, nil
+}
+
+// CancelScheduledPrice cancels a scheduled price that has not taken effect yet
+func (ps *PriceService) CancelScheduledPrice(ctx context.Context, id uint64) error {
+	scheduledPrice, err := ps.priceRepo.GetScheduledPriceByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	if scheduledPrice.IsApplied() {
+		return domain.ErrScheduleApplied
+	}
+
+	err = ps.priceRepo.DeleteScheduledPrice(ctx, id)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// ApplyScheduledPrices applies the scheduled prices whose effective time has been reached, in order of their
+// effective time, and invalidates the cached data of the repriced products. Scheduled prices applied in the
+// meantime by another instance are skipped
+func (ps *PriceService) ApplyScheduledPrices(ctx context.Context, now time.Time) (int, error) {
+	scheduledPrices, err := ps.priceRepo.ListDueScheduledPrices(ctx, now)
+	if err != nil {
+		return 0, domain.ErrInternal
+	}
+
+	var applied int
+
+	for _, scheduledPrice := range scheduledPrices {
+		product, err := ps.priceRepo.ApplyScheduledPrice(ctx, scheduledPrice.ID, now)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				continue
+			}
+			return applied, domain.ErrInternal
+		}
+
+		applied++
+
+		cacheKey := util.GenerateCacheKey("product", product.ID)
+
+		err = ps.cache.Delete(ctx, cacheKey)
+		if err != nil {
+			return applied, domain.ErrInternal
+		}
+
+		if product.ParentID != 0 {
+			parentCacheKey := util.GenerateCacheKey("product", product.ParentID)
+
+			err = ps.cache.Delete(ctx, parentCacheKey)
+			if err != nil {
+				return applied, domain.ErrInternal
+			}
+		}
+	}
+
+	if applied == 0 {
+		return 0, nil
+	}
+
+	err = ps.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return applied, domain.ErrInternal
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+/**
+ * PriceListService implements port.PriceListService interface
+ * and provides an access to the price list and product repositories.
+ * The daily time windows of price lists follow the time zone of the store
+ */
+type PriceListService struct {
+	priceListRepo port.PriceListRepository
+	productRepo   port.ProductRepository
+	location      *time.Location
+}
+
+// NewPriceListService creates a new price list service instance
+func NewPriceListService(priceListRepo port.PriceListRepository, productRepo port.ProductRepository, location *time.Location) *PriceListService {
+	return &PriceListService{
+		priceListRepo,
+		productRepo,
+		location,
+	}
+}
+
+// CreatePriceList creates a new price list
+func (pls *PriceListService) CreatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	err := pls.validatePriceList(ctx, priceList)
+	if err != nil {
+		return nil, err
+	}
+
+	priceList, err = pls.priceListRepo.CreatePriceList(ctx, priceList)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return priceList, nil
+}
+
+// GetPriceList retrieves a price list by id
+func (pls *PriceListService) GetPriceList(ctx context.Context, id uint64) (*domain.PriceList, error) {
+	priceList, err := pls.priceListRepo.GetPriceListByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return priceList, nil
+}
+
+// ListPriceLists retrieves a list of price lists with pagination
+func (pls *PriceListService) ListPriceLists(ctx context.Context, skip, limit uint64) ([]domain.PriceList, error) {
+	priceLists, err := pls.priceListRepo.ListPriceLists(ctx, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return priceLists, nil
+}
+
+// UpdatePriceList updates a price list, replacing its validity, customer group and items
+func (pls *PriceListService) UpdatePriceList(ctx context.Context, priceList *domain.PriceList) (*domain.PriceList, error) {
+	_, err := pls.priceListRepo.GetPriceListByID(ctx, priceList.ID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	err = pls.validatePriceList(ctx, priceList)
+	if err != nil {
+		return nil, err
+	}
+
+	priceList, err = pls.priceListRepo.UpdatePriceList(ctx, priceList)
+	if err != nil {
+		if err == domain.ErrConflictingData || err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return priceList, nil
+}
+
+// DeletePriceList deletes a price list
+func (pls *PriceListService) DeletePriceList(ctx context.Context, id uint64) error {
+	_, err := pls.priceListRepo.GetPriceListByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	err = pls.priceListRepo.DeletePriceList(ctx, id)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// GetProductPrice retrieves the price of a product for a customer group at the given time,
+// either from the price list that applies or the regular price of the product
+func (pls *PriceListService) GetProductPrice(ctx context.Context, productID uint64, group domain.CustomerGroup, at time.Time) (*domain.ProductPrice, error) {
+	product, err := pls.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if group == "" {
+		group = domain.CustomerRetail
+	}
+
+	price, priceList, err := resolvePrice(ctx, pls.priceListRepo, product, group, at.In(pls.location))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ProductPrice{
+		ProductID:     product.ID,
+		CustomerGroup: group,
+		Price:         price,
+		PriceList:     priceList,
+	}, nil
+}
+
+// validatePriceList checks the validity period and time window of a price list and the products of its items
+func (pls *PriceListService) validatePriceList(ctx context.Context, priceList *domain.PriceList) error {
+	if !priceList.IsValid() {
+		return domain.ErrInvalidPriceList
+	}
+
+	for _, item := range priceList.Items {
+		_, err := pls.productRepo.GetProductByID(ctx, item.ProductID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return err
+			}
+			return domain.ErrInternal
+		}
+	}
+
+	return nil
+}
+
+// resolvePrice resolves the price of a product for a customer group at the given time, in the time zone of the store.
+// Among the price lists that contain the product and apply, the one with the highest priority wins,
+// with the lowest price breaking ties. The regular price of the product applies when no price list does
+func resolvePrice(ctx context.Context, priceListRepo port.PriceListRepository, product *domain.Product, group domain.CustomerGroup, at time.Time) (float64, *domain.PriceList, error) {
+	priceLists, err := priceListRepo.ListPriceListsByProduct(ctx, product.ID)
+	if err != nil {
+		return 0, nil, domain.ErrInternal
+	}
+
+	var applied *domain.PriceList
+	price := product.Price
+
+	for i := range priceLists {
+		priceList := &priceLists[i]
+
+		itemPrice, ok := priceList.ItemPrice(product.ID)
+		if !ok || !priceList.AppliesTo(group, at) {
+			continue
+		}
+
+		better := applied == nil ||
+			priceList.Priority > applied.Priority ||
+			priceList.Priority == applied.Priority && itemPrice < price
+		if better {
+			applied = priceList
+			price = itemPrice
+		}
+	}
+
+	return price, applied, nil
+}
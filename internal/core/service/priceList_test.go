@@ -0,0 +1,372 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createPriceListTestedInput struct {
+	priceList *domain.PriceList
+}
+
+type createPriceListExpectedOutput struct {
+	priceList *domain.PriceList
+	err       error
+}
+
+func TestPriceListService_CreatePriceList(t *testing.T) {
+	ctx := context.Background()
+	productID := gofakeit.Uint64()
+	product := &domain.Product{
+		ID:    productID,
+		Name:  gofakeit.ProductName(),
+		Price: 5000,
+	}
+
+	startsAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := startsAt.AddDate(0, 1, 0)
+
+	happyHour := func() *domain.PriceList {
+		return &domain.PriceList{
+			Name:      "Happy Hour",
+			StartTime: "16:00",
+			EndTime:   "18:00",
+			Items: []domain.PriceListItem{
+				{ProductID: productID, Price: 4000},
+			},
+		}
+	}
+	happyHourOutput := &domain.PriceList{
+		ID:        gofakeit.Uint64(),
+		Name:      "Happy Hour",
+		StartTime: "16:00",
+		EndTime:   "18:00",
+		Items: []domain.PriceListItem{
+			{ID: gofakeit.Uint64(), ProductID: productID, Price: 4000},
+		},
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			priceListRepo *mock.MockPriceListRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		input    createPriceListTestedInput
+		expected createPriceListExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				priceListRepo *mock.MockPriceListRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				priceListRepo.EXPECT().
+					CreatePriceList(gomock.Any(), gomock.Eq(happyHour())).
+					Times(1).
+					Return(happyHourOutput, nil)
+			},
+			input: createPriceListTestedInput{
+				priceList: happyHour(),
+			},
+			expected: createPriceListExpectedOutput{
+				priceList: happyHourOutput,
+				err:       nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidTimeWindow",
+			mocks: func(
+				priceListRepo *mock.MockPriceListRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+			},
+			input: createPriceListTestedInput{
+				priceList: &domain.PriceList{
+					Name:      "Happy Hour",
+					StartTime: "16:00",
+				},
+			},
+			expected: createPriceListExpectedOutput{
+				priceList: nil,
+				err:       domain.ErrInvalidPriceList,
+			},
+		},
+		{
+			desc: "Fail_InvalidValidityPeriod",
+			mocks: func(
+				priceListRepo *mock.MockPriceListRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+			},
+			input: createPriceListTestedInput{
+				priceList: &domain.PriceList{
+					Name:     "Promo",
+					StartsAt: &endsAt,
+					EndsAt:   &startsAt,
+				},
+			},
+			expected: createPriceListExpectedOutput{
+				priceList: nil,
+				err:       domain.ErrInvalidPriceList,
+			},
+		},
+		{
+			desc: "Fail_ProductNotFound",
+			mocks: func(
+				priceListRepo *mock.MockPriceListRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: createPriceListTestedInput{
+				priceList: happyHour(),
+			},
+			expected: createPriceListExpectedOutput{
+				priceList: nil,
+				err:       domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_DuplicateName",
+			mocks: func(
+				priceListRepo *mock.MockPriceListRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				priceListRepo.EXPECT().
+					CreatePriceList(gomock.Any(), gomock.Eq(happyHour())).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: createPriceListTestedInput{
+				priceList: happyHour(),
+			},
+			expected: createPriceListExpectedOutput{
+				priceList: nil,
+				err:       domain.ErrConflictingData,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			priceListRepo := mock.NewMockPriceListRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+
+			tc.mocks(priceListRepo, productRepo)
+
+			priceListService := service.NewPriceListService(priceListRepo, productRepo, time.UTC)
+
+			priceList, err := priceListService.CreatePriceList(ctx, tc.input.priceList)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.priceList, priceList, "Price list mismatch")
+		})
+	}
+}
+
+type getProductPriceTestedInput struct {
+	group domain.CustomerGroup
+	at    time.Time
+}
+
+type getProductPriceExpectedOutput struct {
+	productPrice *domain.ProductPrice
+	err          error
+}
+
+func TestPriceListService_GetProductPrice(t *testing.T) {
+	ctx := context.Background()
+	productID := gofakeit.Uint64()
+	product := &domain.Product{
+		ID:    productID,
+		Name:  gofakeit.ProductName(),
+		Price: 5000,
+	}
+
+	// store is the time zone of the store, ahead of the UTC times the server may run in
+	store := time.FixedZone("WIB", 7*60*60)
+
+	seasonStart := time.Date(2024, time.June, 1, 0, 0, 0, 0, store)
+	seasonEnd := seasonStart.AddDate(0, 3, 0)
+
+	afternoon := time.Date(2024, time.July, 1, 17, 0, 0, 0, store)
+	midnight := time.Date(2024, time.July, 1, 0, 30, 0, 0, store)
+	morning := time.Date(2024, time.July, 1, 9, 0, 0, 0, store)
+	winter := time.Date(2024, time.December, 1, 17, 0, 0, 0, store)
+
+	priceLists := func() []domain.PriceList {
+		return []domain.PriceList{
+			{
+				ID:            1,
+				Name:          "Wholesale",
+				CustomerGroup: domain.CustomerWholesale,
+				Items:         []domain.PriceListItem{{PriceListID: 1, ProductID: productID, Price: 4200}},
+			},
+			{
+				ID:        2,
+				Name:      "Happy Hour",
+				Priority:  10,
+				StartsAt:  &seasonStart,
+				EndsAt:    &seasonEnd,
+				StartTime: "16:00",
+				EndTime:   "18:00",
+				Items:     []domain.PriceListItem{{PriceListID: 2, ProductID: productID, Price: 4000}},
+			},
+			{
+				ID:        3,
+				Name:      "Late Night",
+				StartTime: "22:00",
+				EndTime:   "02:00",
+				Items:     []domain.PriceListItem{{PriceListID: 3, ProductID: productID, Price: 4500}},
+			},
+			{
+				ID:            4,
+				Name:          "Staff",
+				CustomerGroup: domain.CustomerStaff,
+				Items:         []domain.PriceListItem{{PriceListID: 4, ProductID: productID, Price: 3000}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		desc     string
+		input    getProductPriceTestedInput
+		expected getProductPriceExpectedOutput
+	}{
+		{
+			desc:  "Success_RegularPrice",
+			input: getProductPriceTestedInput{group: domain.CustomerRetail, at: morning},
+			expected: getProductPriceExpectedOutput{
+				productPrice: &domain.ProductPrice{
+					ProductID:     productID,
+					CustomerGroup: domain.CustomerRetail,
+					Price:         5000,
+				},
+			},
+		},
+		{
+			desc:  "Success_CustomerGroup",
+			input: getProductPriceTestedInput{group: domain.CustomerWholesale, at: morning},
+			expected: getProductPriceExpectedOutput{
+				productPrice: &domain.ProductPrice{
+					ProductID:     productID,
+					CustomerGroup: domain.CustomerWholesale,
+					Price:         4200,
+					PriceList:     &priceLists()[0],
+				},
+			},
+		},
+		{
+			desc:  "Success_HigherPriorityWins",
+			input: getProductPriceTestedInput{group: domain.CustomerWholesale, at: afternoon},
+			expected: getProductPriceExpectedOutput{
+				productPrice: &domain.ProductPrice{
+					ProductID:     productID,
+					CustomerGroup: domain.CustomerWholesale,
+					Price:         4000,
+					PriceList:     &priceLists()[1],
+				},
+			},
+		},
+		{
+			desc:  "Success_StoreTimeZone",
+			input: getProductPriceTestedInput{group: domain.CustomerRetail, at: afternoon.UTC()},
+			expected: getProductPriceExpectedOutput{
+				productPrice: &domain.ProductPrice{
+					ProductID:     productID,
+					CustomerGroup: domain.CustomerRetail,
+					Price:         4000,
+					PriceList:     &priceLists()[1],
+				},
+			},
+		},
+		{
+			desc:  "Success_LowestPriceBreaksTie",
+			input: getProductPriceTestedInput{group: domain.CustomerStaff, at: winter},
+			expected: getProductPriceExpectedOutput{
+				productPrice: &domain.ProductPrice{
+					ProductID:     productID,
+					CustomerGroup: domain.CustomerStaff,
+					Price:         3000,
+					PriceList:     &priceLists()[3],
+				},
+			},
+		},
+		{
+			desc:  "Success_OutsideValidityPeriod",
+			input: getProductPriceTestedInput{group: domain.CustomerRetail, at: winter},
+			expected: getProductPriceExpectedOutput{
+				productPrice: &domain.ProductPrice{
+					ProductID:     productID,
+					CustomerGroup: domain.CustomerRetail,
+					Price:         5000,
+				},
+			},
+		},
+		{
+			desc:  "Success_TimeWindowSpanningMidnight",
+			input: getProductPriceTestedInput{at: midnight},
+			expected: getProductPriceExpectedOutput{
+				productPrice: &domain.ProductPrice{
+					ProductID:     productID,
+					CustomerGroup: domain.CustomerRetail,
+					Price:         4500,
+					PriceList:     &priceLists()[2],
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			priceListRepo := mock.NewMockPriceListRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+
+			productRepo.EXPECT().
+				GetProductByID(gomock.Any(), gomock.Eq(productID)).
+				Times(1).
+				Return(product, nil)
+			priceListRepo.EXPECT().
+				ListPriceListsByProduct(gomock.Any(), gomock.Eq(productID)).
+				Times(1).
+				Return(priceLists(), nil)
+
+			priceListService := service.NewPriceListService(priceListRepo, productRepo, store)
+
+			productPrice, err := priceListService.GetProductPrice(ctx, productID, tc.input.group, tc.input.at)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.productPrice, productPrice, "Product price mismatch")
+		})
+	}
+}
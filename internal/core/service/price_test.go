@@ -0,0 +1,392 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type schedulePriceTestedInput struct {
+	scheduledPrice *domain.ScheduledPrice
+}
+
+type schedulePriceExpectedOutput struct {
+	scheduledPrice *domain.ScheduledPrice
+	err            error
+}
+
+func TestPriceService_SchedulePrice(t *testing.T) {
+	ctx := context.Background()
+	productID := gofakeit.Uint64()
+	userID := gofakeit.Uint64()
+	effectiveAt := time.Now().Add(24 * time.Hour)
+
+	product := &domain.Product{
+		ID:    productID,
+		Name:  gofakeit.ProductName(),
+		Price: 5000,
+	}
+
+	scheduledPriceInput := func() *domain.ScheduledPrice {
+		return &domain.ScheduledPrice{
+			ProductID:   productID,
+			UserID:      userID,
+			Price:       5500,
+			EffectiveAt: effectiveAt,
+		}
+	}
+	scheduledPriceOutput := &domain.ScheduledPrice{
+		ID:          gofakeit.Uint64(),
+		ProductID:   productID,
+		UserID:      userID,
+		Price:       5500,
+		EffectiveAt: effectiveAt,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			priceRepo *mock.MockPriceRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		input    schedulePriceTestedInput
+		expected schedulePriceExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				priceRepo *mock.MockPriceRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				priceRepo.EXPECT().
+					CreateScheduledPrice(gomock.Any(), gomock.Eq(scheduledPriceInput())).
+					Times(1).
+					Return(scheduledPriceOutput, nil)
+			},
+			input: schedulePriceTestedInput{
+				scheduledPrice: scheduledPriceInput(),
+			},
+			expected: schedulePriceExpectedOutput{
+				scheduledPrice: scheduledPriceOutput,
+				err:            nil,
+			},
+		},
+		{
+			desc: "Fail_InvalidSchedule",
+			mocks: func(
+				priceRepo *mock.MockPriceRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+			},
+			input: schedulePriceTestedInput{
+				scheduledPrice: &domain.ScheduledPrice{
+					ProductID:   productID,
+					UserID:      userID,
+					Price:       5500,
+					EffectiveAt: time.Now().Add(-time.Minute),
+				},
+			},
+			expected: schedulePriceExpectedOutput{
+				scheduledPrice: nil,
+				err:            domain.ErrInvalidSchedule,
+			},
+		},
+		{
+			desc: "Fail_ProductNotFound",
+			mocks: func(
+				priceRepo *mock.MockPriceRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: schedulePriceTestedInput{
+				scheduledPrice: scheduledPriceInput(),
+			},
+			expected: schedulePriceExpectedOutput{
+				scheduledPrice: nil,
+				err:            domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				priceRepo *mock.MockPriceRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				priceRepo.EXPECT().
+					CreateScheduledPrice(gomock.Any(), gomock.Eq(scheduledPriceInput())).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: schedulePriceTestedInput{
+				scheduledPrice: scheduledPriceInput(),
+			},
+			expected: schedulePriceExpectedOutput{
+				scheduledPrice: nil,
+				err:            domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			priceRepo := mock.NewMockPriceRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(priceRepo, productRepo)
+
+			priceService := service.NewPriceService(priceRepo, productRepo, cache)
+
+			scheduledPrice, err := priceService.SchedulePrice(ctx, tc.input.scheduledPrice)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.scheduledPrice, scheduledPrice, "Scheduled price mismatch")
+		})
+	}
+}
+
+func TestPriceService_CancelScheduledPrice(t *testing.T) {
+	ctx := context.Background()
+	id := gofakeit.Uint64()
+	appliedAt := time.Now()
+
+	pendingPrice := &domain.ScheduledPrice{
+		ID:          id,
+		ProductID:   gofakeit.Uint64(),
+		Price:       5500,
+		EffectiveAt: time.Now().Add(time.Hour),
+	}
+	appliedPrice := &domain.ScheduledPrice{
+		ID:          id,
+		ProductID:   pendingPrice.ProductID,
+		Price:       5500,
+		EffectiveAt: appliedAt,
+		AppliedAt:   &appliedAt,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(priceRepo *mock.MockPriceRepository)
+		expected error
+	}{
+		{
+			desc: "Success",
+			mocks: func(priceRepo *mock.MockPriceRepository) {
+				priceRepo.EXPECT().
+					GetScheduledPriceByID(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(pendingPrice, nil)
+				priceRepo.EXPECT().
+					DeleteScheduledPrice(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(nil)
+			},
+			expected: nil,
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(priceRepo *mock.MockPriceRepository) {
+				priceRepo.EXPECT().
+					GetScheduledPriceByID(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			expected: domain.ErrDataNotFound,
+		},
+		{
+			desc: "Fail_AlreadyApplied",
+			mocks: func(priceRepo *mock.MockPriceRepository) {
+				priceRepo.EXPECT().
+					GetScheduledPriceByID(gomock.Any(), gomock.Eq(id)).
+					Times(1).
+					Return(appliedPrice, nil)
+			},
+			expected: domain.ErrScheduleApplied,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			priceRepo := mock.NewMockPriceRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(priceRepo)
+
+			priceService := service.NewPriceService(priceRepo, productRepo, cache)
+
+			err := priceService.CancelScheduledPrice(ctx, id)
+			assert.Equal(t, tc.expected, err, "Error mismatch")
+		})
+	}
+}
+
+type applyScheduledPricesExpectedOutput struct {
+	applied int
+	err     error
+}
+
+func TestPriceService_ApplyScheduledPrices(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	productID := gofakeit.Uint64()
+	parentID := gofakeit.Uint64()
+	variantID := gofakeit.Uint64()
+
+	duePrices := []domain.ScheduledPrice{
+		{ID: 1, ProductID: productID, Price: 5500, EffectiveAt: now.Add(-time.Minute)},
+		{ID: 2, ProductID: variantID, Price: 7500, EffectiveAt: now.Add(-time.Minute)},
+		{ID: 3, ProductID: productID, Price: 6000, EffectiveAt: now},
+	}
+
+	product := &domain.Product{ID: productID, Price: 5500}
+	variant := &domain.Product{ID: variantID, ParentID: parentID, Price: 7500}
+
+	productCacheKey := util.GenerateCacheKey("product", productID)
+	variantCacheKey := util.GenerateCacheKey("product", variantID)
+	parentCacheKey := util.GenerateCacheKey("product", parentID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			priceRepo *mock.MockPriceRepository,
+			cache *mock.MockCacheRepository,
+		)
+		expected applyScheduledPricesExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				priceRepo *mock.MockPriceRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				priceRepo.EXPECT().
+					ListDueScheduledPrices(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return(duePrices, nil)
+				priceRepo.EXPECT().
+					ApplyScheduledPrice(gomock.Any(), gomock.Eq(uint64(1)), gomock.Eq(now)).
+					Times(1).
+					Return(product, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(productCacheKey)).
+					Times(1).
+					Return(nil)
+				priceRepo.EXPECT().
+					ApplyScheduledPrice(gomock.Any(), gomock.Eq(uint64(2)), gomock.Eq(now)).
+					Times(1).
+					Return(variant, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(variantCacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(parentCacheKey)).
+					Times(1).
+					Return(nil)
+				priceRepo.EXPECT().
+					ApplyScheduledPrice(gomock.Any(), gomock.Eq(uint64(3)), gomock.Eq(now)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			expected: applyScheduledPricesExpectedOutput{
+				applied: 2,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Success_NoneDue",
+			mocks: func(
+				priceRepo *mock.MockPriceRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				priceRepo.EXPECT().
+					ListDueScheduledPrices(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return(nil, nil)
+			},
+			expected: applyScheduledPricesExpectedOutput{
+				applied: 0,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				priceRepo *mock.MockPriceRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				priceRepo.EXPECT().
+					ListDueScheduledPrices(gomock.Any(), gomock.Eq(now)).
+					Times(1).
+					Return(duePrices[:1], nil)
+				priceRepo.EXPECT().
+					ApplyScheduledPrice(gomock.Any(), gomock.Eq(uint64(1)), gomock.Eq(now)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: applyScheduledPricesExpectedOutput{
+				applied: 0,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			priceRepo := mock.NewMockPriceRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(priceRepo, cache)
+
+			priceService := service.NewPriceService(priceRepo, productRepo, cache)
+
+			applied, err := priceService.ApplyScheduledPrices(ctx, now)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.applied, applied, "Applied count mismatch")
+		})
+	}
+}
@@ -2,6 +2,10 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
 
 	"github.com/bagashiz/go-pos/internal/core/domain"
 	"github.com/bagashiz/go-pos/internal/core/port"
@@ -28,8 +32,85 @@ func NewProductService(productRepo port.ProductRepository, categoryRepo port.Cat
 	}
 }
 
-// CreateProduct creates a new product
+// CreateProduct creates a new product, a variant of a parent product when a parent id is given,
+// or a bundle of other products when components are given
 func (ps *ProductService) CreateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	components := make(map[uint64]*domain.Product)
+
+	if product.IsBundle || len(product.Components) > 0 {
+		if !isValidBundle(product) {
+			return nil, domain.ErrInvalidBundle
+		}
+
+		for _, bundleComponent := range product.Components {
+			component, err := ps.productRepo.GetProductByID(ctx, bundleComponent.ComponentID)
+			if err != nil {
+				if err == domain.ErrDataNotFound {
+					return nil, err
+				}
+				return nil, domain.ErrInternal
+			}
+
+			if !isValidBundleComponent(component) {
+				return nil, domain.ErrInvalidBundle
+			}
+
+			components[component.ID] = component
+		}
+
+		product.IsBundle = true
+		product.Stock = 0
+	}
+
+	if product.ParentID != 0 {
+		parent, err := ps.productRepo.GetProductByID(ctx, product.ParentID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		if !isValidVariant(parent, product) {
+			return nil, domain.ErrInvalidVariant
+		}
+
+		if product.CategoryID == 0 {
+			product.CategoryID = parent.CategoryID
+		}
+		if product.Name == "" {
+			product.Name = variantName(parent, product.OptionValues)
+		}
+		if product.Image == "" {
+			product.Image = parent.Image
+		}
+		if product.Unit == "" {
+			product.Unit = parent.Unit
+			product.Fractional = parent.Fractional
+			product.Units = parent.Units
+		}
+		if product.Valuation == "" {
+			product.Valuation = parent.Valuation
+		}
+
+		parentCacheKey := util.GenerateCacheKey("product", parent.ID)
+
+		err = ps.cache.Delete(ctx, parentCacheKey)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+	}
+
+	if product.Unit == "" {
+		product.Unit = domain.DefaultUnit
+	}
+
+	for _, barcode := range product.Barcodes {
+		if !isValidBarcode(&barcode) {
+			return nil, domain.ErrInvalidBarcode
+		}
+	}
+
 	category, err := ps.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
 	if err != nil {
 		if err == domain.ErrDataNotFound {
@@ -48,6 +129,15 @@ func (ps *ProductService) CreateProduct(ctx context.Context, product *domain.Pro
 		return nil, domain.ErrInternal
 	}
 
+	if product.IsBundle {
+		for i, bundleComponent := range product.Components {
+			product.Components[i].Component = components[bundleComponent.ComponentID]
+		}
+
+		product.Stock = bundleStock(product.Components)
+		product.Cost = bundleCost(product.Components)
+	}
+
 	cacheKey := util.GenerateCacheKey("product", product.ID)
 	productSerialized, err := util.Serialize(product)
 	if err != nil {
@@ -99,6 +189,26 @@ func (ps *ProductService) GetProduct(ctx context.Context, id uint64) (*domain.Pr
 
 	product.Category = category
 
+	if len(product.Options) > 0 {
+		variants, err := ps.productRepo.ListVariants(ctx, product.ID)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+
+		for i := range variants {
+			variants[i].Category = category
+		}
+
+		product.Variants = variants
+	}
+
+	if product.IsBundle {
+		err = loadBundle(ctx, ps.productRepo, product)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	productSerialized, err := util.Serialize(product)
 	if err != nil {
 		return nil, domain.ErrInternal
@@ -112,11 +222,11 @@ func (ps *ProductService) GetProduct(ctx context.Context, id uint64) (*domain.Pr
 	return product, nil
 }
 
-// ListProducts retrieves a list of products
-func (ps *ProductService) ListProducts(ctx context.Context, search string, categoryID, skip, limit uint64) ([]domain.Product, error) {
+// ListProducts retrieves a list of products, grouping variants under their parent product if requested
+func (ps *ProductService) ListProducts(ctx context.Context, search string, categoryID, skip, limit uint64, grouped bool) ([]domain.Product, error) {
 	var products []domain.Product
 
-	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search)
+	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search, grouped)
 	cacheKey := util.GenerateCacheKey("products", params)
 
 	cachedProducts, err := ps.cache.Get(ctx, cacheKey)
@@ -128,7 +238,7 @@ func (ps *ProductService) ListProducts(ctx context.Context, search string, categ
 		return products, nil
 	}
 
-	products, err = ps.productRepo.ListProducts(ctx, search, categoryID, skip, limit)
+	products, err = ps.productRepo.ListProducts(ctx, search, categoryID, skip, limit, grouped)
 	if err != nil {
 		return nil, domain.ErrInternal
 	}
@@ -143,6 +253,28 @@ func (ps *ProductService) ListProducts(ctx context.Context, search string, categ
 		}
 
 		products[i].Category = category
+
+		if product.IsBundle {
+			err = loadBundle(ctx, ps.productRepo, &products[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !grouped || len(product.Options) == 0 {
+			continue
+		}
+
+		variants, err := ps.productRepo.ListVariants(ctx, product.ID)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+
+		for j := range variants {
+			variants[j].Category = category
+		}
+
+		products[i].Variants = variants
 	}
 
 	productsSerialized, err := util.Serialize(products)
@@ -158,8 +290,9 @@ func (ps *ProductService) ListProducts(ctx context.Context, search string, categ
 	return products, nil
 }
 
-// UpdateProduct updates a product
-func (ps *ProductService) UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+// UpdateProduct updates a product, recording a change of its price in the price history on behalf of the user.
+// The stock of products tracked by lots or serial numbers cannot be set, as it must match their lots or serial numbers
+func (ps *ProductService) UpdateProduct(ctx context.Context, product *domain.Product, userID uint64) (*domain.Product, error) {
 	existingProduct, err := ps.productRepo.GetProductByID(ctx, product.ID)
 	if err != nil {
 		if err == domain.ErrDataNotFound {
@@ -172,18 +305,30 @@ func (ps *ProductService) UpdateProduct(ctx context.Context, product *domain.Pro
 		product.Name == "" &&
 		product.Image == "" &&
 		product.Price == 0 &&
-		product.Stock == 0
+		product.Stock == 0 &&
+		product.Cost == 0 &&
+		product.Valuation == ""
 
 	sameData := existingProduct.CategoryID == product.CategoryID &&
 		existingProduct.Name == product.Name &&
 		existingProduct.Image == product.Image &&
 		existingProduct.Price == product.Price &&
-		existingProduct.Stock == product.Stock
+		existingProduct.Stock == product.Stock &&
+		existingProduct.Cost == product.Cost &&
+		existingProduct.Valuation == product.Valuation
 
 	if emptyData || sameData {
 		return nil, domain.ErrNoUpdatedData
 	}
 
+	if existingProduct.IsBundle && (product.Stock != 0 || product.Cost != 0) {
+		return nil, domain.ErrInvalidBundle
+	}
+
+	if (existingProduct.TrackLots || existingProduct.TrackSerials) && product.Stock != 0 {
+		return nil, domain.ErrReceiptNotAllowed
+	}
+
 	if product.CategoryID == 0 {
 		product.CategoryID = existingProduct.CategoryID
 	}
@@ -198,7 +343,7 @@ func (ps *ProductService) UpdateProduct(ctx context.Context, product *domain.Pro
 
 	product.Category = category
 
-	_, err = ps.productRepo.UpdateProduct(ctx, product)
+	_, err = ps.productRepo.UpdateProduct(ctx, product, userID)
 	if err != nil {
 		if err == domain.ErrConflictingData {
 			return nil, err
@@ -213,6 +358,15 @@ func (ps *ProductService) UpdateProduct(ctx context.Context, product *domain.Pro
 		return nil, domain.ErrInternal
 	}
 
+	if existingProduct.ParentID != 0 {
+		parentCacheKey := util.GenerateCacheKey("product", existingProduct.ParentID)
+
+		err = ps.cache.Delete(ctx, parentCacheKey)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+	}
+
 	productSerialized, err := util.Serialize(product)
 	if err != nil {
 		return nil, domain.ErrInternal
@@ -231,9 +385,62 @@ func (ps *ProductService) UpdateProduct(ctx context.Context, product *domain.Pro
 	return product, nil
 }
 
+// ReceiveProduct adds a received quantity, converted into the base unit, to the stock of a product,
+// updating its average cost with the cost per unit received
+func (ps *ProductService) ReceiveProduct(ctx context.Context, id uint64, quantity float64, unit string, cost float64) (*domain.Product, error) {
+	product, err := ps.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if product.IsBundle || len(product.Options) > 0 || product.TrackLots || product.TrackSerials {
+		return nil, domain.ErrReceiptNotAllowed
+	}
+
+	baseQuantity, err := product.BaseQuantity(quantity, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err = ps.productRepo.IncrementStock(ctx, id, baseQuantity, baseUnitCost(cost, quantity, baseQuantity))
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	category, err := ps.categoryRepo.GetCategoryByID(ctx, product.CategoryID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	product.Category = category
+
+	cacheKey := util.GenerateCacheKey("product", id)
+
+	err = ps.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = ps.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return product, nil
+}
+
 // DeleteProduct deletes a product
 func (ps *ProductService) DeleteProduct(ctx context.Context, id uint64) error {
-	_, err := ps.productRepo.GetProductByID(ctx, id)
+	product, err := ps.productRepo.GetProductByID(ctx, id)
 	if err != nil {
 		if err == domain.ErrDataNotFound {
 			return err
@@ -248,6 +455,15 @@ func (ps *ProductService) DeleteProduct(ctx context.Context, id uint64) error {
 		return domain.ErrInternal
 	}
 
+	if product.ParentID != 0 {
+		parentCacheKey := util.GenerateCacheKey("product", product.ParentID)
+
+		err = ps.cache.Delete(ctx, parentCacheKey)
+		if err != nil {
+			return domain.ErrInternal
+		}
+	}
+
 	err = ps.cache.DeleteByPrefix(ctx, "products:*")
 	if err != nil {
 		return domain.ErrInternal
@@ -255,3 +471,125 @@ func (ps *ProductService) DeleteProduct(ctx context.Context, id uint64) error {
 
 	return ps.productRepo.DeleteProduct(ctx, id)
 }
+
+// isValidVariant checks whether a variant has exactly one allowed value for each option dimension of its parent product
+func isValidVariant(parent, variant *domain.Product) bool {
+	isParent := parent.ParentID == 0 && len(parent.Options) > 0
+	if !isParent || len(variant.Options) > 0 {
+		return false
+	}
+
+	if len(variant.OptionValues) != len(parent.Options) {
+		return false
+	}
+
+	for _, option := range parent.Options {
+		value, ok := variant.OptionValues[option.Name]
+		if !ok || !slices.Contains(option.Values, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// variantName generates a variant name from its parent product name and option values
+func variantName(parent *domain.Product, optionValues map[string]string) string {
+	var values []string
+
+	for _, option := range parent.Options {
+		values = append(values, optionValues[option.Name])
+	}
+
+	return fmt.Sprintf("%s (%s)", parent.Name, strings.Join(values, " / "))
+}
+
+// isValidBundle checks whether a bundle is a standalone product made of distinct components with positive quantities
+func isValidBundle(bundle *domain.Product) bool {
+	isStandalone := bundle.ParentID == 0 &&
+		len(bundle.Options) == 0 &&
+		!bundle.TrackLots &&
+		!bundle.TrackSerials
+	if !isStandalone || len(bundle.Components) == 0 {
+		return false
+	}
+
+	componentIDs := make(map[uint64]bool)
+
+	for _, component := range bundle.Components {
+		if component.Quantity <= 0 || componentIDs[component.ComponentID] {
+			return false
+		}
+
+		componentIDs[component.ComponentID] = true
+	}
+
+	return true
+}
+
+// isValidBundleComponent checks whether a product can be sold as a component of a bundle
+func isValidBundleComponent(component *domain.Product) bool {
+	return !component.IsBundle &&
+		len(component.Options) == 0 &&
+		!component.TrackSerials
+}
+
+// loadBundle attaches the component products to a bundle and derives its stock from their stock
+func loadBundle(ctx context.Context, productRepo port.ProductRepository, bundle *domain.Product) error {
+	components, err := productRepo.ListBundleComponents(ctx, bundle.ID)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	for i, bundleComponent := range components {
+		component, err := productRepo.GetProductByID(ctx, bundleComponent.ComponentID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return err
+			}
+			return domain.ErrInternal
+		}
+
+		components[i].Component = component
+	}
+
+	bundle.Components = components
+	bundle.Stock = bundleStock(components)
+	bundle.Cost = bundleCost(components)
+
+	return nil
+}
+
+// bundleStock calculates how many bundles can be assembled from the stock of their components
+func bundleStock(components []domain.BundleComponent) float64 {
+	var stock float64
+
+	for i, component := range components {
+		available := math.Floor(max(component.Component.Stock, 0) / component.Quantity)
+		if i == 0 || available < stock {
+			stock = available
+		}
+	}
+
+	return stock
+}
+
+// bundleCost calculates the cost of a bundle from the average cost of its components
+func bundleCost(components []domain.BundleComponent) float64 {
+	var cost float64
+
+	for _, component := range components {
+		cost += component.Component.Cost * component.Quantity
+	}
+
+	return math.Round(cost*10000) / 10000
+}
+
+// baseUnitCost converts a cost per the unit of measure a quantity was received in into a cost per base unit
+func baseUnitCost(cost, quantity, baseQuantity float64) float64 {
+	if baseQuantity == 0 {
+		return cost
+	}
+
+	return math.Round(cost*quantity/baseQuantity*10000) / 10000
+}
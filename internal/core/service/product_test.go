@@ -34,7 +34,7 @@ func TestProductService_CreateProduct(t *testing.T) {
 	}
 
 	productName := gofakeit.ProductName()
-	productStock := gofakeit.Int64()
+	productStock := gofakeit.Float64()
 	productPrice := gofakeit.Float64()
 	productImage := gofakeit.ImageURL(400, 400)
 	productSKU, _ := uuid.NewUUID()
@@ -60,6 +60,54 @@ func TestProductService_CreateProduct(t *testing.T) {
 		UpdatedAt:  gofakeit.Date(),
 	}
 
+	parentID := gofakeit.Uint64()
+	parentWithoutOptions := &domain.Product{
+		ID:         parentID,
+		Name:       gofakeit.ProductName(),
+		CategoryID: categoryID,
+	}
+
+	variantInput := &domain.Product{
+		ParentID: parentID,
+		Price:    productPrice,
+		Stock:    productStock,
+		OptionValues: map[string]string{
+			"Size": "M",
+		},
+	}
+
+	componentID := gofakeit.Uint64()
+	bundleComponent := &domain.Product{
+		ID:         componentID,
+		Name:       gofakeit.ProductName(),
+		Stock:      productStock,
+		CategoryID: categoryID,
+		IsBundle:   true,
+	}
+
+	bundleInput := &domain.Product{
+		Name:       productName,
+		Price:      productPrice,
+		Image:      productImage,
+		CategoryID: categoryID,
+		IsBundle:   true,
+		Components: []domain.BundleComponent{
+			{ComponentID: componentID, Quantity: 2},
+		},
+	}
+
+	duplicateBundleInput := &domain.Product{
+		Name:       productName,
+		Price:      productPrice,
+		Image:      productImage,
+		CategoryID: categoryID,
+		IsBundle:   true,
+		Components: []domain.BundleComponent{
+			{ComponentID: componentID, Quantity: 2},
+			{ComponentID: componentID, Quantity: 1},
+		},
+	}
+
 	cacheKey := util.GenerateCacheKey("product", productOutput.ID)
 	productSerialized, _ := util.Serialize(productOutput)
 	ttl := time.Duration(0)
@@ -254,6 +302,102 @@ func TestProductService_CreateProduct(t *testing.T) {
 				err:     domain.ErrInternal,
 			},
 		},
+		{
+			desc: "Fail_NotFoundGetParent",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(parentID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: createProductTestedInput{
+				product: variantInput,
+			},
+			expected: createProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InvalidVariant",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(parentID)).
+					Times(1).
+					Return(parentWithoutOptions, nil)
+			},
+			input: createProductTestedInput{
+				product: variantInput,
+			},
+			expected: createProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrInvalidVariant,
+			},
+		},
+		{
+			desc: "Fail_InvalidBundle",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+			},
+			input: createProductTestedInput{
+				product: duplicateBundleInput,
+			},
+			expected: createProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrInvalidBundle,
+			},
+		},
+		{
+			desc: "Fail_NotFoundGetComponent",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(componentID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: createProductTestedInput{
+				product: bundleInput,
+			},
+			expected: createProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InvalidBundleComponent",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(componentID)).
+					Times(1).
+					Return(bundleComponent, nil)
+			},
+			input: createProductTestedInput{
+				product: bundleInput,
+			},
+			expected: createProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrInvalidBundle,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -303,7 +447,7 @@ func TestProductService_GetProduct(t *testing.T) {
 		ID:         productID,
 		SKU:        productSKU,
 		Name:       gofakeit.ProductName(),
-		Stock:      gofakeit.Int64(),
+		Stock:      gofakeit.Float64(),
 		Price:      gofakeit.Float64(),
 		Image:      gofakeit.ImageURL(400, 400),
 		CategoryID: categoryID,
@@ -312,6 +456,36 @@ func TestProductService_GetProduct(t *testing.T) {
 		UpdatedAt:  gofakeit.Date(),
 	}
 
+	bundleID := gofakeit.Uint64()
+	component := &domain.Product{
+		ID:         gofakeit.Uint64(),
+		Name:       gofakeit.ProductName(),
+		Stock:      7,
+		CategoryID: categoryID,
+	}
+
+	bundleOutput := &domain.Product{
+		ID:         bundleID,
+		SKU:        productSKU,
+		Name:       gofakeit.ProductName(),
+		Price:      gofakeit.Float64(),
+		CategoryID: categoryID,
+		IsBundle:   true,
+	}
+
+	bundleComponents := []domain.BundleComponent{
+		{BundleID: bundleID, ComponentID: component.ID, Quantity: 2},
+	}
+
+	bundleWithStock := *bundleOutput
+	bundleWithStock.Stock = 3
+	bundleWithStock.Category = category
+	bundleWithStock.Components = []domain.BundleComponent{
+		{BundleID: bundleID, ComponentID: component.ID, Quantity: 2, Component: component},
+	}
+
+	bundleCacheKey := util.GenerateCacheKey("product", bundleID)
+
 	cacheKey := util.GenerateCacheKey("product", productOutput.ID)
 	productSerialized, _ := util.Serialize(productOutput)
 	ttl := time.Duration(0)
@@ -378,6 +552,46 @@ func TestProductService_GetProduct(t *testing.T) {
 				err:     nil,
 			},
 		},
+		{
+			desc: "Success_FromDBBundle",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(bundleCacheKey)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(bundleID)).
+					Times(1).
+					Return(bundleOutput, nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(category, nil)
+				productRepo.EXPECT().
+					ListBundleComponents(gomock.Any(), gomock.Eq(bundleID)).
+					Times(1).
+					Return(bundleComponents, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(component.ID)).
+					Times(1).
+					Return(component, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(bundleCacheKey), gomock.Any(), gomock.Eq(ttl)).
+					Times(1).
+					Return(nil)
+			},
+			input: getProductTestedInput{
+				id: bundleID,
+			},
+			expected: getProductExpectedOutput{
+				product: &bundleWithStock,
+				err:     nil,
+			},
+		},
 		{
 			desc: "Fail_Deserialize",
 			mocks: func(
@@ -564,6 +778,7 @@ type listProductsTestedInput struct {
 	categoryID uint64
 	skip       uint64
 	limit      uint64
+	grouped    bool
 }
 
 type listProductsExpectedOutput struct {
@@ -587,7 +802,7 @@ func TestProductService_ListProducts(t *testing.T) {
 			ID:         gofakeit.Uint64(),
 			SKU:        productSKU,
 			Name:       gofakeit.ProductName(),
-			Stock:      gofakeit.Int64(),
+			Stock:      gofakeit.Float64(),
 			Price:      gofakeit.Float64(),
 			Image:      gofakeit.ImageURL(400, 400),
 			CategoryID: categoryID,
@@ -601,8 +816,9 @@ func TestProductService_ListProducts(t *testing.T) {
 	skip := gofakeit.Uint64()
 	limit := gofakeit.Uint64()
 	search := ""
+	grouped := false
 
-	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search)
+	params := util.GenerateCacheKeyParams(skip, limit, categoryID, search, grouped)
 	cacheKey := util.GenerateCacheKey("products", params)
 	productsSerialized, _ := util.Serialize(products)
 	ttl := time.Duration(0)
@@ -634,6 +850,7 @@ func TestProductService_ListProducts(t *testing.T) {
 				categoryID: categoryID,
 				skip:       skip,
 				limit:      limit,
+				grouped:    grouped,
 			},
 			expected: listProductsExpectedOutput{
 				products: products,
@@ -652,7 +869,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(grouped)).
 					Times(1).
 					Return(products, nil)
 				for i := range products {
@@ -672,6 +889,7 @@ func TestProductService_ListProducts(t *testing.T) {
 				categoryID: categoryID,
 				skip:       skip,
 				limit:      limit,
+				grouped:    grouped,
 			},
 			expected: listProductsExpectedOutput{
 				products: products,
@@ -695,6 +913,7 @@ func TestProductService_ListProducts(t *testing.T) {
 				categoryID: categoryID,
 				skip:       skip,
 				limit:      limit,
+				grouped:    grouped,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -713,7 +932,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(grouped)).
 					Times(1).
 					Return(nil, domain.ErrInternal)
 			},
@@ -722,6 +941,7 @@ func TestProductService_ListProducts(t *testing.T) {
 				categoryID: categoryID,
 				skip:       skip,
 				limit:      limit,
+				grouped:    grouped,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -741,7 +961,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(grouped)).
 					Times(1).
 					Return(products, nil)
 				categoryRepo.EXPECT().
@@ -754,6 +974,7 @@ func TestProductService_ListProducts(t *testing.T) {
 				categoryID: categoryID,
 				skip:       skip,
 				limit:      limit,
+				grouped:    grouped,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -773,7 +994,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(grouped)).
 					Times(1).
 					Return(products, nil)
 				categoryRepo.EXPECT().
@@ -786,6 +1007,7 @@ func TestProductService_ListProducts(t *testing.T) {
 				categoryID: categoryID,
 				skip:       skip,
 				limit:      limit,
+				grouped:    grouped,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -805,7 +1027,7 @@ func TestProductService_ListProducts(t *testing.T) {
 					Times(1).
 					Return(nil, domain.ErrDataNotFound)
 				productRepo.EXPECT().
-					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit)).
+					ListProducts(gomock.Any(), gomock.Eq(search), gomock.Eq(categoryID), gomock.Eq(skip), gomock.Eq(limit), gomock.Eq(grouped)).
 					Times(1).
 					Return(products, nil)
 				for i := range products {
@@ -825,6 +1047,7 @@ func TestProductService_ListProducts(t *testing.T) {
 				categoryID: categoryID,
 				skip:       skip,
 				limit:      limit,
+				grouped:    grouped,
 			},
 			expected: listProductsExpectedOutput{
 				products: nil,
@@ -849,7 +1072,7 @@ func TestProductService_ListProducts(t *testing.T) {
 
 			productService := service.NewProductService(productRepo, categoryRepo, cache)
 
-			products, err := productService.ListProducts(ctx, tc.input.search, tc.input.categoryID, tc.input.skip, tc.input.limit)
+			products, err := productService.ListProducts(ctx, tc.input.search, tc.input.categoryID, tc.input.skip, tc.input.limit, tc.input.grouped)
 			assert.Equal(t, tc.expected.err, err, "Error mismatch")
 			assert.Equal(t, tc.expected.products, products, "Products mismatch")
 		})
@@ -867,6 +1090,7 @@ type updateProductExpectedOutput struct {
 
 func TestProductService_UpdateProduct(t *testing.T) {
 	ctx := context.Background()
+	userID := gofakeit.Uint64()
 	productID := gofakeit.Uint64()
 	productSKU, _ := uuid.NewUUID()
 	categoryID := gofakeit.Uint64()
@@ -877,7 +1101,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 	}
 
 	productName := gofakeit.ProductName()
-	productStock := gofakeit.Int64()
+	productStock := gofakeit.Float64()
 	productPrice := gofakeit.Float64()
 	productImage := gofakeit.ImageURL(400, 400)
 
@@ -906,11 +1130,17 @@ func TestProductService_UpdateProduct(t *testing.T) {
 		ID:    productID,
 		SKU:   productSKU,
 		Name:  gofakeit.ProductName(),
-		Stock: gofakeit.Int64(),
+		Stock: gofakeit.Float64(),
 		Price: gofakeit.Float64(),
 		Image: gofakeit.ImageURL(400, 400),
 	}
 
+	lotProduct := *existingProduct
+	lotProduct.TrackLots = true
+
+	serialProduct := *existingProduct
+	serialProduct.TrackSerials = true
+
 	cacheKey := util.GenerateCacheKey("product", productOutput.ID)
 	productSerialized, _ := util.Serialize(productOutput)
 	ttl := time.Duration(0)
@@ -942,7 +1172,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 					Times(1).
 					Return(category, nil)
 				productRepo.EXPECT().
-					UpdateProduct(gomock.Any(), gomock.Eq(productInput)).
+					UpdateProduct(gomock.Any(), gomock.Eq(productInput), gomock.Eq(userID)).
 					Times(1).
 					Return(productOutput, nil)
 				cache.EXPECT().
@@ -1052,6 +1282,46 @@ func TestProductService_UpdateProduct(t *testing.T) {
 				err:     domain.ErrNoUpdatedData,
 			},
 		},
+		{
+			desc: "Fail_LotTrackedStock",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(&lotProduct, nil)
+			},
+			input: updateProductTestedInput{
+				product: productInput,
+			},
+			expected: updateProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrReceiptNotAllowed,
+			},
+		},
+		{
+			desc: "Fail_SerialTrackedStock",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(&serialProduct, nil)
+			},
+			input: updateProductTestedInput{
+				product: productInput,
+			},
+			expected: updateProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrReceiptNotAllowed,
+			},
+		},
 		{
 			desc: "Fail_NotFoundGetCategory",
 			mocks: func(
@@ -1119,7 +1389,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 					Times(1).
 					Return(category, nil)
 				productRepo.EXPECT().
-					UpdateProduct(gomock.Any(), gomock.Eq(productInput)).
+					UpdateProduct(gomock.Any(), gomock.Eq(productInput), gomock.Eq(userID)).
 					Times(1).
 					Return(nil, domain.ErrConflictingData)
 			},
@@ -1147,7 +1417,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 					Times(1).
 					Return(category, nil)
 				productRepo.EXPECT().
-					UpdateProduct(gomock.Any(), gomock.Eq(productInput)).
+					UpdateProduct(gomock.Any(), gomock.Eq(productInput), gomock.Eq(userID)).
 					Times(1).
 					Return(nil, domain.ErrInternal)
 			},
@@ -1175,7 +1445,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 					Times(1).
 					Return(category, nil)
 				productRepo.EXPECT().
-					UpdateProduct(gomock.Any(), gomock.Eq(productInput)).
+					UpdateProduct(gomock.Any(), gomock.Eq(productInput), gomock.Eq(userID)).
 					Times(1).
 					Return(productOutput, nil)
 				cache.EXPECT().
@@ -1208,7 +1478,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 					Times(1).
 					Return(category, nil)
 				productRepo.EXPECT().
-					UpdateProduct(gomock.Any(), gomock.Eq(productInput)).
+					UpdateProduct(gomock.Any(), gomock.Eq(productInput), gomock.Eq(userID)).
 					Times(1).
 					Return(productOutput, nil)
 				cache.EXPECT().
@@ -1245,7 +1515,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 					Times(1).
 					Return(category, nil)
 				productRepo.EXPECT().
-					UpdateProduct(gomock.Any(), gomock.Eq(productInput)).
+					UpdateProduct(gomock.Any(), gomock.Eq(productInput), gomock.Eq(userID)).
 					Times(1).
 					Return(productOutput, nil)
 				cache.EXPECT().
@@ -1287,7 +1557,7 @@ func TestProductService_UpdateProduct(t *testing.T) {
 
 			productService := service.NewProductService(productRepo, categoryRepo, cache)
 
-			product, err := productService.UpdateProduct(ctx, tc.input.product)
+			product, err := productService.UpdateProduct(ctx, tc.input.product, userID)
 			assert.Equal(t, tc.expected.err, err, "Error mismatch")
 			assert.Equal(t, tc.expected.product, product, "Product mismatch")
 		})
@@ -1305,6 +1575,9 @@ type deleteProductExpectedOutput struct {
 func TestProductService_DeleteProduct(t *testing.T) {
 	ctx := context.Background()
 	productID := gofakeit.Uint64()
+	product := &domain.Product{
+		ID: productID,
+	}
 
 	cacheKey := util.GenerateCacheKey("product", productID)
 
@@ -1328,7 +1601,7 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(product, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1397,7 +1670,7 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(product, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1420,7 +1693,7 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(product, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1447,7 +1720,7 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				productRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Eq(productID)).
 					Times(1).
-					Return(nil, nil)
+					Return(product, nil)
 				cache.EXPECT().
 					Delete(gomock.Any(), gomock.Eq(cacheKey)).
 					Times(1).
@@ -1490,3 +1763,239 @@ func TestProductService_DeleteProduct(t *testing.T) {
 		})
 	}
 }
+
+type receiveProductTestedInput struct {
+	id       uint64
+	quantity float64
+	unit     string
+	cost     float64
+}
+
+type receiveProductExpectedOutput struct {
+	product *domain.Product
+	err     error
+}
+
+func TestProductService_ReceiveProduct(t *testing.T) {
+	ctx := context.Background()
+	productID := gofakeit.Uint64()
+	categoryID := gofakeit.Uint64()
+	category := &domain.Category{
+		ID:   categoryID,
+		Name: gofakeit.ProductCategory(),
+	}
+
+	product := &domain.Product{
+		ID:         productID,
+		Name:       gofakeit.ProductName(),
+		Stock:      10,
+		CategoryID: categoryID,
+		Unit:       domain.DefaultUnit,
+		Units: []domain.ProductUnit{
+			{Name: "carton", Factor: 24},
+		},
+	}
+	lotTrackedProduct := &domain.Product{
+		ID:         productID,
+		Name:       product.Name,
+		CategoryID: categoryID,
+		TrackLots:  true,
+	}
+
+	receivedProduct := func() *domain.Product {
+		return &domain.Product{
+			ID:         productID,
+			Name:       product.Name,
+			Stock:      58,
+			CategoryID: categoryID,
+			Unit:       product.Unit,
+			Units:      product.Units,
+		}
+	}
+	productOutput := receivedProduct()
+	productOutput.Category = category
+
+	cacheKey := util.GenerateCacheKey("product", productID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			productRepo *mock.MockProductRepository,
+			categoryRepo *mock.MockCategoryRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    receiveProductTestedInput
+		expected receiveProductExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				productRepo.EXPECT().
+					IncrementStock(gomock.Any(), gomock.Eq(productID), gomock.Eq(float64(48)), gomock.Eq(float64(3500))).
+					Times(1).
+					Return(receivedProduct(), nil)
+				categoryRepo.EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Eq(categoryID)).
+					Times(1).
+					Return(category, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: receiveProductTestedInput{
+				id:       productID,
+				quantity: 2,
+				unit:     "carton",
+				cost:     84000,
+			},
+			expected: receiveProductExpectedOutput{
+				product: productOutput,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: receiveProductTestedInput{
+				id:       productID,
+				quantity: 2,
+			},
+			expected: receiveProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_ReceiptNotAllowed",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(lotTrackedProduct, nil)
+			},
+			input: receiveProductTestedInput{
+				id:       productID,
+				quantity: 2,
+			},
+			expected: receiveProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrReceiptNotAllowed,
+			},
+		},
+		{
+			desc: "Fail_InvalidUnit",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: receiveProductTestedInput{
+				id:       productID,
+				quantity: 2,
+				unit:     "pallet",
+			},
+			expected: receiveProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrInvalidUnit,
+			},
+		},
+		{
+			desc: "Fail_FractionalQuantity",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: receiveProductTestedInput{
+				id:       productID,
+				quantity: 1.5,
+			},
+			expected: receiveProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrFractionalQuantity,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				productRepo *mock.MockProductRepository,
+				categoryRepo *mock.MockCategoryRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				productRepo.EXPECT().
+					IncrementStock(gomock.Any(), gomock.Eq(productID), gomock.Eq(float64(2)), gomock.Eq(float64(0))).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: receiveProductTestedInput{
+				id:       productID,
+				quantity: 2,
+			},
+			expected: receiveProductExpectedOutput{
+				product: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			productRepo := mock.NewMockProductRepository(ctrl)
+			categoryRepo := mock.NewMockCategoryRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(productRepo, categoryRepo, cache)
+
+			productService := service.NewProductService(productRepo, categoryRepo, cache)
+
+			product, err := productService.ReceiveProduct(ctx, tc.input.id, tc.input.quantity, tc.input.unit, tc.input.cost)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.product, product, "Product mismatch")
+		})
+	}
+}
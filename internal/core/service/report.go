@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+)
+
+/**
+ * ReportService implements port.ReportService interface
+ * and provides an access to the report repository
+ */
+type ReportService struct {
+	reportRepo port.ReportRepository
+}
+
+// NewReportService creates a new report service instance
+func NewReportService(reportRepo port.ReportRepository) *ReportService {
+	return &ReportService{
+		reportRepo,
+	}
+}
+
+// GetMarginReport reports the revenue, cost and gross margin of the products sold within a period
+func (rs *ReportService) GetMarginReport(ctx context.Context, from, to time.Time) (*domain.MarginReport, error) {
+	margins, err := rs.reportRepo.ListProductMargins(ctx, from, to)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	report := &domain.MarginReport{
+		From: from,
+		To:   to,
+	}
+
+	for i, margin := range margins {
+		margins[i].Margin = roundPrice(margin.Revenue - margin.Cost)
+
+		report.Revenue += margin.Revenue
+		report.Cost += margin.Cost
+	}
+
+	report.Products = margins
+	report.Revenue = roundPrice(report.Revenue)
+	report.Cost = roundPrice(report.Cost)
+	report.Margin = roundPrice(report.Revenue - report.Cost)
+
+	return report, nil
+}
+
+// GetInventoryValuation reports the value of the stock on hand of each product. Products valued by weighted average cost
+// are valued at their average cost, while products valued by FIFO are valued at the cost of their most recent receipts,
+// with any stock not covered by cost layers valued at the average cost
+func (rs *ReportService) GetInventoryValuation(ctx context.Context) (*domain.InventoryValuation, error) {
+	valuations, err := rs.reportRepo.ListStockValuations(ctx)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	inventory := &domain.InventoryValuation{}
+
+	for i, valuation := range valuations {
+		stock := max(valuation.Stock, 0)
+		value := stock * valuation.AverageCost
+
+		if valuation.Method == domain.ValuationFIFO {
+			value = valuation.LayeredValue + max(stock-valuation.LayeredQuantity, 0)*valuation.AverageCost
+		}
+
+		valuations[i].Value = roundPrice(value)
+		inventory.Value += valuations[i].Value
+	}
+
+	inventory.Products = valuations
+	inventory.Value = roundPrice(inventory.Value)
+
+	return inventory, nil
+}
+
+// roundPrice rounds an amount of money to cents
+func roundPrice(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
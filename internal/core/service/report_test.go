@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type getMarginReportTestedInput struct {
+	from time.Time
+	to   time.Time
+}
+
+type getMarginReportExpectedOutput struct {
+	report *domain.MarginReport
+	err    error
+}
+
+func TestReportService_GetMarginReport(t *testing.T) {
+	ctx := context.Background()
+	to := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, -1, 0)
+
+	coffeeID := gofakeit.Uint64()
+	teaID := gofakeit.Uint64()
+
+	margins := func() []domain.ProductMargin {
+		return []domain.ProductMargin{
+			{ProductID: coffeeID, Name: "Coffee", Quantity: 10, Revenue: 250000, Cost: 120000.5},
+			{ProductID: teaID, Name: "Tea", Quantity: 4, Revenue: 60000, Cost: 20000.25},
+		}
+	}
+
+	report := &domain.MarginReport{
+		From: from,
+		To:   to,
+		Products: []domain.ProductMargin{
+			{ProductID: coffeeID, Name: "Coffee", Quantity: 10, Revenue: 250000, Cost: 120000.5, Margin: 129999.5},
+			{ProductID: teaID, Name: "Tea", Quantity: 4, Revenue: 60000, Cost: 20000.25, Margin: 39999.75},
+		},
+		Revenue: 310000,
+		Cost:    140000.75,
+		Margin:  169999.25,
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(reportRepo *mock.MockReportRepository)
+		input    getMarginReportTestedInput
+		expected getMarginReportExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(reportRepo *mock.MockReportRepository) {
+				reportRepo.EXPECT().
+					ListProductMargins(gomock.Any(), gomock.Eq(from), gomock.Eq(to)).
+					Times(1).
+					Return(margins(), nil)
+			},
+			input: getMarginReportTestedInput{
+				from: from,
+				to:   to,
+			},
+			expected: getMarginReportExpectedOutput{
+				report: report,
+				err:    nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(reportRepo *mock.MockReportRepository) {
+				reportRepo.EXPECT().
+					ListProductMargins(gomock.Any(), gomock.Eq(from), gomock.Eq(to)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: getMarginReportTestedInput{
+				from: from,
+				to:   to,
+			},
+			expected: getMarginReportExpectedOutput{
+				report: nil,
+				err:    domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reportRepo := mock.NewMockReportRepository(ctrl)
+
+			tc.mocks(reportRepo)
+
+			reportService := service.NewReportService(reportRepo)
+
+			report, err := reportService.GetMarginReport(ctx, tc.input.from, tc.input.to)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.report, report, "Report mismatch")
+		})
+	}
+}
+
+type getInventoryValuationExpectedOutput struct {
+	inventory *domain.InventoryValuation
+	err       error
+}
+
+func TestReportService_GetInventoryValuation(t *testing.T) {
+	ctx := context.Background()
+	averageID := gofakeit.Uint64()
+	fifoID := gofakeit.Uint64()
+
+	valuations := func() []domain.StockValuation {
+		return []domain.StockValuation{
+			{
+				ProductID:   averageID,
+				Name:        "Coffee",
+				Stock:       20,
+				Method:      domain.ValuationAverage,
+				AverageCost: 12000,
+			},
+			{
+				ProductID:       fifoID,
+				Name:            "Tea",
+				Stock:           30,
+				Method:          domain.ValuationFIFO,
+				AverageCost:     5000,
+				LayeredQuantity: 25,
+				LayeredValue:    137500,
+			},
+		}
+	}
+
+	inventory := &domain.InventoryValuation{
+		Products: valuations(),
+		Value:    402500,
+	}
+	inventory.Products[0].Value = 240000
+	inventory.Products[1].Value = 162500
+
+	testCases := []struct {
+		desc     string
+		mocks    func(reportRepo *mock.MockReportRepository)
+		expected getInventoryValuationExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(reportRepo *mock.MockReportRepository) {
+				reportRepo.EXPECT().
+					ListStockValuations(gomock.Any()).
+					Times(1).
+					Return(valuations(), nil)
+			},
+			expected: getInventoryValuationExpectedOutput{
+				inventory: inventory,
+				err:       nil,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(reportRepo *mock.MockReportRepository) {
+				reportRepo.EXPECT().
+					ListStockValuations(gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: getInventoryValuationExpectedOutput{
+				inventory: nil,
+				err:       domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reportRepo := mock.NewMockReportRepository(ctrl)
+
+			tc.mocks(reportRepo)
+
+			reportService := service.NewReportService(reportRepo)
+
+			inventory, err := reportService.GetInventoryValuation(ctx)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.inventory, inventory, "Inventory mismatch")
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+/**
+ * RoleService implements port.RoleService interface
+ * and provides an access to the role repository
+ * and cache service
+ */
+type RoleService struct {
+	repo  port.RoleRepository
+	cache port.CacheRepository
+}
+
+// NewRoleService creates a new role service instance
+func NewRoleService(repo port.RoleRepository, cache port.CacheRepository) *RoleService {
+	return &RoleService{
+		repo,
+		cache,
+	}
+}
+
+// CreateRole creates a new role
+func (rs *RoleService) CreateRole(ctx context.Context, role *domain.Role) (*domain.Role, error) {
+	role, err := rs.repo.CreateRole(ctx, role)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return role, nil
+}
+
+// GetRole retrieves a role by id
+func (rs *RoleService) GetRole(ctx context.Context, id uint64) (*domain.Role, error) {
+	role, err := rs.repo.GetRoleByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	return role, nil
+}
+
+// ListRoles retrieves all roles
+func (rs *RoleService) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	roles, err := rs.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return roles, nil
+}
+
+// UpdateRole updates the name or permissions of a role, keeping those left empty.
+// The access tokens issued with the previous permissions are revoked, so they are refreshed with the new ones
+func (rs *RoleService) UpdateRole(ctx context.Context, role *domain.Role) (*domain.Role, error) {
+	existingRole, err := rs.repo.GetRoleByID(ctx, role.ID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if role.Name == "" {
+		role.Name = existingRole.Name
+	}
+	if role.Permissions == nil {
+		role.Permissions = existingRole.Permissions
+	}
+
+	renamed := role.Name != existingRole.Name
+	if existingRole.Name == domain.Admin || existingRole.IsBuiltIn() && renamed {
+		return nil, domain.ErrBuiltInRole
+	}
+
+	sameData := !renamed && samePermissions(existingRole.Permissions, role.Permissions)
+	if sameData {
+		return nil, domain.ErrNoUpdatedData
+	}
+
+	role, err = rs.repo.UpdateRole(ctx, role)
+	if err != nil {
+		if err == domain.ErrConflictingData || err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	err = revokeRoleTokens(ctx, rs.cache, existingRole.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// DeleteRole deletes a role by id, if it is not built in and no user is given it
+func (rs *RoleService) DeleteRole(ctx context.Context, id uint64) error {
+	role, err := rs.repo.GetRoleByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	if role.IsBuiltIn() {
+		return domain.ErrBuiltInRole
+	}
+
+	err = rs.repo.DeleteRole(ctx, id)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	return nil
+}
+
+// samePermissions checks whether two lists hold the same permissions, regardless of order
+func samePermissions(a, b []domain.Permission) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[domain.Permission]bool, len(a))
+	for _, permission := range a {
+		set[permission] = true
+	}
+
+	for _, permission := range b {
+		if !set[permission] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// revokeRoleTokens revokes the access tokens issued until now with the name and permissions of a role.
+// The revocation time is kept without expiry, as it only rejects tokens issued before it
+func revokeRoleTokens(ctx context.Context, cache port.CacheRepository, name domain.UserRole) error {
+	cacheKey := util.GenerateCacheKey("revoked_role", name)
+	nowSerialized, err := util.Serialize(time.Now())
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	err = cache.Set(ctx, cacheKey, nowSerialized, 0)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
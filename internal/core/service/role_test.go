@@ -0,0 +1,247 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRoleService_UpdateRole(t *testing.T) {
+	ctx := context.Background()
+	adminRole := &domain.Role{
+		ID:          1,
+		Name:        domain.Admin,
+		Permissions: domain.Permissions,
+	}
+	cashier := &domain.Role{
+		ID:          2,
+		Name:        domain.Cashier,
+		Permissions: []domain.Permission{domain.AuthPINLogin},
+	}
+	supervisor := &domain.Role{
+		ID:          3,
+		Name:        "supervisor",
+		Permissions: []domain.Permission{domain.ReportsView},
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(roleRepo *mock.MockRoleRepository, cache *mock.MockCacheRepository)
+		input    *domain.Role
+		expected error
+	}{
+		{
+			desc: "Success_Permissions",
+			mocks: func(roleRepo *mock.MockRoleRepository, cache *mock.MockCacheRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(supervisor.ID)).
+					Times(1).
+					Return(supervisor, nil)
+				roleRepo.EXPECT().
+					UpdateRole(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, role *domain.Role) (*domain.Role, error) {
+						return role, nil
+					})
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq("revoked_role:supervisor"), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			input: &domain.Role{
+				ID:          supervisor.ID,
+				Permissions: []domain.Permission{domain.ReportsView, domain.ProductsEditPrice},
+			},
+			expected: nil,
+		},
+		{
+			desc: "Success_CashierPermissions",
+			mocks: func(roleRepo *mock.MockRoleRepository, cache *mock.MockCacheRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(cashier.ID)).
+					Times(1).
+					Return(cashier, nil)
+				roleRepo.EXPECT().
+					UpdateRole(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, role *domain.Role) (*domain.Role, error) {
+						return role, nil
+					})
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq("revoked_role:cashier"), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			input: &domain.Role{
+				ID:          cashier.ID,
+				Name:        domain.Cashier,
+				Permissions: []domain.Permission{domain.AuthPINLogin, domain.InventoryManage},
+			},
+			expected: nil,
+		},
+		{
+			desc: "Fail_AdminRole",
+			mocks: func(roleRepo *mock.MockRoleRepository, cache *mock.MockCacheRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(adminRole.ID)).
+					Times(1).
+					Return(adminRole, nil)
+			},
+			input: &domain.Role{
+				ID:          adminRole.ID,
+				Permissions: []domain.Permission{domain.ReportsView},
+			},
+			expected: domain.ErrBuiltInRole,
+		},
+		{
+			desc: "Fail_RenameBuiltInRole",
+			mocks: func(roleRepo *mock.MockRoleRepository, cache *mock.MockCacheRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(cashier.ID)).
+					Times(1).
+					Return(cashier, nil)
+			},
+			input: &domain.Role{
+				ID:   cashier.ID,
+				Name: "clerk",
+			},
+			expected: domain.ErrBuiltInRole,
+		},
+		{
+			desc: "Fail_NoUpdatedData",
+			mocks: func(roleRepo *mock.MockRoleRepository, cache *mock.MockCacheRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(supervisor.ID)).
+					Times(1).
+					Return(supervisor, nil)
+			},
+			input: &domain.Role{
+				ID:          supervisor.ID,
+				Name:        supervisor.Name,
+				Permissions: []domain.Permission{domain.ReportsView},
+			},
+			expected: domain.ErrNoUpdatedData,
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(roleRepo *mock.MockRoleRepository, cache *mock.MockCacheRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(uint64(4))).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: &domain.Role{
+				ID:   4,
+				Name: "auditor",
+			},
+			expected: domain.ErrDataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(roleRepo, cache)
+
+			roleService := service.NewRoleService(roleRepo, cache)
+
+			_, err := roleService.UpdateRole(ctx, tc.input)
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestRoleService_DeleteRole(t *testing.T) {
+	ctx := context.Background()
+	cashier := &domain.Role{
+		ID:   2,
+		Name: domain.Cashier,
+	}
+	supervisor := &domain.Role{
+		ID:   3,
+		Name: "supervisor",
+	}
+
+	testCases := []struct {
+		desc     string
+		mocks    func(roleRepo *mock.MockRoleRepository)
+		input    uint64
+		expected error
+	}{
+		{
+			desc: "Success",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(supervisor.ID)).
+					Times(1).
+					Return(supervisor, nil)
+				roleRepo.EXPECT().
+					DeleteRole(gomock.Any(), gomock.Eq(supervisor.ID)).
+					Times(1).
+					Return(nil)
+			},
+			input:    supervisor.ID,
+			expected: nil,
+		},
+		{
+			desc: "Fail_BuiltInRole",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(cashier.ID)).
+					Times(1).
+					Return(cashier, nil)
+			},
+			input:    cashier.ID,
+			expected: domain.ErrBuiltInRole,
+		},
+		{
+			desc: "Fail_GivenToUsers",
+			mocks: func(roleRepo *mock.MockRoleRepository) {
+				roleRepo.EXPECT().
+					GetRoleByID(gomock.Any(), gomock.Eq(supervisor.ID)).
+					Times(1).
+					Return(supervisor, nil)
+				roleRepo.EXPECT().
+					DeleteRole(gomock.Any(), gomock.Eq(supervisor.ID)).
+					Times(1).
+					Return(domain.ErrConflictingData)
+			},
+			input:    supervisor.ID,
+			expected: domain.ErrConflictingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(roleRepo)
+
+			roleService := service.NewRoleService(roleRepo, cache)
+
+			err := roleService.DeleteRole(ctx, tc.input)
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+/**
+ * SerialService implements port.SerialService interface
+ * and provides an access to the serial, product and order repositories
+ * and cache service
+ */
+type SerialService struct {
+	serialRepo  port.SerialRepository
+	productRepo port.ProductRepository
+	orderRepo   port.OrderRepository
+	cache       port.CacheRepository
+}
+
+// NewSerialService creates a new serial service instance
+func NewSerialService(serialRepo port.SerialRepository, productRepo port.ProductRepository, orderRepo port.OrderRepository, cache port.CacheRepository) *SerialService {
+	return &SerialService{
+		serialRepo,
+		productRepo,
+		orderRepo,
+		cache,
+	}
+}
+
+// CreateSerials receives new serial numbers and adds them to the product stock at the given cost per unit
+func (ss *SerialService) CreateSerials(ctx context.Context, productID uint64, serialNumbers []string, cost float64) ([]domain.Serial, error) {
+	product, err := ss.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if !product.TrackSerials {
+		return nil, domain.ErrSerialNotTracked
+	}
+
+	if hasDuplicates(serialNumbers) {
+		return nil, domain.ErrConflictingData
+	}
+
+	serials, err := ss.serialRepo.CreateSerials(ctx, productID, serialNumbers, cost)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	cacheKey := util.GenerateCacheKey("product", productID)
+
+	err = ss.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = ss.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return serials, nil
+}
+
+// ListSerials retrieves a list of serials of a product
+func (ss *SerialService) ListSerials(ctx context.Context, productID uint64, status domain.SerialStatus, skip, limit uint64) ([]domain.Serial, error) {
+	product, err := ss.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	serials, err := ss.serialRepo.ListSerials(ctx, productID, status, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	for i := range serials {
+		serials[i].Product = product
+	}
+
+	return serials, nil
+}
+
+// SearchSerials retrieves the serials with the given serial number and the orders they were sold on
+func (ss *SerialService) SearchSerials(ctx context.Context, serialNumber string) ([]domain.Serial, error) {
+	serials, err := ss.serialRepo.ListSerialsBySerialNumber(ctx, serialNumber)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	if len(serials) == 0 {
+		return nil, domain.ErrDataNotFound
+	}
+
+	for i, serial := range serials {
+		product, err := ss.productRepo.GetProductByID(ctx, serial.ProductID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		serials[i].Product = product
+
+		if serial.OrderID == 0 {
+			continue
+		}
+
+		order, err := ss.orderRepo.GetOrderByID(ctx, serial.OrderID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		serials[i].Order = order
+	}
+
+	return serials, nil
+}
+
+// hasDuplicates reports whether the given values contain any duplicate
+func hasDuplicates(values []string) bool {
+	seen := make(map[string]bool, len(values))
+
+	for _, value := range values {
+		if seen[value] {
+			return true
+		}
+		seen[value] = true
+	}
+
+	return false
+}
@@ -0,0 +1,433 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type createSerialsTestedInput struct {
+	productID     uint64
+	serialNumbers []string
+}
+
+type createSerialsExpectedOutput struct {
+	serials []domain.Serial
+	err     error
+}
+
+func TestSerialService_CreateSerials(t *testing.T) {
+	ctx := context.Background()
+	productID := gofakeit.Uint64()
+	product := &domain.Product{
+		ID:           productID,
+		Name:         gofakeit.ProductName(),
+		TrackSerials: true,
+	}
+	untrackedProduct := &domain.Product{
+		ID:   productID,
+		Name: product.Name,
+	}
+
+	serialNumbers := []string{gofakeit.UUID(), gofakeit.UUID()}
+	duplicateSerialNumbers := []string{serialNumbers[0], serialNumbers[0]}
+
+	var serials []domain.Serial
+	for _, serialNumber := range serialNumbers {
+		serials = append(serials, domain.Serial{
+			ID:           gofakeit.Uint64(),
+			ProductID:    productID,
+			SerialNumber: serialNumber,
+			Status:       domain.SerialInStock,
+		})
+	}
+
+	cacheKey := util.GenerateCacheKey("product", productID)
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			serialRepo *mock.MockSerialRepository,
+			productRepo *mock.MockProductRepository,
+			cache *mock.MockCacheRepository,
+		)
+		input    createSerialsTestedInput
+		expected createSerialsExpectedOutput
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				serialRepo.EXPECT().
+					CreateSerials(gomock.Any(), gomock.Eq(productID), gomock.Eq(serialNumbers), gomock.Eq(float64(0))).
+					Times(1).
+					Return(serials, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			input: createSerialsTestedInput{
+				productID:     productID,
+				serialNumbers: serialNumbers,
+			},
+			expected: createSerialsExpectedOutput{
+				serials: serials,
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFoundGetProduct",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: createSerialsTestedInput{
+				productID:     productID,
+				serialNumbers: serialNumbers,
+			},
+			expected: createSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_SerialNotTracked",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(untrackedProduct, nil)
+			},
+			input: createSerialsTestedInput{
+				productID:     productID,
+				serialNumbers: serialNumbers,
+			},
+			expected: createSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrSerialNotTracked,
+			},
+		},
+		{
+			desc: "Fail_DuplicateSerialNumbers",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: createSerialsTestedInput{
+				productID:     productID,
+				serialNumbers: duplicateSerialNumbers,
+			},
+			expected: createSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrConflictingData,
+			},
+		},
+		{
+			desc: "Fail_DuplicateData",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				serialRepo.EXPECT().
+					CreateSerials(gomock.Any(), gomock.Eq(productID), gomock.Eq(serialNumbers), gomock.Eq(float64(0))).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			input: createSerialsTestedInput{
+				productID:     productID,
+				serialNumbers: serialNumbers,
+			},
+			expected: createSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrConflictingData,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(productID)).
+					Times(1).
+					Return(product, nil)
+				serialRepo.EXPECT().
+					CreateSerials(gomock.Any(), gomock.Eq(productID), gomock.Eq(serialNumbers), gomock.Eq(float64(0))).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: createSerialsTestedInput{
+				productID:     productID,
+				serialNumbers: serialNumbers,
+			},
+			expected: createSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serialRepo := mock.NewMockSerialRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			orderRepo := mock.NewMockOrderRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(serialRepo, productRepo, cache)
+
+			serialService := service.NewSerialService(serialRepo, productRepo, orderRepo, cache)
+
+			serials, err := serialService.CreateSerials(ctx, tc.input.productID, tc.input.serialNumbers, float64(0))
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.serials, serials, "Serials mismatch")
+		})
+	}
+}
+
+type searchSerialsTestedInput struct {
+	serialNumber string
+}
+
+type searchSerialsExpectedOutput struct {
+	serials []domain.Serial
+	err     error
+}
+
+func TestSerialService_SearchSerials(t *testing.T) {
+	ctx := context.Background()
+	serialNumber := gofakeit.UUID()
+	product := &domain.Product{
+		ID:           gofakeit.Uint64(),
+		Name:         gofakeit.ProductName(),
+		TrackSerials: true,
+	}
+	order := &domain.Order{
+		ID:          gofakeit.Uint64(),
+		ReceiptCode: uuid.New(),
+	}
+
+	soldSerial := domain.Serial{
+		ID:             gofakeit.Uint64(),
+		ProductID:      product.ID,
+		SerialNumber:   serialNumber,
+		Status:         domain.SerialSold,
+		OrderProductID: gofakeit.Uint64(),
+		OrderID:        order.ID,
+	}
+	inStockSerial := domain.Serial{
+		ID:           gofakeit.Uint64(),
+		ProductID:    product.ID,
+		SerialNumber: serialNumber,
+		Status:       domain.SerialInStock,
+	}
+
+	soldSerialOutput := soldSerial
+	soldSerialOutput.Product = product
+	soldSerialOutput.Order = order
+
+	inStockSerialOutput := inStockSerial
+	inStockSerialOutput.Product = product
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			serialRepo *mock.MockSerialRepository,
+			productRepo *mock.MockProductRepository,
+			orderRepo *mock.MockOrderRepository,
+		)
+		input    searchSerialsTestedInput
+		expected searchSerialsExpectedOutput
+	}{
+		{
+			desc: "Success_Sold",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				orderRepo *mock.MockOrderRepository,
+			) {
+				serialRepo.EXPECT().
+					ListSerialsBySerialNumber(gomock.Any(), gomock.Eq(serialNumber)).
+					Times(1).
+					Return([]domain.Serial{soldSerial}, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				orderRepo.EXPECT().
+					GetOrderByID(gomock.Any(), gomock.Eq(order.ID)).
+					Times(1).
+					Return(order, nil)
+			},
+			input: searchSerialsTestedInput{
+				serialNumber: serialNumber,
+			},
+			expected: searchSerialsExpectedOutput{
+				serials: []domain.Serial{soldSerialOutput},
+				err:     nil,
+			},
+		},
+		{
+			desc: "Success_InStock",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				orderRepo *mock.MockOrderRepository,
+			) {
+				serialRepo.EXPECT().
+					ListSerialsBySerialNumber(gomock.Any(), gomock.Eq(serialNumber)).
+					Times(1).
+					Return([]domain.Serial{inStockSerial}, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+			},
+			input: searchSerialsTestedInput{
+				serialNumber: serialNumber,
+			},
+			expected: searchSerialsExpectedOutput{
+				serials: []domain.Serial{inStockSerialOutput},
+				err:     nil,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				orderRepo *mock.MockOrderRepository,
+			) {
+				serialRepo.EXPECT().
+					ListSerialsBySerialNumber(gomock.Any(), gomock.Eq(serialNumber)).
+					Times(1).
+					Return(nil, nil)
+			},
+			input: searchSerialsTestedInput{
+				serialNumber: serialNumber,
+			},
+			expected: searchSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				orderRepo *mock.MockOrderRepository,
+			) {
+				serialRepo.EXPECT().
+					ListSerialsBySerialNumber(gomock.Any(), gomock.Eq(serialNumber)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: searchSerialsTestedInput{
+				serialNumber: serialNumber,
+			},
+			expected: searchSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+		{
+			desc: "Fail_InternalErrorGetOrder",
+			mocks: func(
+				serialRepo *mock.MockSerialRepository,
+				productRepo *mock.MockProductRepository,
+				orderRepo *mock.MockOrderRepository,
+			) {
+				serialRepo.EXPECT().
+					ListSerialsBySerialNumber(gomock.Any(), gomock.Eq(serialNumber)).
+					Times(1).
+					Return([]domain.Serial{soldSerial}, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(product.ID)).
+					Times(1).
+					Return(product, nil)
+				orderRepo.EXPECT().
+					GetOrderByID(gomock.Any(), gomock.Eq(order.ID)).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: searchSerialsTestedInput{
+				serialNumber: serialNumber,
+			},
+			expected: searchSerialsExpectedOutput{
+				serials: nil,
+				err:     domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			serialRepo := mock.NewMockSerialRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			orderRepo := mock.NewMockOrderRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(serialRepo, productRepo, orderRepo)
+
+			serialService := service.NewSerialService(serialRepo, productRepo, orderRepo, cache)
+
+			serials, err := serialService.SearchSerials(ctx, tc.input.serialNumber)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.serials, serials, "Serials mismatch")
+		})
+	}
+}
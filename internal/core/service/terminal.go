@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+/**
+ * TerminalService implements port.TerminalService interface
+ * and provides an access to the terminal repository
+ */
+type TerminalService struct {
+	repo port.TerminalRepository
+}
+
+// NewTerminalService creates a new terminal service instance
+func NewTerminalService(repo port.TerminalRepository) *TerminalService {
+	return &TerminalService{
+		repo,
+	}
+}
+
+// RegisterTerminal registers a new terminal with a random credential,
+// which is only returned here as just its hash is stored
+func (ts *TerminalService) RegisterTerminal(ctx context.Context, terminal *domain.Terminal) (*domain.Terminal, error) {
+	credential, err := util.GenerateToken()
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	terminal.CredentialHash = util.HashToken(credential)
+
+	terminal, err = ts.repo.CreateTerminal(ctx, terminal)
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	terminal.Credential = credential
+
+	return terminal, nil
+}
+
+// ListTerminals lists all registered terminals
+func (ts *TerminalService) ListTerminals(ctx context.Context) ([]domain.Terminal, error) {
+	terminals, err := ts.repo.ListTerminals(ctx)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return terminals, nil
+}
+
+// DeleteTerminal deletes a terminal by id
+func (ts *TerminalService) DeleteTerminal(ctx context.Context, id uint64) error {
+	err := ts.repo.DeleteTerminal(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	return nil
+}
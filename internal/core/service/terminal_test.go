@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTerminalService_RegisterTerminal(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		desc     string
+		mocks    func(terminalRepo *mock.MockTerminalRepository)
+		expected error
+	}{
+		{
+			desc: "Success",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					CreateTerminal(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, terminal *domain.Terminal) (*domain.Terminal, error) {
+						terminal.ID = 1
+						return terminal, nil
+					})
+			},
+			expected: nil,
+		},
+		{
+			desc: "Fail_DuplicateName",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					CreateTerminal(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			expected: domain.ErrConflictingData,
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(terminalRepo *mock.MockTerminalRepository) {
+				terminalRepo.EXPECT().
+					CreateTerminal(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			expected: domain.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			terminalRepo := mock.NewMockTerminalRepository(ctrl)
+			tc.mocks(terminalRepo)
+
+			terminalService := service.NewTerminalService(terminalRepo)
+
+			terminal, err := terminalService.RegisterTerminal(ctx, &domain.Terminal{Name: "Register 1"})
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if terminal.Credential == "" {
+				t.Errorf("[case: %s] expected the credential to be returned", tc.desc)
+			}
+			if terminal.CredentialHash != util.HashToken(terminal.Credential) {
+				t.Errorf("[case: %s] expected only the hash of the credential to be stored", tc.desc)
+			}
+		})
+	}
+}
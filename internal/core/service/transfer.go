@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port"
+	"github.com/bagashiz/go-pos/internal/core/util"
+)
+
+/**
+ * TransferService implements port.TransferService interface
+ * and provides an access to the transfer, location and product repositories
+ * and cache service
+ */
+type TransferService struct {
+	repo         port.TransferRepository
+	locationRepo port.LocationRepository
+	productRepo  port.ProductRepository
+	cache        port.CacheRepository
+}
+
+// NewTransferService creates a new transfer service instance
+func NewTransferService(repo port.TransferRepository, locationRepo port.LocationRepository, productRepo port.ProductRepository, cache port.CacheRepository) *TransferService {
+	return &TransferService{
+		repo,
+		locationRepo,
+		productRepo,
+		cache,
+	}
+}
+
+// CreateTransfer requests a transfer of the quantities of products, in their base units, between two different locations.
+// Stock is only checked once the transfer is dispatched
+func (ts *TransferService) CreateTransfer(ctx context.Context, transfer *domain.Transfer) (*domain.Transfer, error) {
+	if transfer.FromLocationID == transfer.ToLocationID || len(transfer.Items) == 0 {
+		return nil, domain.ErrInvalidTransfer
+	}
+
+	products := make(map[uint64]*domain.Product)
+
+	for i, item := range transfer.Items {
+		if _, ok := products[item.ProductID]; ok {
+			return nil, domain.ErrInvalidTransfer
+		}
+
+		product, err := ts.productRepo.GetProductByID(ctx, item.ProductID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		if product.IsBundle || len(product.Options) > 0 || product.TrackLots || product.TrackSerials {
+			return nil, domain.ErrTransferNotAllowed
+		}
+
+		quantity, err := product.BaseQuantity(item.Quantity, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if quantity <= 0 {
+			return nil, domain.ErrInvalidTransfer
+		}
+
+		transfer.Items[i].Quantity = quantity
+
+		products[item.ProductID] = product
+	}
+
+	err := ts.loadLocations(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	fromLocation, toLocation := transfer.FromLocation, transfer.ToLocation
+
+	transfer, err = ts.repo.CreateTransfer(ctx, transfer)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	transfer.FromLocation, transfer.ToLocation = fromLocation, toLocation
+
+	for i, item := range transfer.Items {
+		transfer.Items[i].Product = products[item.ProductID]
+	}
+
+	return transfer, nil
+}
+
+// GetTransfer retrieves a transfer by id along with its items and stock movements
+func (ts *TransferService) GetTransfer(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	transfer, err := ts.repo.GetTransferByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	err = ts.loadTransfer(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// ListTransfers retrieves a list of transfers, of any status if it is empty, the latest first
+func (ts *TransferService) ListTransfers(ctx context.Context, status domain.TransferStatus, skip, limit uint64) ([]domain.Transfer, error) {
+	transfers, err := ts.repo.ListTransfers(ctx, status, skip, limit)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	for i := range transfers {
+		err = ts.loadLocations(ctx, &transfers[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return transfers, nil
+}
+
+// DispatchTransfer takes the items of a requested transfer out of the stock of its source location,
+// putting them in transit until the transfer is received
+func (ts *TransferService) DispatchTransfer(ctx context.Context, id, userID uint64) (*domain.Transfer, error) {
+	transfer, err := ts.repo.DispatchTransfer(ctx, id, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound || err == domain.ErrTransferStatus || err == domain.ErrInsufficientStock {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	err = ts.loadTransfer(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.invalidateStock(ctx, transfer, transfer.FromLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// ReceiveTransfer adds the quantity received of each product of a transfer in transit, in its base unit,
+// to the stock of its destination location. Quantities received that differ from those dispatched are kept
+// on the items as discrepancies
+func (ts *TransferService) ReceiveTransfer(ctx context.Context, id uint64, received []domain.TransferItem, userID uint64) (*domain.Transfer, error) {
+	transfer, err := ts.repo.GetTransferByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if transfer.Status != domain.TransferInTransit {
+		return nil, domain.ErrTransferStatus
+	}
+
+	quantities := make(map[uint64]float64)
+	for _, item := range received {
+		if _, ok := quantities[item.ProductID]; ok {
+			return nil, domain.ErrInvalidTransferReceipt
+		}
+
+		quantities[item.ProductID] = *item.ReceivedQuantity
+	}
+
+	if len(quantities) != len(transfer.Items) {
+		return nil, domain.ErrInvalidTransferReceipt
+	}
+
+	for i, item := range transfer.Items {
+		quantity, ok := quantities[item.ProductID]
+		if !ok {
+			return nil, domain.ErrInvalidTransferReceipt
+		}
+
+		product, err := ts.productRepo.GetProductByID(ctx, item.ProductID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return nil, err
+			}
+			return nil, domain.ErrInternal
+		}
+
+		quantity, err = product.BaseQuantity(quantity, "")
+		if err != nil {
+			return nil, err
+		}
+
+		transfer.Items[i].ReceivedQuantity = &quantity
+	}
+
+	transfer, err = ts.repo.ReceiveTransfer(ctx, transfer, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound || err == domain.ErrTransferStatus || err == domain.ErrInvalidTransferReceipt {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	err = ts.loadTransfer(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ts.invalidateStock(ctx, transfer, transfer.ToLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// CancelTransfer cancels a transfer that has not been dispatched yet
+func (ts *TransferService) CancelTransfer(ctx context.Context, id uint64) (*domain.Transfer, error) {
+	transfer, err := ts.repo.CancelTransfer(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound || err == domain.ErrTransferStatus {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	err = ts.loadTransfer(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// loadTransfer loads the locations of a transfer and the products of its items
+func (ts *TransferService) loadTransfer(ctx context.Context, transfer *domain.Transfer) error {
+	err := ts.loadLocations(ctx, transfer)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range transfer.Items {
+		product, err := ts.productRepo.GetProductByID(ctx, item.ProductID)
+		if err != nil {
+			if err == domain.ErrDataNotFound {
+				return err
+			}
+			return domain.ErrInternal
+		}
+
+		transfer.Items[i].Product = product
+	}
+
+	return nil
+}
+
+// loadLocations loads the source and destination locations of a transfer
+func (ts *TransferService) loadLocations(ctx context.Context, transfer *domain.Transfer) error {
+	fromLocation, err := ts.locationRepo.GetLocationByID(ctx, transfer.FromLocationID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	toLocation, err := ts.locationRepo.GetLocationByID(ctx, transfer.ToLocationID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	transfer.FromLocation = fromLocation
+	transfer.ToLocation = toLocation
+
+	return nil
+}
+
+// invalidateStock removes the cached products of a transfer when the stock moved in or out of the default location,
+// the stock of the products themselves
+func (ts *TransferService) invalidateStock(ctx context.Context, transfer *domain.Transfer, location *domain.Location) error {
+	if !location.IsDefault {
+		return nil
+	}
+
+	for _, item := range transfer.Items {
+		err := ts.cache.Delete(ctx, util.GenerateCacheKey("product", item.ProductID))
+		if err != nil {
+			return domain.ErrInternal
+		}
+	}
+
+	err := ts.cache.DeleteByPrefix(ctx, "products:*")
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
+}
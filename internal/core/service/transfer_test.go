@@ -0,0 +1,741 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type transferTestFixture struct {
+	transferID   uint64
+	userID       uint64
+	fromLocation *domain.Location
+	toLocation   *domain.Location
+	product      *domain.Product
+}
+
+func newTransferTestFixture() transferTestFixture {
+	return transferTestFixture{
+		transferID: gofakeit.Uint64(),
+		userID:     gofakeit.Uint64(),
+		fromLocation: &domain.Location{
+			ID:        1,
+			Name:      "Store",
+			IsDefault: true,
+		},
+		toLocation: &domain.Location{
+			ID:   2,
+			Name: gofakeit.City(),
+		},
+		product: &domain.Product{
+			ID:   gofakeit.Uint64(),
+			Name: gofakeit.ProductName(),
+		},
+	}
+}
+
+// transfer returns a new transfer of the fixture product with the given status, so that every test case
+// gets its own copy to be changed by the service
+func (f transferTestFixture) transfer(status domain.TransferStatus, received *float64) *domain.Transfer {
+	return &domain.Transfer{
+		ID:             f.transferID,
+		FromLocationID: f.fromLocation.ID,
+		ToLocationID:   f.toLocation.ID,
+		UserID:         f.userID,
+		Status:         status,
+		Items: []domain.TransferItem{
+			{
+				TransferID:       f.transferID,
+				ProductID:        f.product.ID,
+				Quantity:         10,
+				ReceivedQuantity: received,
+			},
+		},
+	}
+}
+
+// loaded returns a transfer with its locations and products loaded, as returned by the service
+func (f transferTestFixture) loaded(transfer *domain.Transfer) *domain.Transfer {
+	transfer.FromLocation = f.fromLocation
+	transfer.ToLocation = f.toLocation
+	for i := range transfer.Items {
+		transfer.Items[i].Product = f.product
+	}
+
+	return transfer
+}
+
+func TestTransferService_CreateTransfer(t *testing.T) {
+	ctx := context.Background()
+	f := newTransferTestFixture()
+
+	lotProduct := &domain.Product{
+		ID:        f.product.ID,
+		Name:      f.product.Name,
+		TrackLots: true,
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			transferRepo *mock.MockTransferRepository,
+			locationRepo *mock.MockLocationRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		input    func() *domain.Transfer
+		expected struct {
+			transfer *domain.Transfer
+			err      error
+		}
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.fromLocation.ID)).
+					Times(1).
+					Return(f.fromLocation, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.toLocation.ID)).
+					Times(1).
+					Return(f.toLocation, nil)
+				transferRepo.EXPECT().
+					CreateTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(f.transfer(domain.TransferRequested, nil), nil)
+			},
+			input: func() *domain.Transfer {
+				return f.transfer("", nil)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: f.loaded(f.transfer(domain.TransferRequested, nil)),
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_SameLocation",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+			},
+			input: func() *domain.Transfer {
+				transfer := f.transfer("", nil)
+				transfer.ToLocationID = transfer.FromLocationID
+				return transfer
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrInvalidTransfer,
+			},
+		},
+		{
+			desc: "Fail_DuplicateProduct",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+			},
+			input: func() *domain.Transfer {
+				transfer := f.transfer("", nil)
+				transfer.Items = append(transfer.Items, transfer.Items[0])
+				return transfer
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrInvalidTransfer,
+			},
+		},
+		{
+			desc: "Fail_InvalidQuantity",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+			},
+			input: func() *domain.Transfer {
+				transfer := f.transfer("", nil)
+				transfer.Items[0].Quantity = 0
+				return transfer
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrInvalidTransfer,
+			},
+		},
+		{
+			desc: "Fail_LotProduct",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(lotProduct, nil)
+			},
+			input: func() *domain.Transfer {
+				return f.transfer("", nil)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrTransferNotAllowed,
+			},
+		},
+		{
+			desc: "Fail_FractionalQuantity",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+			},
+			input: func() *domain.Transfer {
+				transfer := f.transfer("", nil)
+				transfer.Items[0].Quantity = 1.5
+				return transfer
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrFractionalQuantity,
+			},
+		},
+		{
+			desc: "Fail_NotFoundLocation",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.fromLocation.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: func() *domain.Transfer {
+				return f.transfer("", nil)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.fromLocation.ID)).
+					Times(1).
+					Return(f.fromLocation, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.toLocation.ID)).
+					Times(1).
+					Return(f.toLocation, nil)
+				transferRepo.EXPECT().
+					CreateTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, domain.ErrInternal)
+			},
+			input: func() *domain.Transfer {
+				return f.transfer("", nil)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo := mock.NewMockTransferRepository(ctrl)
+			locationRepo := mock.NewMockLocationRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(transferRepo, locationRepo, productRepo)
+
+			transferService := service.NewTransferService(transferRepo, locationRepo, productRepo, cache)
+
+			transfer, err := transferService.CreateTransfer(ctx, tc.input())
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.transfer, transfer, "Transfer mismatch")
+		})
+	}
+}
+
+func TestTransferService_DispatchTransfer(t *testing.T) {
+	ctx := context.Background()
+	f := newTransferTestFixture()
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			transferRepo *mock.MockTransferRepository,
+			locationRepo *mock.MockLocationRepository,
+			productRepo *mock.MockProductRepository,
+			cache *mock.MockCacheRepository,
+		)
+		expected struct {
+			transfer *domain.Transfer
+			err      error
+		}
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				transferRepo.EXPECT().
+					DispatchTransfer(gomock.Any(), gomock.Eq(f.transferID), gomock.Eq(f.userID)).
+					Times(1).
+					Return(f.transfer(domain.TransferInTransit, nil), nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.fromLocation.ID)).
+					Times(1).
+					Return(f.fromLocation, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.toLocation.ID)).
+					Times(1).
+					Return(f.toLocation, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(util.GenerateCacheKey("product", f.product.ID))).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("products:*")).
+					Times(1).
+					Return(nil)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: f.loaded(f.transfer(domain.TransferInTransit, nil)),
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_TransferStatus",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				transferRepo.EXPECT().
+					DispatchTransfer(gomock.Any(), gomock.Eq(f.transferID), gomock.Eq(f.userID)).
+					Times(1).
+					Return(nil, domain.ErrTransferStatus)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrTransferStatus,
+			},
+		},
+		{
+			desc: "Fail_InsufficientStock",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				transferRepo.EXPECT().
+					DispatchTransfer(gomock.Any(), gomock.Eq(f.transferID), gomock.Eq(f.userID)).
+					Times(1).
+					Return(nil, domain.ErrInsufficientStock)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrInsufficientStock,
+			},
+		},
+		{
+			desc: "Fail_InternalError",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				transferRepo.EXPECT().
+					DispatchTransfer(gomock.Any(), gomock.Eq(f.transferID), gomock.Eq(f.userID)).
+					Times(1).
+					Return(nil, domain.ErrConflictingData)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrInternal,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo := mock.NewMockTransferRepository(ctrl)
+			locationRepo := mock.NewMockLocationRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(transferRepo, locationRepo, productRepo, cache)
+
+			transferService := service.NewTransferService(transferRepo, locationRepo, productRepo, cache)
+
+			transfer, err := transferService.DispatchTransfer(ctx, f.transferID, f.userID)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.transfer, transfer, "Transfer mismatch")
+		})
+	}
+}
+
+func TestTransferService_ReceiveTransfer(t *testing.T) {
+	ctx := context.Background()
+	f := newTransferTestFixture()
+
+	short := float64(8)
+	received := []domain.TransferItem{
+		{
+			ProductID:        f.product.ID,
+			ReceivedQuantity: &short,
+		},
+	}
+	unknown := []domain.TransferItem{
+		{
+			ProductID:        f.product.ID + 1,
+			ReceivedQuantity: &short,
+		},
+	}
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			transferRepo *mock.MockTransferRepository,
+			locationRepo *mock.MockLocationRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		input    []domain.TransferItem
+		expected struct {
+			transfer    *domain.Transfer
+			discrepancy float64
+			err         error
+		}
+	}{
+		{
+			desc: "Success_Discrepancy",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				transferRepo.EXPECT().
+					GetTransferByID(gomock.Any(), gomock.Eq(f.transferID)).
+					Times(1).
+					Return(f.transfer(domain.TransferInTransit, nil), nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(2).
+					Return(f.product, nil)
+				transferRepo.EXPECT().
+					ReceiveTransfer(gomock.Any(), gomock.Eq(f.transfer(domain.TransferInTransit, &short)), gomock.Eq(f.userID)).
+					Times(1).
+					Return(f.transfer(domain.TransferReceived, &short), nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.fromLocation.ID)).
+					Times(1).
+					Return(f.fromLocation, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.toLocation.ID)).
+					Times(1).
+					Return(f.toLocation, nil)
+			},
+			input: received,
+			expected: struct {
+				transfer    *domain.Transfer
+				discrepancy float64
+				err         error
+			}{
+				transfer:    f.loaded(f.transfer(domain.TransferReceived, &short)),
+				discrepancy: 2,
+				err:         nil,
+			},
+		},
+		{
+			desc: "Fail_TransferStatus",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				transferRepo.EXPECT().
+					GetTransferByID(gomock.Any(), gomock.Eq(f.transferID)).
+					Times(1).
+					Return(f.transfer(domain.TransferRequested, nil), nil)
+			},
+			input: received,
+			expected: struct {
+				transfer    *domain.Transfer
+				discrepancy float64
+				err         error
+			}{
+				transfer: nil,
+				err:      domain.ErrTransferStatus,
+			},
+		},
+		{
+			desc: "Fail_MissingItem",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				transferRepo.EXPECT().
+					GetTransferByID(gomock.Any(), gomock.Eq(f.transferID)).
+					Times(1).
+					Return(f.transfer(domain.TransferInTransit, nil), nil)
+			},
+			input: unknown,
+			expected: struct {
+				transfer    *domain.Transfer
+				discrepancy float64
+				err         error
+			}{
+				transfer: nil,
+				err:      domain.ErrInvalidTransferReceipt,
+			},
+		},
+		{
+			desc: "Fail_NotFound",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				transferRepo.EXPECT().
+					GetTransferByID(gomock.Any(), gomock.Eq(f.transferID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+			},
+			input: received,
+			expected: struct {
+				transfer    *domain.Transfer
+				discrepancy float64
+				err         error
+			}{
+				transfer: nil,
+				err:      domain.ErrDataNotFound,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo := mock.NewMockTransferRepository(ctrl)
+			locationRepo := mock.NewMockLocationRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(transferRepo, locationRepo, productRepo)
+
+			transferService := service.NewTransferService(transferRepo, locationRepo, productRepo, cache)
+
+			transfer, err := transferService.ReceiveTransfer(ctx, f.transferID, tc.input, f.userID)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.transfer, transfer, "Transfer mismatch")
+			if transfer != nil {
+				assert.Equal(t, tc.expected.discrepancy, transfer.Items[0].Discrepancy(), "Discrepancy mismatch")
+			}
+		})
+	}
+}
+
+func TestTransferService_CancelTransfer(t *testing.T) {
+	ctx := context.Background()
+	f := newTransferTestFixture()
+
+	testCases := []struct {
+		desc  string
+		mocks func(
+			transferRepo *mock.MockTransferRepository,
+			locationRepo *mock.MockLocationRepository,
+			productRepo *mock.MockProductRepository,
+		)
+		expected struct {
+			transfer *domain.Transfer
+			err      error
+		}
+	}{
+		{
+			desc: "Success",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				transferRepo.EXPECT().
+					CancelTransfer(gomock.Any(), gomock.Eq(f.transferID)).
+					Times(1).
+					Return(f.transfer(domain.TransferCanceled, nil), nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.fromLocation.ID)).
+					Times(1).
+					Return(f.fromLocation, nil)
+				locationRepo.EXPECT().
+					GetLocationByID(gomock.Any(), gomock.Eq(f.toLocation.ID)).
+					Times(1).
+					Return(f.toLocation, nil)
+				productRepo.EXPECT().
+					GetProductByID(gomock.Any(), gomock.Eq(f.product.ID)).
+					Times(1).
+					Return(f.product, nil)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: f.loaded(f.transfer(domain.TransferCanceled, nil)),
+				err:      nil,
+			},
+		},
+		{
+			desc: "Fail_TransferStatus",
+			mocks: func(
+				transferRepo *mock.MockTransferRepository,
+				locationRepo *mock.MockLocationRepository,
+				productRepo *mock.MockProductRepository,
+			) {
+				transferRepo.EXPECT().
+					CancelTransfer(gomock.Any(), gomock.Eq(f.transferID)).
+					Times(1).
+					Return(nil, domain.ErrTransferStatus)
+			},
+			expected: struct {
+				transfer *domain.Transfer
+				err      error
+			}{
+				transfer: nil,
+				err:      domain.ErrTransferStatus,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			transferRepo := mock.NewMockTransferRepository(ctrl)
+			locationRepo := mock.NewMockLocationRepository(ctrl)
+			productRepo := mock.NewMockProductRepository(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+
+			tc.mocks(transferRepo, locationRepo, productRepo)
+
+			transferService := service.NewTransferService(transferRepo, locationRepo, productRepo, cache)
+
+			transfer, err := transferService.CancelTransfer(ctx, f.transferID)
+			assert.Equal(t, tc.expected.err, err, "Error mismatch")
+			assert.Equal(t, tc.expected.transfer, transfer, "Transfer mismatch")
+		})
+	}
+}
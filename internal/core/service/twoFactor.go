@@ -0,0 +1,490 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"github.com/google/uuid"
+)
+
+const (
+	// twoFactorChallengeDuration is how long a login can be completed with a two-factor code
+	twoFactorChallengeDuration = 5 * time.Minute
+	// twoFactorMaxAttempts is the number of codes a two-factor challenge can be completed with before it is dropped
+	twoFactorMaxAttempts = 5
+	// totpReuseWindow is how long a used TOTP code is rejected for, covering the periods it is valid in
+	totpReuseWindow = 2 * time.Minute
+	// recoveryCodeCount is the number of recovery codes a user gets on enrollment or regeneration
+	recoveryCodeCount = 10
+)
+
+// requiresTwoFactor checks whether a user with a role cannot log in without two-factor authentication,
+// which is the case for every privileged role, whatever its name, while two-factor authentication is enforced
+func (as *AuthService) requiresTwoFactor(role *domain.Role) bool {
+	return as.enforceAdminTwoFactor && role.IsPrivileged()
+}
+
+// finishLogin issues the tokens of a user whose credentials are valid, unless the user has two-factor authentication enabled
+// or enforced, in which case it gives a two-factor challenge. A user whose two-factor authentication is enforced
+// but not enabled is enrolled, and completing the challenge confirms the enrollment.
+// The failed login attempts of the credentials are only forgotten once the login is complete,
+// so that guessing two-factor codes is throttled like guessing the credentials
+func (as *AuthService) finishLogin(ctx context.Context, user *domain.User, role *domain.Role, subject string, device domain.Device) (*domain.AuthToken, error) {
+	twoFactor, err := as.twoFactorRepo.GetTwoFactorByUserID(ctx, user.ID)
+	if err != nil && err != domain.ErrDataNotFound {
+		return nil, domain.ErrInternal
+	}
+
+	enabled := err == nil && twoFactor.IsEnabled()
+
+	if !enabled && !as.requiresTwoFactor(role) {
+		err = resetLoginFailures(ctx, as.cache, subject)
+		if err != nil {
+			return nil, err
+		}
+
+		familyID, err := uuid.NewRandom()
+		if err != nil {
+			return nil, domain.ErrTokenCreation
+		}
+
+		return as.issueTokens(ctx, user, role, familyID, device)
+	}
+
+	var enrollment *domain.TwoFactorEnrollment
+	if !enabled {
+		enrollment, err = as.enrollTwoFactor(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	challenge, err := as.createTwoFactorChallenge(ctx, user, subject, device)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge.Enrollment = enrollment
+
+	return &domain.AuthToken{
+		Challenge: challenge,
+	}, nil
+}
+
+// createTwoFactorChallenge stores a new two-factor challenge for the login of a user with the credentials of a subject
+// on a device until it expires. Only the hash of its token is used as the cache key
+func (as *AuthService) createTwoFactorChallenge(ctx context.Context, user *domain.User, subject string, device domain.Device) (*domain.TwoFactorChallenge, error) {
+	token, err := util.GenerateToken()
+	if err != nil {
+		return nil, domain.ErrTokenCreation
+	}
+
+	challenge := &domain.TwoFactorChallenge{
+		UserID:    user.ID,
+		Subject:   subject,
+		Device:    device,
+		ExpiresAt: time.Now().Add(twoFactorChallengeDuration),
+	}
+
+	challengeSerialized, err := util.Serialize(challenge)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	cacheKey := util.GenerateCacheKey("two_factor_challenge", util.HashToken(token))
+
+	err = as.cache.Set(ctx, cacheKey, challengeSerialized, twoFactorChallengeDuration)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	challenge.Token = token
+
+	return challenge, nil
+}
+
+// CompleteTwoFactor gives the user of a two-factor challenge an access token and a refresh token for the device
+// the login was challenged on, if the code is a valid TOTP code or an unused recovery code.
+// A challenge for an enrollment only accepts a TOTP code, which confirms the enrollment.
+// The challenge is dropped once it is completed or after too many invalid codes, and invalid codes count as
+// failed login attempts for the credentials and the client IP address of the login, which delay and lock out new challenges
+func (as *AuthService) CompleteTwoFactor(ctx context.Context, challengeToken, code string) (*domain.AuthToken, error) {
+	tokenHash := util.HashToken(challengeToken)
+	cacheKey := util.GenerateCacheKey("two_factor_challenge", tokenHash)
+
+	cachedChallenge, err := as.cache.Get(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInvalidTwoFactorChallenge
+	}
+
+	var challenge domain.TwoFactorChallenge
+	err = util.Deserialize(cachedChallenge, &challenge)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = checkLoginAttempts(ctx, as.cache, challenge.Subject, challenge.Device.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := as.cache.Increment(ctx, util.GenerateCacheKey("two_factor_attempts", tokenHash), twoFactorChallengeDuration)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	if attempts > twoFactorMaxAttempts {
+		err = as.cache.Delete(ctx, cacheKey)
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+		return nil, domain.ErrTooManyLoginAttempts
+	}
+
+	user, err := as.repo.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, domain.ErrInvalidTwoFactorChallenge
+		}
+		return nil, domain.ErrInternal
+	}
+
+	twoFactor, err := as.twoFactorRepo.GetTwoFactorByUserID(ctx, user.ID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			// Reset by an admin since the challenge was given
+			return nil, domain.ErrInvalidTwoFactorChallenge
+		}
+		return nil, domain.ErrInternal
+	}
+
+	if twoFactor.IsEnabled() {
+		err = as.verifyTwoFactorCode(ctx, user, twoFactor, code)
+	} else {
+		err = as.confirmTwoFactor(ctx, user, twoFactor, code)
+	}
+	if err != nil {
+		if err == domain.ErrInvalidTwoFactorCode {
+			return nil, as.loginFailed(ctx, challenge.Subject, user.ID, challenge.Device.IPAddress, err)
+		}
+		return nil, err
+	}
+
+	err = as.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	err = resetLoginFailures(ctx, as.cache, challenge.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := as.roleRepo.GetRoleByName(ctx, user.Role)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	familyID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, domain.ErrTokenCreation
+	}
+
+	return as.issueTokens(ctx, user, role, familyID, challenge.Device)
+}
+
+// EnrollTwoFactor gives a user a new TOTP secret, its provisioning URI and new recovery codes.
+// Two-factor authentication is only enabled once it is confirmed with a code generated from the secret,
+// and enrolling again before that replaces the secret and recovery codes
+func (as *AuthService) EnrollTwoFactor(ctx context.Context, userID uint64) (*domain.TwoFactorEnrollment, error) {
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, err
+		}
+		return nil, domain.ErrInternal
+	}
+
+	twoFactor, err := as.twoFactorRepo.GetTwoFactorByUserID(ctx, userID)
+	if err != nil && err != domain.ErrDataNotFound {
+		return nil, domain.ErrInternal
+	}
+
+	if err == nil && twoFactor.IsEnabled() {
+		return nil, domain.ErrTwoFactorEnabled
+	}
+
+	return as.enrollTwoFactor(ctx, user)
+}
+
+// ConfirmTwoFactor enables the enrolled two-factor authentication of a user if the code is valid for its secret
+func (as *AuthService) ConfirmTwoFactor(ctx context.Context, userID uint64, code string) error {
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	twoFactor, err := as.twoFactorRepo.GetTwoFactorByUserID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	if twoFactor.IsEnabled() {
+		return domain.ErrTwoFactorEnabled
+	}
+
+	return as.confirmTwoFactor(ctx, user, twoFactor, code)
+}
+
+// DisableTwoFactor removes the two-factor authentication of a user and their recovery codes if the code is valid,
+// unless two-factor authentication is enforced for the user
+func (as *AuthService) DisableTwoFactor(ctx context.Context, userID uint64, code string) error {
+	user, twoFactor, err := as.getEnabledTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	role, err := as.roleRepo.GetRoleByName(ctx, user.Role)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	if as.requiresTwoFactor(role) {
+		return domain.ErrTwoFactorRequired
+	}
+
+	err = as.verifyTwoFactorCode(ctx, user, twoFactor, code)
+	if err != nil {
+		return err
+	}
+
+	err = as.twoFactorRepo.DeleteTwoFactor(ctx, userID)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	as.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditTwoFactorDisabled,
+		ActorID:    user.ID,
+		UserID:     user.ID,
+		Subject:    user.Email,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of a user whose two-factor authentication is enabled
+// with new ones if the code is valid, so that the user does not run out of them
+func (as *AuthService) RegenerateRecoveryCodes(ctx context.Context, userID uint64, code string) ([]string, error) {
+	user, twoFactor, err := as.getEnabledTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = as.verifyTwoFactorCode(ctx, user, twoFactor, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return as.replaceRecoveryCodes(ctx, userID)
+}
+
+// ResetTwoFactor removes the two-factor authentication of a user and their recovery codes, on behalf of an admin,
+// for a user who lost both their authenticator app and recovery codes. A user whose two-factor authentication is enforced
+// enrolls again on the next login
+func (as *AuthService) ResetTwoFactor(ctx context.Context, userID, actorID uint64) error {
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	_, err = as.twoFactorRepo.GetTwoFactorByUserID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	err = as.twoFactorRepo.DeleteTwoFactor(ctx, userID)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	as.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditTwoFactorDisabled,
+		ActorID:    actorID,
+		UserID:     user.ID,
+		Subject:    user.Email,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
+
+// getEnabledTwoFactor gets a user and their two-factor authentication, only if it is enabled
+func (as *AuthService) getEnabledTwoFactor(ctx context.Context, userID uint64) (*domain.User, *domain.TwoFactor, error) {
+	user, err := as.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, nil, err
+		}
+		return nil, nil, domain.ErrInternal
+	}
+
+	twoFactor, err := as.twoFactorRepo.GetTwoFactorByUserID(ctx, userID)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return nil, nil, err
+		}
+		return nil, nil, domain.ErrInternal
+	}
+
+	if !twoFactor.IsEnabled() {
+		return nil, nil, domain.ErrDataNotFound
+	}
+
+	return user, twoFactor, nil
+}
+
+// enrollTwoFactor generates and stores a new TOTP secret and new recovery codes for a user
+func (as *AuthService) enrollTwoFactor(ctx context.Context, user *domain.User) (*domain.TwoFactorEnrollment, error) {
+	secret, uri, err := as.totp.GenerateSecret(user.Email)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	_, err = as.twoFactorRepo.SaveTwoFactor(ctx, &domain.TwoFactor{
+		UserID: user.ID,
+		Secret: secret,
+	})
+	if err != nil {
+		if err == domain.ErrConflictingData {
+			// Confirmed by a concurrent request since it was read
+			return nil, domain.ErrTwoFactorEnabled
+		}
+		return nil, domain.ErrInternal
+	}
+
+	recoveryCodes, err := as.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TwoFactorEnrollment{
+		Secret:        secret,
+		URI:           uri,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// confirmTwoFactor enables the two-factor authentication of a user if the code is a valid TOTP code for its secret
+func (as *AuthService) confirmTwoFactor(ctx context.Context, user *domain.User, twoFactor *domain.TwoFactor, code string) error {
+	if !as.totp.ValidateCode(twoFactor.Secret, code) {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	err := as.useTOTPCode(ctx, user.ID, code)
+	if err != nil {
+		return err
+	}
+
+	err = as.twoFactorRepo.ConfirmTwoFactor(ctx, user.ID, time.Now())
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			// Confirmed by a concurrent request since it was read
+			return domain.ErrTwoFactorEnabled
+		}
+		return domain.ErrInternal
+	}
+
+	as.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditTwoFactorEnabled,
+		ActorID:    user.ID,
+		UserID:     user.ID,
+		Subject:    user.Email,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
+
+// verifyTwoFactorCode checks that a code is either a valid TOTP code for the secret of a user
+// or an unused recovery code of the user, using it up
+func (as *AuthService) verifyTwoFactorCode(ctx context.Context, user *domain.User, twoFactor *domain.TwoFactor, code string) error {
+	if as.totp.ValidateCode(twoFactor.Secret, code) {
+		return as.useTOTPCode(ctx, user.ID, code)
+	}
+
+	codeHash := util.HashToken(util.NormalizeRecoveryCode(code))
+
+	err := as.twoFactorRepo.UseRecoveryCode(ctx, user.ID, codeHash, time.Now())
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return domain.ErrInvalidTwoFactorCode
+		}
+		return domain.ErrInternal
+	}
+
+	as.audit.Record(ctx, &domain.AuditEvent{
+		Type:       domain.AuditRecoveryCodeUsed,
+		ActorID:    user.ID,
+		UserID:     user.ID,
+		Subject:    user.Email,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
+
+// useTOTPCode rejects a TOTP code of a user that has already been used while it is still valid,
+// so that an intercepted code cannot be replayed
+func (as *AuthService) useTOTPCode(ctx context.Context, userID uint64, code string) error {
+	cacheKey := util.GenerateCacheKey("totp_used", util.GenerateCacheKeyParams(userID, code))
+
+	uses, err := as.cache.Increment(ctx, cacheKey, totpReuseWindow)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	if uses > 1 {
+		return domain.ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// replaceRecoveryCodes generates new recovery codes for a user, storing only their hashes
+func (as *AuthService) replaceRecoveryCodes(ctx context.Context, userID uint64) ([]string, error) {
+	recoveryCodes := make([]string, recoveryCodeCount)
+	codeHashes := make([]string, recoveryCodeCount)
+
+	for i := range recoveryCodes {
+		code, err := util.GenerateRecoveryCode()
+		if err != nil {
+			return nil, domain.ErrInternal
+		}
+
+		recoveryCodes[i] = code
+		codeHashes[i] = util.HashToken(util.NormalizeRecoveryCode(code))
+	}
+
+	err := as.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, codeHashes)
+	if err != nil {
+		return nil, domain.ErrInternal
+	}
+
+	return recoveryCodes, nil
+}
@@ -0,0 +1,806 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bagashiz/go-pos/internal/core/domain"
+	"github.com/bagashiz/go-pos/internal/core/port/mock"
+	"github.com/bagashiz/go-pos/internal/core/service"
+	"github.com/bagashiz/go-pos/internal/core/util"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthService_LoginTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	email := "admin@example.com"
+	password := "12345678"
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("expected to hash the password; got %q", err)
+	}
+
+	user := &domain.User{
+		ID:       1,
+		Email:    email,
+		Password: string(hashedPassword),
+		Role:     domain.Admin,
+	}
+	adminRole := &domain.Role{
+		Name:        domain.Admin,
+		Permissions: domain.Permissions,
+	}
+	manager := &domain.User{
+		ID:       3,
+		Email:    email,
+		Password: string(hashedPassword),
+		Role:     "manager",
+	}
+	managerRole := &domain.Role{
+		Name:        "manager",
+		Permissions: []domain.Permission{domain.UsersManage, domain.ReportsView},
+	}
+	confirmedAt := time.Now().Add(-24 * time.Hour)
+	secret := "JBSWY3DPEHPK3PXP"
+	uri := "otpauth://totp/go-pos:admin@example.com?issuer=go-pos&secret=JBSWY3DPEHPK3PXP"
+
+	testCases := []struct {
+		desc    string
+		enforce bool
+		role    *domain.Role
+		mocks   func(
+			userRepo *mock.MockUserRepository,
+			twoFactorRepo *mock.MockTwoFactorRepository,
+			totpService *mock.MockTOTPService,
+			cache *mock.MockCacheRepository,
+		)
+		enrollment bool
+	}{
+		{
+			desc:    "Success_Enabled",
+			enforce: false,
+			role:    adminRole,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(user, nil)
+				twoFactorRepo.EXPECT().
+					GetTwoFactorByUserID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(&domain.TwoFactor{
+						UserID:      user.ID,
+						Secret:      secret,
+						ConfirmedAt: &confirmedAt,
+					}, nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(5*time.Minute)).
+					Times(1).
+					Return(nil)
+			},
+			enrollment: false,
+		},
+		{
+			desc:    "Success_EnrollmentEnforced",
+			enforce: true,
+			role:    adminRole,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(user, nil)
+				twoFactorRepo.EXPECT().
+					GetTwoFactorByUserID(gomock.Any(), gomock.Eq(user.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				totpService.EXPECT().
+					GenerateSecret(gomock.Eq(email)).
+					Times(1).
+					Return(secret, uri, nil)
+				twoFactorRepo.EXPECT().
+					SaveTwoFactor(gomock.Any(), gomock.Eq(&domain.TwoFactor{
+						UserID: user.ID,
+						Secret: secret,
+					})).
+					Times(1).
+					DoAndReturn(func(_ context.Context, twoFactor *domain.TwoFactor) (*domain.TwoFactor, error) {
+						return twoFactor, nil
+					})
+				twoFactorRepo.EXPECT().
+					ReplaceRecoveryCodes(gomock.Any(), gomock.Eq(user.ID), gomock.Len(10)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(5*time.Minute)).
+					Times(1).
+					Return(nil)
+			},
+			enrollment: true,
+		},
+		{
+			desc:    "Success_EnrollmentEnforcedCustomRole",
+			enforce: true,
+			role:    managerRole,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(email)).
+					Times(1).
+					Return(manager, nil)
+				twoFactorRepo.EXPECT().
+					GetTwoFactorByUserID(gomock.Any(), gomock.Eq(manager.ID)).
+					Times(1).
+					Return(nil, domain.ErrDataNotFound)
+				totpService.EXPECT().
+					GenerateSecret(gomock.Eq(email)).
+					Times(1).
+					Return(secret, uri, nil)
+				twoFactorRepo.EXPECT().
+					SaveTwoFactor(gomock.Any(), gomock.Eq(&domain.TwoFactor{
+						UserID: manager.ID,
+						Secret: secret,
+					})).
+					Times(1).
+					DoAndReturn(func(_ context.Context, twoFactor *domain.TwoFactor) (*domain.TwoFactor, error) {
+						return twoFactor, nil
+					})
+				twoFactorRepo.EXPECT().
+					ReplaceRecoveryCodes(gomock.Any(), gomock.Eq(manager.ID), gomock.Len(10)).
+					Times(1).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(5*time.Minute)).
+					Times(1).
+					Return(nil)
+			},
+			enrollment: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			roleRepo := mock.NewMockRoleRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			totpService := mock.NewMockTOTPService(ctrl)
+
+			// The failed login attempts must only be forgotten once the challenge is completed
+			cache := mock.NewMockCacheRepository(ctrl)
+			cache.EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				Return(nil, domain.ErrCacheMiss)
+
+			roleRepo.EXPECT().
+				GetRoleByName(gomock.Any(), gomock.Eq(tc.role.Name)).
+				Times(1).
+				Return(tc.role, nil)
+
+			tc.mocks(userRepo, twoFactorRepo, totpService, cache)
+
+			authService := service.NewAuthService(
+				userRepo,
+				mock.NewMockRefreshTokenRepository(ctrl),
+				mock.NewMockTerminalRepository(ctrl),
+				roleRepo,
+				twoFactorRepo,
+				mock.NewMockTokenService(ctrl),
+				totpService,
+				cache,
+				mock.NewMockAuditLogger(ctrl),
+				tc.enforce,
+			)
+
+			token, err := authService.Login(ctx, email, password, domain.Device{})
+			if err != nil {
+				t.Fatalf("[case: %s] expected to get no error; got %q", tc.desc, err)
+			}
+
+			if token.AccessToken != "" || token.Challenge == nil || token.Challenge.Token == "" {
+				t.Fatalf("[case: %s] expected to get only a challenge; got %+v", tc.desc, token)
+			}
+
+			if token.Challenge.Subject != "email:admin@example.com" {
+				t.Errorf("[case: %s] expected the challenge to count failures for the email; got %q", tc.desc, token.Challenge.Subject)
+			}
+
+			enrollment := token.Challenge.Enrollment
+			if (enrollment != nil) != tc.enrollment {
+				t.Fatalf("[case: %s] expected to get an enrollment %t; got %+v", tc.desc, tc.enrollment, enrollment)
+			}
+
+			if enrollment != nil && (enrollment.Secret != secret || enrollment.URI != uri || len(enrollment.RecoveryCodes) != 10) {
+				t.Errorf("[case: %s] expected to get the enrollment of the secret with 10 recovery codes; got %+v", tc.desc, enrollment)
+			}
+		})
+	}
+}
+
+func TestAuthService_CompleteTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	challengeToken := "challenge-token"
+	challengeKey := "two_factor_challenge:" + util.HashToken(challengeToken)
+	attemptsKey := "two_factor_attempts:" + util.HashToken(challengeToken)
+	device := domain.Device{
+		Name:      "Back office",
+		IPAddress: "192.0.2.10",
+	}
+	challengeSerialized, err := util.Serialize(&domain.TwoFactorChallenge{
+		UserID:    1,
+		Subject:   "email:admin@example.com",
+		Device:    device,
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("expected to serialize the challenge; got %q", err)
+	}
+
+	user := &domain.User{
+		ID:    1,
+		Email: "admin@example.com",
+		Role:  domain.Admin,
+	}
+	confirmedAt := time.Now().Add(-24 * time.Hour)
+	enabled := &domain.TwoFactor{
+		UserID:      user.ID,
+		Secret:      "JBSWY3DPEHPK3PXP",
+		ConfirmedAt: &confirmedAt,
+	}
+	enrolled := &domain.TwoFactor{
+		UserID: user.ID,
+		Secret: "JBSWY3DPEHPK3PXP",
+	}
+	code := "123456"
+	recoveryCode := "K7QZ-M2XA-4NVB-P9CD"
+	accessToken := "access-token"
+	refreshToken := "refresh-token"
+	refreshExpiresAt := time.Now().Add(7 * 24 * time.Hour)
+	errMiss := domain.ErrCacheMiss
+
+	// expectLoginAttempts mocks checking the login attempts of the credentials and the client IP address of the challenge
+	expectLoginAttempts := func(cache *mock.MockCacheRepository) {
+		for _, subject := range []string{"email:admin@example.com", "ip:192.0.2.10"} {
+			for _, prefix := range []string{"login_lockout", "login_delay"} {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(prefix+":"+subject)).
+					Times(1).
+					Return(nil, errMiss)
+			}
+		}
+	}
+
+	// expectChallenge mocks a cached challenge completed for the first time by the enrolled user
+	expectChallenge := func(userRepo *mock.MockUserRepository, twoFactorRepo *mock.MockTwoFactorRepository, cache *mock.MockCacheRepository, twoFactor *domain.TwoFactor) {
+		cache.EXPECT().
+			Get(gomock.Any(), gomock.Eq(challengeKey)).
+			Times(1).
+			Return(challengeSerialized, nil)
+		expectLoginAttempts(cache)
+		cache.EXPECT().
+			Increment(gomock.Any(), gomock.Eq(attemptsKey), gomock.Eq(5*time.Minute)).
+			Times(1).
+			Return(int64(1), nil)
+		userRepo.EXPECT().
+			GetUserByID(gomock.Any(), gomock.Eq(user.ID)).
+			Times(1).
+			Return(user, nil)
+		twoFactorRepo.EXPECT().
+			GetTwoFactorByUserID(gomock.Any(), gomock.Eq(user.ID)).
+			Times(1).
+			Return(twoFactor, nil)
+	}
+
+	// expectLoginFailure mocks counting an invalid code as a failed login attempt
+	expectLoginFailure := func(cache *mock.MockCacheRepository) {
+		for _, subject := range []string{"email:admin@example.com", "ip:192.0.2.10"} {
+			cache.EXPECT().
+				Increment(gomock.Any(), gomock.Eq("login_failures:"+subject), gomock.Any()).
+				Times(1).
+				Return(int64(1), nil)
+		}
+	}
+
+	// expectTokens mocks forgetting the failed login attempts and issuing the tokens for the device of the challenge
+	expectTokens := func(refreshTokenRepo *mock.MockRefreshTokenRepository, tokenService *mock.MockTokenService, cache *mock.MockCacheRepository) {
+		cache.EXPECT().
+			Delete(gomock.Any(), gomock.Eq(challengeKey)).
+			Times(1).
+			Return(nil)
+		for _, prefix := range []string{"login_failures", "login_delay"} {
+			cache.EXPECT().
+				Delete(gomock.Any(), gomock.Eq(prefix+":email:admin@example.com")).
+				Times(1).
+				Return(nil)
+		}
+		tokenService.EXPECT().
+			CreateToken(gomock.Eq(user), gomock.Any(), gomock.Any()).
+			Times(1).
+			Return(accessToken, nil)
+		tokenService.EXPECT().
+			CreateRefreshToken().
+			Times(1).
+			Return(refreshToken, refreshExpiresAt, nil)
+		refreshTokenRepo.EXPECT().
+			CreateRefreshToken(gomock.Any(), gomock.Cond(func(x any) bool {
+				return x.(*domain.RefreshToken).Device == device
+			})).
+			Times(1).
+			DoAndReturn(func(_ context.Context, token *domain.RefreshToken) (*domain.RefreshToken, error) {
+				return token, nil
+			})
+	}
+
+	testCases := []struct {
+		desc  string
+		code  string
+		mocks func(
+			userRepo *mock.MockUserRepository,
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
+			twoFactorRepo *mock.MockTwoFactorRepository,
+			tokenService *mock.MockTokenService,
+			totpService *mock.MockTOTPService,
+			cache *mock.MockCacheRepository,
+			audit *mock.MockAuditLogger,
+		)
+		expected error
+	}{
+		{
+			desc: "Success_TOTPCode",
+			code: code,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				expectChallenge(userRepo, twoFactorRepo, cache, enabled)
+				totpService.EXPECT().
+					ValidateCode(gomock.Eq(enabled.Secret), gomock.Eq(code)).
+					Times(1).
+					Return(true)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("totp_used:1-123456"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				expectTokens(refreshTokenRepo, tokenService, cache)
+			},
+			expected: nil,
+		},
+		{
+			desc: "Success_RecoveryCode",
+			code: recoveryCode,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				expectChallenge(userRepo, twoFactorRepo, cache, enabled)
+				totpService.EXPECT().
+					ValidateCode(gomock.Any(), gomock.Eq(recoveryCode)).
+					Times(1).
+					Return(false)
+				twoFactorRepo.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(user.ID), gomock.Eq(util.HashToken("k7qzm2xa4nvbp9cd")), gomock.Any()).
+					Times(1).
+					Return(nil)
+				audit.EXPECT().
+					Record(gomock.Any(), gomock.Cond(func(x any) bool {
+						return x.(*domain.AuditEvent).Type == domain.AuditRecoveryCodeUsed
+					})).
+					Times(1)
+				expectTokens(refreshTokenRepo, tokenService, cache)
+			},
+			expected: nil,
+		},
+		{
+			desc: "Success_ConfirmsEnrollment",
+			code: code,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				expectChallenge(userRepo, twoFactorRepo, cache, enrolled)
+				totpService.EXPECT().
+					ValidateCode(gomock.Eq(enrolled.Secret), gomock.Eq(code)).
+					Times(1).
+					Return(true)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("totp_used:1-123456"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				twoFactorRepo.EXPECT().
+					ConfirmTwoFactor(gomock.Any(), gomock.Eq(user.ID), gomock.Any()).
+					Times(1).
+					Return(nil)
+				audit.EXPECT().
+					Record(gomock.Any(), gomock.Cond(func(x any) bool {
+						return x.(*domain.AuditEvent).Type == domain.AuditTwoFactorEnabled
+					})).
+					Times(1)
+				expectTokens(refreshTokenRepo, tokenService, cache)
+			},
+			expected: nil,
+		},
+		{
+			desc: "Fail_RecoveryCodeForEnrollment",
+			code: recoveryCode,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				expectChallenge(userRepo, twoFactorRepo, cache, enrolled)
+				totpService.EXPECT().
+					ValidateCode(gomock.Any(), gomock.Eq(recoveryCode)).
+					Times(1).
+					Return(false)
+				expectLoginFailure(cache)
+			},
+			expected: domain.ErrInvalidTwoFactorCode,
+		},
+		{
+			desc: "Fail_UnknownChallenge",
+			code: code,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(challengeKey)).
+					Times(1).
+					Return(nil, errMiss)
+			},
+			expected: domain.ErrInvalidTwoFactorChallenge,
+		},
+		{
+			desc: "Fail_TooManyAttempts",
+			code: code,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(challengeKey)).
+					Times(1).
+					Return(challengeSerialized, nil)
+				expectLoginAttempts(cache)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq(attemptsKey), gomock.Any()).
+					Times(1).
+					Return(int64(6), nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(challengeKey)).
+					Times(1).
+					Return(nil)
+			},
+			expected: domain.ErrTooManyLoginAttempts,
+		},
+		{
+			desc: "Fail_AccountLockedOut",
+			code: code,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq(challengeKey)).
+					Times(1).
+					Return(challengeSerialized, nil)
+				cache.EXPECT().
+					Get(gomock.Any(), gomock.Eq("login_lockout:email:admin@example.com")).
+					Times(1).
+					Return([]byte("1"), nil)
+			},
+			expected: domain.ErrTooManyLoginAttempts,
+		},
+		{
+			desc: "Fail_ReusedTOTPCode",
+			code: code,
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				expectChallenge(userRepo, twoFactorRepo, cache, enabled)
+				totpService.EXPECT().
+					ValidateCode(gomock.Any(), gomock.Eq(code)).
+					Times(1).
+					Return(true)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("totp_used:1-123456"), gomock.Any()).
+					Times(1).
+					Return(int64(2), nil)
+				expectLoginFailure(cache)
+			},
+			expected: domain.ErrInvalidTwoFactorCode,
+		},
+		{
+			desc: "Fail_InvalidCode",
+			code: "654321",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				tokenService *mock.MockTokenService,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				expectChallenge(userRepo, twoFactorRepo, cache, enabled)
+				totpService.EXPECT().
+					ValidateCode(gomock.Any(), gomock.Eq("654321")).
+					Times(1).
+					Return(false)
+				twoFactorRepo.EXPECT().
+					UseRecoveryCode(gomock.Any(), gomock.Eq(user.ID), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(domain.ErrDataNotFound)
+				expectLoginFailure(cache)
+			},
+			expected: domain.ErrInvalidTwoFactorCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			tokenService := mock.NewMockTokenService(ctrl)
+			totpService := mock.NewMockTOTPService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			audit := mock.NewMockAuditLogger(ctrl)
+
+			tc.mocks(userRepo, refreshTokenRepo, twoFactorRepo, tokenService, totpService, cache, audit)
+
+			authService := service.NewAuthService(
+				userRepo,
+				refreshTokenRepo,
+				mock.NewMockTerminalRepository(ctrl),
+				newRoleRepository(ctrl),
+				twoFactorRepo,
+				tokenService,
+				totpService,
+				cache,
+				audit,
+				true,
+			)
+
+			token, err := authService.CompleteTwoFactor(ctx, challengeToken, tc.code)
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+			if err == nil && (token.AccessToken != accessToken || token.RefreshToken != refreshToken || token.Challenge != nil) {
+				t.Errorf("[case: %s] expected to get the tokens; got %+v", tc.desc, token)
+			}
+		})
+	}
+}
+
+func TestAuthService_DisableTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	confirmedAt := time.Now().Add(-24 * time.Hour)
+	code := "123456"
+
+	testCases := []struct {
+		desc      string
+		user      *domain.User
+		twoFactor *domain.TwoFactor
+		mocks     func(
+			twoFactorRepo *mock.MockTwoFactorRepository,
+			totpService *mock.MockTOTPService,
+			cache *mock.MockCacheRepository,
+			audit *mock.MockAuditLogger,
+		)
+		expected error
+	}{
+		{
+			desc: "Success",
+			user: &domain.User{
+				ID:   2,
+				Role: domain.Cashier,
+			},
+			twoFactor: &domain.TwoFactor{
+				UserID:      2,
+				Secret:      "JBSWY3DPEHPK3PXP",
+				ConfirmedAt: &confirmedAt,
+			},
+			mocks: func(
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+				totpService.EXPECT().
+					ValidateCode(gomock.Any(), gomock.Eq(code)).
+					Times(1).
+					Return(true)
+				cache.EXPECT().
+					Increment(gomock.Any(), gomock.Eq("totp_used:2-123456"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				twoFactorRepo.EXPECT().
+					DeleteTwoFactor(gomock.Any(), gomock.Eq(uint64(2))).
+					Times(1).
+					Return(nil)
+				audit.EXPECT().
+					Record(gomock.Any(), gomock.Cond(func(x any) bool {
+						event := x.(*domain.AuditEvent)
+						return event.Type == domain.AuditTwoFactorDisabled && event.ActorID == 2
+					})).
+					Times(1)
+			},
+			expected: nil,
+		},
+		{
+			desc: "Fail_Enforced",
+			user: &domain.User{
+				ID:   1,
+				Role: domain.Admin,
+			},
+			twoFactor: &domain.TwoFactor{
+				UserID:      1,
+				Secret:      "JBSWY3DPEHPK3PXP",
+				ConfirmedAt: &confirmedAt,
+			},
+			mocks: func(
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+			},
+			expected: domain.ErrTwoFactorRequired,
+		},
+		{
+			desc: "Fail_EnforcedCustomRole",
+			user: &domain.User{
+				ID:   3,
+				Role: "manager",
+			},
+			twoFactor: &domain.TwoFactor{
+				UserID:      3,
+				Secret:      "JBSWY3DPEHPK3PXP",
+				ConfirmedAt: &confirmedAt,
+			},
+			mocks: func(
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+			},
+			expected: domain.ErrTwoFactorRequired,
+		},
+		{
+			desc: "Fail_NotEnabled",
+			user: &domain.User{
+				ID:   2,
+				Role: domain.Cashier,
+			},
+			twoFactor: &domain.TwoFactor{
+				UserID: 2,
+				Secret: "JBSWY3DPEHPK3PXP",
+			},
+			mocks: func(
+				twoFactorRepo *mock.MockTwoFactorRepository,
+				totpService *mock.MockTOTPService,
+				cache *mock.MockCacheRepository,
+				audit *mock.MockAuditLogger,
+			) {
+			},
+			expected: domain.ErrDataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mock.NewMockUserRepository(ctrl)
+			twoFactorRepo := mock.NewMockTwoFactorRepository(ctrl)
+			totpService := mock.NewMockTOTPService(ctrl)
+			cache := mock.NewMockCacheRepository(ctrl)
+			audit := mock.NewMockAuditLogger(ctrl)
+
+			userRepo.EXPECT().
+				GetUserByID(gomock.Any(), gomock.Eq(tc.user.ID)).
+				Times(1).
+				Return(tc.user, nil)
+			twoFactorRepo.EXPECT().
+				GetTwoFactorByUserID(gomock.Any(), gomock.Eq(tc.user.ID)).
+				Times(1).
+				Return(tc.twoFactor, nil)
+
+			tc.mocks(twoFactorRepo, totpService, cache, audit)
+
+			authService := service.NewAuthService(
+				userRepo,
+				mock.NewMockRefreshTokenRepository(ctrl),
+				mock.NewMockTerminalRepository(ctrl),
+				newRoleRepository(ctrl),
+				twoFactorRepo,
+				mock.NewMockTokenService(ctrl),
+				totpService,
+				cache,
+				audit,
+				true,
+			)
+
+			err := authService.DisableTwoFactor(ctx, tc.user.ID, code)
+			if err != tc.expected {
+				t.Errorf("[case: %s] expected to get %q; got %q", tc.desc, tc.expected, err)
+			}
+		})
+	}
+}
@@ -10,18 +10,22 @@ import (
 
 /**
  * UserService implements port.UserService interface
- * and provides an access to the user repository
+ * and provides an access to the user, refresh token and role repositories
  * and cache service
  */
 type UserService struct {
-	repo  port.UserRepository
-	cache port.CacheRepository
+	repo        port.UserRepository
+	refreshRepo port.RefreshTokenRepository
+	roleRepo    port.RoleRepository
+	cache       port.CacheRepository
 }
 
 // NewUserService creates a new user service instance
-func NewUserService(repo port.UserRepository, cache port.CacheRepository) *UserService {
+func NewUserService(repo port.UserRepository, refreshRepo port.RefreshTokenRepository, roleRepo port.RoleRepository, cache port.CacheRepository) *UserService {
 	return &UserService{
 		repo,
+		refreshRepo,
+		roleRepo,
 		cache,
 	}
 }
@@ -131,7 +135,8 @@ func (us *UserService) ListUsers(ctx context.Context, skip, limit uint64) ([]dom
 	return users, nil
 }
 
-// UpdateUser updates a user's name, email, and password
+// UpdateUser updates a user's name, email, and password,
+// revoking the user's tokens if the role or password changes
 func (us *UserService) UpdateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	existingUser, err := us.repo.GetUserByID(ctx, user.ID)
 	if err != nil {
@@ -193,10 +198,18 @@ func (us *UserService) UpdateUser(ctx context.Context, user *domain.User) (*doma
 		return nil, domain.ErrInternal
 	}
 
+	roleChanged := user.Role != "" && user.Role != existingUser.Role
+	if roleChanged || hashedPassword != "" {
+		err = revokeUserTokens(ctx, us.refreshRepo, us.cache, user.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return user, nil
 }
 
-// DeleteUser deletes a user by ID
+// DeleteUser deletes a user by ID and revokes the user's tokens
 func (us *UserService) DeleteUser(ctx context.Context, id uint64) error {
 	_, err := us.repo.GetUserByID(ctx, id)
 	if err != nil {
@@ -218,5 +231,52 @@ func (us *UserService) DeleteUser(ctx context.Context, id uint64) error {
 		return domain.ErrInternal
 	}
 
-	return us.repo.DeleteUser(ctx, id)
+	err = us.repo.DeleteUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return revokeUserTokens(ctx, us.refreshRepo, us.cache, id)
+}
+
+// SetPIN hashes and sets the PIN of a user whose role allows PIN login, replacing the previous one
+func (us *UserService) SetPIN(ctx context.Context, id uint64, pin string) error {
+	user, err := us.repo.GetUserByID(ctx, id)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	role, err := us.roleRepo.GetRoleByName(ctx, user.Role)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	if !role.HasPermission(domain.AuthPINLogin) {
+		return domain.ErrPINNotAllowed
+	}
+
+	hashedPIN, err := util.HashPassword(pin)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	err = us.repo.UpdateUserPIN(ctx, id, hashedPIN)
+	if err != nil {
+		if err == domain.ErrDataNotFound {
+			return err
+		}
+		return domain.ErrInternal
+	}
+
+	cacheKey := util.GenerateCacheKey("user", id)
+
+	err = us.cache.Delete(ctx, cacheKey)
+	if err != nil {
+		return domain.ErrInternal
+	}
+
+	return nil
 }
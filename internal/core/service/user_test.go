@@ -174,11 +174,12 @@ func TestUserService_Register(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := service.NewUserService(userRepo, cache)
+			userService := service.NewUserService(userRepo, refreshTokenRepo, newRoleRepository(ctrl), cache)
 
 			user, err := userService.Register(ctx, tc.input.user)
 			assert.Equal(t, tc.expected.err, err, "Error mismatch")
@@ -356,11 +357,12 @@ func TestUserService_GetUser(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := service.NewUserService(userRepo, cache)
+			userService := service.NewUserService(userRepo, refreshTokenRepo, newRoleRepository(ctrl), cache)
 
 			user, err := userService.GetUser(ctx, tc.input.id)
 			assert.Equal(t, tc.expected.err, err, "Error mismatch")
@@ -533,11 +535,12 @@ func TestUserService_ListUsers(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
 
 			tc.mocks(userRepo, cache)
 
-			userService := service.NewUserService(userRepo, cache)
+			userService := service.NewUserService(userRepo, refreshTokenRepo, newRoleRepository(ctrl), cache)
 
 			users, err := userService.ListUsers(ctx, tc.input.skip, tc.input.limit)
 			assert.Equal(t, tc.expected.err, err, "Error mismatch")
@@ -579,8 +582,15 @@ func TestUserService_UpdateUser(t *testing.T) {
 		Email: gofakeit.Email(),
 		Role:  domain.Admin,
 	}
+	existingCashier := &domain.User{
+		ID:    userID,
+		Name:  existingUser.Name,
+		Email: existingUser.Email,
+		Role:  domain.Cashier,
+	}
 
 	cacheKey := util.GenerateCacheKey("user", userID)
+	revokedCacheKey := util.GenerateCacheKey("revoked_user", userID)
 	userSerialized, _ := util.Serialize(userOutput)
 	ttl := time.Duration(0)
 
@@ -588,6 +598,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 		desc  string
 		mocks func(
 			userRepo *mock.MockUserRepository,
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
 			cache *mock.MockCacheRepository,
 		)
 		input    updateUserTestedInput
@@ -597,6 +608,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Success",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -614,6 +626,43 @@ func TestUserService_UpdateUser(t *testing.T) {
 				cache.EXPECT().
 					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
 					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(revokedCacheKey), gomock.Any(), gomock.Eq(ttl)).
+					Return(nil)
+				refreshTokenRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Return(nil)
+			},
+			input: updateUserTestedInput{
+				user: userInput,
+			},
+			expected: updateUserExpectedOutput{
+				user: userOutput,
+				err:  nil,
+			},
+		},
+		{
+			desc: "Success_SameRole",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingCashier, nil)
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(userInput)).
+					Return(userOutput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(userSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Return(nil)
 			},
 			input: updateUserTestedInput{
 				user: userInput,
@@ -627,6 +676,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_NotFound",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -645,6 +695,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_InternalErrorGetByID",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -663,6 +714,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_EmptyData",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -683,6 +735,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_SameData",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -701,6 +754,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_DuplicateData",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -722,6 +776,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_InternalErrorUpdate",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -743,6 +798,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_DeleteCache",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -767,6 +823,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_SetCache",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -794,6 +851,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 			desc: "Fail_DeleteCacheByPrefix",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -820,6 +878,43 @@ func TestUserService_UpdateUser(t *testing.T) {
 				err:  domain.ErrInternal,
 			},
 		},
+		{
+			desc: "Fail_RevokeTokens",
+			mocks: func(
+				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
+				cache *mock.MockCacheRepository,
+			) {
+				userRepo.EXPECT().
+					GetUserByID(gomock.Any(), gomock.Eq(userID)).
+					Return(existingUser, nil)
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(userInput)).
+					Return(userOutput, nil)
+				cache.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(cacheKey)).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(cacheKey), gomock.Eq(userSerialized), gomock.Eq(ttl)).
+					Return(nil)
+				cache.EXPECT().
+					DeleteByPrefix(gomock.Any(), gomock.Eq("users:*")).
+					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(revokedCacheKey), gomock.Any(), gomock.Eq(ttl)).
+					Return(nil)
+				refreshTokenRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Return(domain.ErrInternal)
+			},
+			input: updateUserTestedInput{
+				user: userInput,
+			},
+			expected: updateUserExpectedOutput{
+				user: nil,
+				err:  domain.ErrInternal,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -831,11 +926,12 @@ func TestUserService_UpdateUser(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
 
-			tc.mocks(userRepo, cache)
+			tc.mocks(userRepo, refreshTokenRepo, cache)
 
-			userService := service.NewUserService(userRepo, cache)
+			userService := service.NewUserService(userRepo, refreshTokenRepo, newRoleRepository(ctrl), cache)
 
 			user, err := userService.UpdateUser(ctx, tc.input.user)
 			assert.Equal(t, tc.expected.err, err, "Error mismatch")
@@ -857,11 +953,13 @@ func TestUserService_DeleteUser(t *testing.T) {
 	userID := gofakeit.Uint64()
 
 	cacheKey := util.GenerateCacheKey("user", userID)
+	revokedCacheKey := util.GenerateCacheKey("revoked_user", userID)
 
 	testCases := []struct {
 		desc  string
 		mocks func(
 			userRepo *mock.MockUserRepository,
+			refreshTokenRepo *mock.MockRefreshTokenRepository,
 			cache *mock.MockCacheRepository,
 		)
 		input    deleteUserTestedInput
@@ -871,6 +969,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			desc: "Success",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -885,6 +984,12 @@ func TestUserService_DeleteUser(t *testing.T) {
 				userRepo.EXPECT().
 					DeleteUser(gomock.Any(), gomock.Eq(userID)).
 					Return(nil)
+				cache.EXPECT().
+					Set(gomock.Any(), gomock.Eq(revokedCacheKey), gomock.Any(), gomock.Eq(time.Duration(0))).
+					Return(nil)
+				refreshTokenRepo.EXPECT().
+					RevokeUserRefreshTokens(gomock.Any(), gomock.Eq(userID), gomock.Any()).
+					Return(nil)
 			},
 			input: deleteUserTestedInput{
 				id: userID,
@@ -897,6 +1002,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			desc: "Fail_NotFound",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -914,6 +1020,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			desc: "Fail_InternalErrorGetByID",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -931,6 +1038,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			desc: "Fail_DeleteCache",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -951,6 +1059,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			desc: "Fail_DeleteCacheByPrefix",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				userRepo.EXPECT().
@@ -974,6 +1083,7 @@ func TestUserService_DeleteUser(t *testing.T) {
 			desc: "Fail_InternalErrorDelete",
 			mocks: func(
 				userRepo *mock.MockUserRepository,
+				refreshTokenRepo *mock.MockRefreshTokenRepository,
 				cache *mock.MockCacheRepository,
 			) {
 				user := &domain.User{
@@ -1009,11 +1119,12 @@ func TestUserService_DeleteUser(t *testing.T) {
 			defer ctrl.Finish()
 
 			userRepo := mock.NewMockUserRepository(ctrl)
+			refreshTokenRepo := mock.NewMockRefreshTokenRepository(ctrl)
 			cache := mock.NewMockCacheRepository(ctrl)
 
-			tc.mocks(userRepo, cache)
+			tc.mocks(userRepo, refreshTokenRepo, cache)
 
-			userService := service.NewUserService(userRepo, cache)
+			userService := service.NewUserService(userRepo, refreshTokenRepo, newRoleRepository(ctrl), cache)
 
 			err := userService.DeleteUser(ctx, tc.input.id)
 			assert.Equal(t, tc.expected.err, err, "Error mismatch")
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// weightedBarcodePrefixLength is the length of the in-store prefix and item code of a weighted barcode
+const weightedBarcodePrefixLength = 7
+
+// inStoreBarcodePrefix is the prefix of the EAN-13 barcodes generated for products, in the 040-049 range
+// reserved for numbers used within a company, so that they are never read as weighted barcodes in the 20-29 range
+const inStoreBarcodePrefix = "04"
+
+// IsValidBarcode checks whether a code is an EAN-13, EAN-8 or UPC-A barcode with a valid check digit
+func IsValidBarcode(code string) bool {
+	switch len(code) {
+	case 8, 12, 13:
+	default:
+		return false
+	}
+
+	if !isDigits(code) {
+		return false
+	}
+
+	last := len(code) - 1
+
+	return BarcodeCheckDigit(code[:last]) == code[last]
+}
+
+// BarcodeCheckDigit calculates the GS1 check digit of the given barcode digits
+func BarcodeCheckDigit(digits string) byte {
+	var sum int
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 1 {
+			digit *= 3
+		}
+
+		sum += digit
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+// IsValidWeightedBarcodePrefix checks whether a code is the in-store prefix and item code
+// of weighted barcodes, in the 20-29 range reserved for restricted circulation
+func IsValidWeightedBarcodePrefix(code string) bool {
+	return len(code) == weightedBarcodePrefixLength && code[0] == '2' && isDigits(code)
+}
+
+// ParseWeightedBarcode splits an in-store weighted EAN-13 barcode into its prefix
+// and the weight or price value encoded in it
+func ParseWeightedBarcode(code string) (string, int64, bool) {
+	if len(code) != 13 || code[0] != '2' || !IsValidBarcode(code) {
+		return "", 0, false
+	}
+
+	value, err := strconv.ParseInt(code[weightedBarcodePrefixLength:12], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return code[:weightedBarcodePrefixLength], value, true
+}
+
+// InStoreBarcode generates an in-store EAN-13 barcode from a product id,
+// for products without a manufacturer barcode
+func InStoreBarcode(id uint64) string {
+	digits := fmt.Sprintf("%s%010d", inStoreBarcodePrefix, id%10000000000)
+	return digits + string(BarcodeCheckDigit(digits))
+}
+
+// isDigits checks whether a string is made of decimal digits only
+func isDigits(str string) bool {
+	if str == "" {
+		return false
+	}
+
+	for _, char := range str {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}